@@ -20,6 +20,9 @@ var params multi.KeyValueString
 var client_uri string
 var use_runtimevar bool
 var paginated bool
+var workers int
+var as_completed bool
+var split_search bool
 
 // APIApplication implements the application.Application interface as a commandline application to invoke
 // the Flickr API and output results to STDOUT. It does not support uploading or replacing photos.
@@ -35,6 +38,9 @@ func (app *APIApplication) DefaultFlagSet() *flag.FlagSet {
 	fs.StringVar(&client_uri, "client-uri", "", "A valid aaronland/go-flickr-api client URI.")
 	fs.BoolVar(&use_runtimevar, "use-runtimevar", false, "Signal that the -client-uri flag is encoded as a gocloud.dev/runtimevar string URI.")
 	fs.BoolVar(&paginated, "paginated", false, "Automatically paginate (and iterate through) all API responses.")
+	fs.IntVar(&workers, "workers", 1, "The number of pages to fetch concurrently when the -paginated flag is set.")
+	fs.BoolVar(&as_completed, "as-completed", false, "Emit pages as soon as they are fetched rather than in page order when the -workers flag is greater than 1.")
+	fs.BoolVar(&split_search, "split-search", false, "Slice flickr.photos.search queries in to date windows in order to return all the results, rather than the first 4000 results, for large queries. Photos are emitted as line-separated JSON.")
 	fs.Var(&params, "param", "Zero or more {KEY}={VALUE} Flickr API parameters to include with your uploads.")
 
 	fs.Usage = func() {
@@ -106,12 +112,43 @@ func (app *APIApplication) RunWithFlagSet(ctx context.Context, fs *flag.FlagSet)
 		return nil
 	}
 
-	if paginated {
+	if split_search {
 
-		err := client.ExecuteMethodPaginatedWithClient(ctx, cl, args, cb)
+		opts := &client.SearchOptions{
+			Workers: workers,
+		}
 
-		if err != nil {
-			return nil, fmt.Errorf("Failed to write method results, %v", err)
+		for ph, err := range client.SearchPhotosWithClient(ctx, cl, args, opts) {
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to write method results, %v", err)
+			}
+
+			_, err = fmt.Fprintln(os.Stdout, ph.Raw)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to write method results, %v", err)
+			}
+		}
+
+	} else if paginated {
+
+		opts := &client.PaginateOptions{
+			Workers:     workers,
+			AsCompleted: as_completed,
+		}
+
+		for page, err := range client.ExecuteMethodPagesWithClient(ctx, cl, args, opts) {
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to write method results, %v", err)
+			}
+
+			_, err = os.Stdout.Write(page.Body)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to write method results, %v", err)
+			}
 		}
 
 	} else {
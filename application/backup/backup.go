@@ -0,0 +1,182 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/application"
+	"github.com/aaronland/go-flickr-api/backup"
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/gocloud/runtimevar"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/sfomuseum/go-flags/flagset"
+	"github.com/sfomuseum/go-flags/multi"
+	"gocloud.dev/blob"
+)
+
+var params multi.KeyValueString
+var client_uri string
+var use_runtimevar bool
+var bucket_uri string
+var workers int
+var progress_interval time.Duration
+
+// BackupApplication implements the application.Application interface as a commandline application for
+// backing up photos, and their metadata, using the Flickr API
+type BackupApplication struct {
+	application.Application
+}
+
+// Return the default FlagSet necessary for the BackupApplication to run.
+func (app *BackupApplication) DefaultFlagSet() *flag.FlagSet {
+
+	fs := flagset.NewFlagSet("backup")
+
+	fs.StringVar(&client_uri, "client-uri", "", "A valid aaronland/go-flickr-api client URI.")
+	fs.BoolVar(&use_runtimevar, "use-runtimevar", false, "Signal that the -client-uri flag is encoded as a gocloud.dev/runtimevar string URI.")
+	fs.StringVar(&bucket_uri, "bucket-uri", "", "A valid gocloud.dev/blob bucket URI where photos and their sidecar files will be written.")
+	fs.IntVar(&workers, "workers", backup.DEFAULT_WORKERS, "The number of photos to back up concurrently.")
+	fs.DurationVar(&progress_interval, "progress-interval", 30*time.Second, "How often to report progress (photos processed and bytes downloaded) to STDERR. If 0 progress is not reported.")
+	fs.Var(&params, "param", "One or more {KEY}={VALUE} Flickr API parameters for a method that returns a \"standard photo response\", for example -param method=flickr.people.getPhotos -param user_id=me.")
+
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, wordwrap.WrapString("Command-line tool for backing up photos, and their metadata, from Flickr to a gocloud.dev/blob bucket. The result for each photo is emitted to STDOUT as line-separated JSON.\n\n", 80))
+		fmt.Fprintf(os.Stderr, "Usage:\n\t%s [options]\n\n", os.Args[0])
+		fmt.Fprint(os.Stderr, "Valid options are:\n")
+		fs.PrintDefaults()
+		fmt.Fprint(os.Stderr, "\nNotes:\n\n")
+		fmt.Fprint(os.Stderr, wordwrap.WrapString("For each photo the original, or the largest available size, is written to {PHOTO_ID}.{EXTENSION} and a sidecar file containing the output of the flickr.photos.getInfo, flickr.photos.getExif and flickr.photos.comments.getList methods is written to {PHOTO_ID}.json. Backups are incremental: photos whose \"lastupdate\" date has not changed since they were last backed up are skipped.\n\nUnder the hood the backup tool is using the GoCloud blob abstraction layer for writing files. By default only local files the file:// URI scheme are supported. If you need to write files to other destinations you will need to clone this application and import the relevant packages.\n", 80))
+
+		fmt.Fprintf(os.Stderr, "\n")
+	}
+
+	return fs
+}
+
+// Invoke the BackupApplication with its default FlagSet.
+func (app *BackupApplication) Run(ctx context.Context) (any, error) {
+	fs := app.DefaultFlagSet()
+	return app.RunWithFlagSet(ctx, fs)
+}
+
+// Invoke the BackupApplication with a custom FlagSet.
+func (app *BackupApplication) RunWithFlagSet(ctx context.Context, fs *flag.FlagSet) (any, error) {
+
+	flagset.Parse(fs)
+
+	err := flagset.SetFlagsFromEnvVars(fs, "FLICKR")
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to set flags from environment variables, %v", err)
+	}
+
+	args := &url.Values{}
+
+	for _, kv := range params {
+		args.Set(kv.Key(), kv.Value().(string))
+	}
+
+	if args.Get("method") == "" {
+		return nil, fmt.Errorf("Missing method parameter")
+	}
+
+	if bucket_uri == "" {
+		return nil, fmt.Errorf("Missing -bucket-uri flag")
+	}
+
+	if use_runtimevar {
+
+		runtime_uri, err := runtimevar.StringVar(ctx, client_uri)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to derive runtime value for client URI, %v", err)
+		}
+
+		client_uri = runtime_uri
+	}
+
+	cl, err := client.NewClient(ctx, client_uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create client, %v", err)
+	}
+
+	bucket, err := blob.OpenBucket(ctx, bucket_uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open bucket, %v", err)
+	}
+
+	defer bucket.Close()
+
+	// Cancel outstanding downloads on Ctrl-C so that their results are reported before exiting.
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	opts := &backup.BackupOptions{
+		Client:  cl,
+		Bucket:  bucket,
+		Workers: workers,
+	}
+
+	b, err := backup.NewBackup(ctx, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create backup, %v", err)
+	}
+
+	if progress_interval > 0 {
+
+		ticker := time.NewTicker(progress_interval)
+		defer ticker.Stop()
+
+		go func() {
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					fmt.Fprintln(os.Stderr, b.Progress().String())
+				}
+			}
+		}()
+	}
+
+	// Results are emitted as line-separated JSON as soon as each photo is backed up. Individual failures are
+	// reported in the results rather than ending the backup.
+
+	enc := json.NewEncoder(os.Stdout)
+
+	failed := 0
+
+	for rsp := range b.Backup(ctx, args) {
+
+		if rsp.Error != nil {
+			failed += 1
+		}
+
+		err := enc.Encode(rsp)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to encode result, %v", err)
+		}
+	}
+
+	if progress_interval > 0 {
+		fmt.Fprintln(os.Stderr, b.Progress().String())
+	}
+
+	if failed > 0 {
+		return nil, fmt.Errorf("Failed to back up %d photos", failed)
+	}
+
+	return nil, nil
+}
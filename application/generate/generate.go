@@ -0,0 +1,177 @@
+package generate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aaronland/go-flickr-api/application"
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/reflection"
+	"github.com/aaronland/gocloud/runtimevar"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/sfomuseum/go-flags/flagset"
+)
+
+var client_uri string
+var use_runtimevar bool
+var reflection_path string
+var output_path string
+var package_name string
+var refresh bool
+
+// GenerateApplication implements the application.Application interface as a commandline application for generating
+// typed Go bindings for Flickr API methods from a snapshot of the Flickr API reflection methods.
+type GenerateApplication struct {
+	application.Application
+}
+
+// Return the default FlagSet necessary for the GenerateApplication to run.
+func (app *GenerateApplication) DefaultFlagSet() *flag.FlagSet {
+
+	fs := flagset.NewFlagSet("generate")
+
+	fs.StringVar(&reflection_path, "reflection", "", "The path to a JSON-encoded snapshot of the Flickr API reflection methods.")
+	fs.StringVar(&output_path, "output", "-", "The path to write generated code to. If \"-\" then code is written to STDOUT.")
+	fs.StringVar(&package_name, "package", "methods", "The name of the Go package for the generated code.")
+	fs.BoolVar(&refresh, "refresh", false, "Fetch a new snapshot of the Flickr API reflection methods, using the -client-uri flag, and write it to the -reflection path before generating code.")
+	fs.StringVar(&client_uri, "client-uri", "", "A valid aaronland/go-flickr-api client URI. Only required if the -refresh flag is set.")
+	fs.BoolVar(&use_runtimevar, "use-runtimevar", false, "Signal that the -client-uri flag is encoded as a gocloud.dev/runtimevar string URI.")
+
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, wordwrap.WrapString("Command-line tool for generating typed Go bindings for Flickr API methods from a snapshot of the output of the flickr.reflection.getMethods and flickr.reflection.getMethodInfo API methods.\n\n", 80))
+		fmt.Fprintf(os.Stderr, "Usage:\n\t%s [options]\n\n", os.Args[0])
+		fmt.Fprint(os.Stderr, "Valid options are:\n")
+		fs.PrintDefaults()
+		fmt.Fprint(os.Stderr, "\nNotes:\n\n")
+		fmt.Fprint(os.Stderr, wordwrap.WrapString("Code generation does not require network access unless the -refresh flag is set. Generated code is deterministic for a given snapshot.\n", 80))
+
+		fmt.Fprintf(os.Stderr, "\n")
+	}
+
+	return fs
+}
+
+// Invoke the GenerateApplication with its default FlagSet.
+func (app *GenerateApplication) Run(ctx context.Context) (any, error) {
+	fs := app.DefaultFlagSet()
+	return app.RunWithFlagSet(ctx, fs)
+}
+
+// Invoke the GenerateApplication with a custom FlagSet.
+func (app *GenerateApplication) RunWithFlagSet(ctx context.Context, fs *flag.FlagSet) (any, error) {
+
+	flagset.Parse(fs)
+
+	err := flagset.SetFlagsFromEnvVars(fs, "FLICKR")
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to set flags from environment variables, %v", err)
+	}
+
+	if reflection_path == "" {
+		return nil, fmt.Errorf("Missing -reflection flag")
+	}
+
+	if refresh {
+
+		err := app.refreshSnapshot(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to refresh snapshot, %v", err)
+		}
+	}
+
+	r, err := os.Open(reflection_path)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open %s, %v", reflection_path, err)
+	}
+
+	defer r.Close()
+
+	snapshot, err := reflection.ReadSnapshot(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read snapshot, %v", err)
+	}
+
+	opts := &reflection.GenerateOptions{
+		Package:   package_name,
+		Generator: "go-flickr-api/cmd/generate-methods",
+	}
+
+	src, err := reflection.Generate(snapshot, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to generate code, %v", err)
+	}
+
+	var wr io.Writer
+
+	switch output_path {
+	case "-":
+		wr = os.Stdout
+	default:
+
+		fh, err := os.Create(output_path)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create %s, %v", output_path, err)
+		}
+
+		defer fh.Close()
+		wr = fh
+	}
+
+	_, err = wr.Write(src)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write generated code, %v", err)
+	}
+
+	return nil, nil
+}
+
+func (app *GenerateApplication) refreshSnapshot(ctx context.Context) error {
+
+	if use_runtimevar {
+
+		runtime_uri, err := runtimevar.StringVar(ctx, client_uri)
+
+		if err != nil {
+			return fmt.Errorf("Failed to derive runtime value for client URI, %v", err)
+		}
+
+		client_uri = runtime_uri
+	}
+
+	cl, err := client.NewClient(ctx, client_uri)
+
+	if err != nil {
+		return fmt.Errorf("Failed to create client, %v", err)
+	}
+
+	snapshot, err := reflection.FetchSnapshot(ctx, cl)
+
+	if err != nil {
+		return err
+	}
+
+	fh, err := os.Create(reflection_path)
+
+	if err != nil {
+		return fmt.Errorf("Failed to create %s, %v", reflection_path, err)
+	}
+
+	err = reflection.WriteSnapshot(fh, snapshot)
+
+	if err != nil {
+		fh.Close()
+		return fmt.Errorf("Failed to write snapshot, %v", err)
+	}
+
+	return fh.Close()
+}
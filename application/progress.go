@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/uploader"
+)
+
+// The width, in characters, of the progress bar drawn by ReportProgress.
+const PROGRESS_BAR_WIDTH int = 30
+
+// ReportProgress writes the progress of 'up' to STDERR every 'interval' until the function it returns is invoked, which
+// writes the final progress. If STDERR is a terminal progress is drawn as a progress bar which is updated in place,
+// otherwise each report is written on a new line.
+func ReportProgress(ctx context.Context, up *uploader.Uploader, interval time.Duration) func() {
+
+	is_terminal := false
+
+	info, err := os.Stderr.Stat()
+
+	if err == nil && info.Mode()&os.ModeCharDevice != 0 {
+		is_terminal = true
+	}
+
+	report := func() {
+
+		p := up.Progress()
+
+		if is_terminal {
+			fmt.Fprintf(os.Stderr, "\r\033[K%s", p.Bar(PROGRESS_BAR_WIDTH))
+		} else {
+			fmt.Fprintln(os.Stderr, p.String())
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	done_ch := make(chan bool)
+
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+
+	go func() {
+
+		defer wg.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done_ch:
+				return
+			case <-ticker.C:
+				report()
+			}
+		}
+	}()
+
+	return func() {
+
+		ticker.Stop()
+		close(done_ch)
+		wg.Wait()
+
+		report()
+
+		if is_terminal {
+			fmt.Fprintln(os.Stderr, "")
+		}
+	}
+}
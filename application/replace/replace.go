@@ -5,14 +5,14 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"log"
 	"net/url"
 	"os"
+	"os/signal"
+	"time"
 
 	"github.com/aaronland/go-flickr-api/application"
 	"github.com/aaronland/go-flickr-api/client"
-	"github.com/aaronland/go-flickr-api/reader"
-	"github.com/aaronland/go-flickr-api/response"
+	"github.com/aaronland/go-flickr-api/uploader"
 	"github.com/aaronland/gocloud/runtimevar"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/sfomuseum/go-flags/flagset"
@@ -22,6 +22,14 @@ import (
 var params multi.KeyValueString
 var client_uri string
 var use_runtimevar bool
+var include multi.MultiString
+var exclude multi.MultiString
+var media string
+var manifests multi.MultiString
+var workers int
+var async bool
+var progress_interval time.Duration
+var max_bandwidth int64
 
 // ReplaceApplication implements the application.Application interface as a commandline application for
 // replacing photos using the Flickr API
@@ -36,11 +44,19 @@ func (app *ReplaceApplication) DefaultFlagSet() *flag.FlagSet {
 
 	fs.StringVar(&client_uri, "client-uri", "", "A valid aaronland/go-flickr-api client URI.")
 	fs.BoolVar(&use_runtimevar, "use-runtimevar", false, "Signal that the -client-uri flag is encoded as a gocloud.dev/runtimevar string URI.")
+	fs.Var(&include, "include", "Zero or more glob patterns for files to include when walking directories. Patterns without a \"/\" are matched against file names, otherwise against paths relative to the directory being walked.")
+	fs.Var(&exclude, "exclude", "Zero or more glob patterns for files to exclude when walking directories.")
+	fs.StringVar(&media, "media", uploader.MEDIA_ALL, "The kinds of files to include when walking directories. Valid options are: all, photos, videos.")
+	fs.IntVar(&workers, "workers", uploader.DEFAULT_WORKERS, "The number of photos to replace concurrently.")
+	fs.BoolVar(&async, "async", false, "If true replace each photo asynchronously, waiting for its upload ticket to complete.")
+	fs.DurationVar(&progress_interval, "progress-interval", 5*time.Second, "How often to report progress (files processed, bytes sent and the estimated time remaining) to STDERR. If STDERR is a terminal progress is drawn as a progress bar. If 0 progress is not reported.")
+	fs.Int64Var(&max_bandwidth, "max-bandwidth", 0, "The maximum number of bytes per second to upload, shared by all the workers. If 0 bandwidth is not limited.")
+	fs.Var(&manifests, "manifest", "Zero or more CSV or JSONL manifest files where each row specifies the path to a file, the ID of the photo it replaces in a \"photo_id\" column and any parameters specific to that file. Per-file parameters override -param values.")
 	fs.Var(&params, "param", "Zero or more {KEY}={VALUE} Flickr API parameters to include with your uploads.")
 
 	fs.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Command-line tool for replacing an image in Flickr.\n\n")
-		fmt.Fprintf(os.Stderr, "Usage:\n\t%s [options] path(N)\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Command-line tool for replacing one or more images in Flickr.\n\n")
+		fmt.Fprintf(os.Stderr, "Usage:\n\t%s [options] path=photo_id(N) path=photo_id(N)\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "Valid options are:\n")
 		fs.PrintDefaults()
 		fmt.Fprint(os.Stderr, "\nNotes:\n\n")
@@ -69,7 +85,28 @@ func (app *ReplaceApplication) RunWithFlagSet(ctx context.Context, fs *flag.Flag
 		return nil, fmt.Errorf("Failed to set flags from environment variables, %v", err)
 	}
 
-	paths := fs.Args()
+	input_opts := &uploader.InputOptions{
+		Include: include,
+		Exclude: exclude,
+		Media:   media,
+	}
+
+	inputs, err := uploader.ExpandReplacePairs(ctx, fs.Args(), input_opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to derive inputs, %v", err)
+	}
+
+	for _, uri := range manifests {
+
+		manifest_inputs, err := uploader.ReadManifest(ctx, uri)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read manifest '%s', %v", uri, err)
+		}
+
+		inputs = append(inputs, manifest_inputs...)
+	}
 
 	if use_runtimevar {
 
@@ -94,39 +131,41 @@ func (app *ReplaceApplication) RunWithFlagSet(ctx context.Context, fs *flag.Flag
 		args.Set(kv.Key(), kv.Value().(string))
 	}
 
-	// Do this concurrently...
+	// Cancel outstanding replacements on Ctrl-C so that their results are reported before exiting.
 
-	for _, path := range paths {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
 
-		fh, err := reader.NewReader(ctx, path)
-
-		if err != nil {
-			return nil, fmt.Errorf("Failed to create reader for '%s', %v", path, err)
-		}
+	opts := &uploader.UploaderOptions{
+		Client:       cl,
+		Workers:      workers,
+		Args:         args,
+		ReplaceAsync: async,
+		MaxBandwidth: max_bandwidth,
+	}
 
-		defer fh.Close()
+	up, err := uploader.NewUploader(ctx, opts)
 
-		rsp, err := cl.Replace(ctx, fh, args)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create uploader, %v", err)
+	}
 
-		if err != nil {
-			log.Printf("Failed to replace '%s', %v", path, err)
-		}
+	if progress_interval > 0 {
+		stop_progress := application.ReportProgress(ctx, up, progress_interval)
+		defer stop_progress()
+	}
 
-		up, err := response.UnmarshalUploadResponse(rsp)
+	// Results are emitted as line-separated JSON as soon as each replacement completes. Individual failures are
+	// reported in the results rather than ending the batch.
 
-		if err != nil {
-			return nil, fmt.Errorf("Failed to unmarshal upload response, %v", err)
-		}
+	enc := json.NewEncoder(os.Stdout)
 
-		if up.Error != nil {
-			return nil, fmt.Errorf("Upload failed, %v", up.Error)
-		}
+	for rsp := range up.ReplaceInputs(ctx, inputs) {
 
-		enc := json.NewEncoder(os.Stdout)
-		err = enc.Encode(up)
+		err := enc.Encode(rsp)
 
 		if err != nil {
-			log.Fatalf("Failed to encode results, %v", err)
+			return nil, fmt.Errorf("Failed to encode result, %v", err)
 		}
 	}
 
@@ -0,0 +1,187 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+
+	"github.com/aaronland/go-flickr-api/application"
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/syncer"
+	"github.com/aaronland/go-flickr-api/uploader"
+	"github.com/aaronland/gocloud/runtimevar"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/sfomuseum/go-flags/flagset"
+	"github.com/sfomuseum/go-flags/multi"
+)
+
+var params multi.KeyValueString
+var client_uri string
+var use_runtimevar bool
+var bucket_uri string
+var photoset_id string
+var removed string
+var workers int
+var max_bandwidth int64
+var include multi.MultiString
+var exclude multi.MultiString
+var media string
+var dry_run bool
+
+// SyncApplication implements the application.Application interface as a commandline application for
+// mirroring the files in a bucket to a photoset using the Flickr API
+type SyncApplication struct {
+	application.Application
+}
+
+// Return the default FlagSet necessary for the SyncApplication to run.
+func (app *SyncApplication) DefaultFlagSet() *flag.FlagSet {
+
+	fs := flagset.NewFlagSet("sync")
+
+	fs.StringVar(&client_uri, "client-uri", "", "A valid aaronland/go-flickr-api client URI.")
+	fs.BoolVar(&use_runtimevar, "use-runtimevar", false, "Signal that the -client-uri flag is encoded as a gocloud.dev/runtimevar string URI.")
+	fs.StringVar(&bucket_uri, "bucket-uri", "", "A valid gocloud.dev/blob bucket URI, or local directory, containing the files to mirror.")
+	fs.StringVar(&photoset_id, "photoset-id", "", "The ID of the photoset to mirror the files to.")
+	fs.StringVar(&removed, "removed", syncer.REMOVED_IGNORE, "What to do with photos in the photoset that have no matching file. Valid options are: ignore, remove (from the photoset), delete (the photo).")
+	fs.IntVar(&workers, "workers", uploader.DEFAULT_WORKERS, "The number of files to upload or replace concurrently.")
+	fs.Int64Var(&max_bandwidth, "max-bandwidth", 0, "The maximum number of bytes per second to upload, shared by all the workers. If 0 bandwidth is not limited.")
+	fs.Var(&include, "include", "Zero or more glob patterns for files to include. Patterns without a \"/\" are matched against file names, otherwise against paths relative to the bucket.")
+	fs.Var(&exclude, "exclude", "Zero or more glob patterns for files to exclude.")
+	fs.StringVar(&media, "media", uploader.MEDIA_ALL, "The kinds of files to include. Valid options are: all, photos, videos.")
+	fs.BoolVar(&dry_run, "dry-run", false, "If true report every action needed to mirror the files to the photoset but do not apply them.")
+	fs.Var(&params, "param", "Zero or more {KEY}={VALUE} Flickr API parameters to include with your uploads.")
+
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, wordwrap.WrapString("Command-line tool for mirroring the files in a gocloud.dev/blob bucket to a Flickr photoset. Each action is emitted to STDOUT as line-separated JSON.\n\n", 80))
+		fmt.Fprintf(os.Stderr, "Usage:\n\t%s [options]\n\n", os.Args[0])
+		fmt.Fprint(os.Stderr, "Valid options are:\n")
+		fs.PrintDefaults()
+		fmt.Fprint(os.Stderr, "\nNotes:\n\n")
+		fmt.Fprint(os.Stderr, wordwrap.WrapString("Files are matched to photos in the photoset by their \"file:sha256={HASH}\" machine tag or, failing that, by the file name (minus its extension) and the title of the photo. New files are uploaded (and tagged with their hash) and files whose contents have changed are replaced. Photos matched by their title which have no hash machine tag are compared with the file and, if they are the same, are only tagged with its hash. Finally the photoset is reordered to match the sorted order of the files.\n\nUnder the hood the sync tool is using the GoCloud blob abstraction layer for reading files. By default only local files the file:// URI scheme are supported. If you need to read files from other sources you will need to clone this application and import the relevant packages.\n", 80))
+
+		fmt.Fprintf(os.Stderr, "\n")
+	}
+
+	return fs
+}
+
+// Invoke the SyncApplication with its default FlagSet.
+func (app *SyncApplication) Run(ctx context.Context) (any, error) {
+	fs := app.DefaultFlagSet()
+	return app.RunWithFlagSet(ctx, fs)
+}
+
+// Invoke the SyncApplication with a custom FlagSet.
+func (app *SyncApplication) RunWithFlagSet(ctx context.Context, fs *flag.FlagSet) (any, error) {
+
+	flagset.Parse(fs)
+
+	err := flagset.SetFlagsFromEnvVars(fs, "FLICKR")
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to set flags from environment variables, %v", err)
+	}
+
+	if bucket_uri == "" {
+		return nil, fmt.Errorf("Missing -bucket-uri flag")
+	}
+
+	if photoset_id == "" {
+		return nil, fmt.Errorf("Missing -photoset-id flag")
+	}
+
+	args := &url.Values{}
+
+	for _, kv := range params {
+		args.Set(kv.Key(), kv.Value().(string))
+	}
+
+	if use_runtimevar {
+
+		runtime_uri, err := runtimevar.StringVar(ctx, client_uri)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to derive runtime value for client URI, %v", err)
+		}
+
+		client_uri = runtime_uri
+	}
+
+	cl, err := client.NewClient(ctx, client_uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create client, %v", err)
+	}
+
+	opts := &syncer.SyncerOptions{
+		Client:       cl,
+		Workers:      workers,
+		Args:         args,
+		Removed:      removed,
+		MaxBandwidth: max_bandwidth,
+		InputOptions: &uploader.InputOptions{
+			Include: include,
+			Exclude: exclude,
+			Media:   media,
+		},
+	}
+
+	s, err := syncer.NewSyncer(ctx, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create syncer, %v", err)
+	}
+
+	plan, err := s.Plan(ctx, bucket_uri, photoset_id)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to derive sync plan, %v", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+
+	if dry_run {
+
+		for _, a := range plan.Actions {
+
+			err := enc.Encode(a)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to encode action, %v", err)
+			}
+		}
+
+		return nil, nil
+	}
+
+	// Stop applying actions on Ctrl-C once the actions in progress have been reported.
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	failed := 0
+
+	for a := range s.Apply(ctx, plan) {
+
+		if a.Error != nil {
+			failed += 1
+		}
+
+		err := enc.Encode(a)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to encode action, %v", err)
+		}
+	}
+
+	if failed > 0 {
+		return nil, fmt.Errorf("%d of %d actions failed", failed, plan.Changes())
+	}
+
+	return nil, nil
+}
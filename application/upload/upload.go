@@ -5,13 +5,17 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"log"
 	"net/url"
 	"os"
+	"os/signal"
+	"time"
 
 	"github.com/aaronland/go-flickr-api/application"
 	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/ledger"
+	"github.com/aaronland/go-flickr-api/metadata"
 	"github.com/aaronland/go-flickr-api/reader"
+	"github.com/aaronland/go-flickr-api/uploader"
 	"github.com/aaronland/gocloud/runtimevar"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/sfomuseum/go-flags/flagset"
@@ -21,37 +25,32 @@ import (
 var params multi.KeyValueString
 var client_uri string
 var use_runtimevar bool
-
-// UploadResult is struct containing information about an atomic upload.
-type UploadResult struct {
-	// The URI of file that was uploaded.
-	Path string `json:"path,omitempty"`
-	// The Photo ID of a successfully uploaded file.
-	PhotoId int64 `json:"photoid,omitempty"`
-	// An UploadError instance if the file was not able to be uploaded.
-	Error *UploadError `json:"error,omitempty"`
-}
-
-// UploadError is a custom error type that can be JSON-serialized.
-type UploadError struct {
-	error
-}
-
-// The error message associated with this instance.
-func (e *UploadError) Error() string {
-	return e.error.Error()
-}
-
-// The error message associated with this instance.
-func (e *UploadError) String() string {
-	return e.Error()
-}
-
-// This error instance serialized as a string for JSON-marshaling.
-func (e *UploadError) MarshalJSON() ([]byte, error) {
-	err_q := fmt.Sprintf(`"%s"`, e.Error())
-	return []byte(err_q), nil
-}
+var include multi.MultiString
+var exclude multi.MultiString
+var media string
+var manifests multi.MultiString
+var ledger_uri string
+var dedup bool
+var extract_metadata bool
+var metadata_mapping string
+var photoset_ids multi.MultiString
+var create_photoset string
+var group_ids multi.MultiString
+var gallery_ids multi.MultiString
+var license_id string
+var perms string
+var workers int
+var progress_interval time.Duration
+var max_bandwidth int64
+var account_type string
+var validate bool
+var dry_run bool
+
+// UploadResult is struct containing information about an atomic upload. It is an alias for uploader.UploadResult.
+type UploadResult = uploader.UploadResult
+
+// UploadError is a custom error type that can be JSON-serialized. It is an alias for uploader.UploadError.
+type UploadError = uploader.UploadError
 
 // UploadApplication implements the application.Application interface as a commandline application for
 // uploading photos using the Flickr API
@@ -66,6 +65,26 @@ func (app *UploadApplication) DefaultFlagSet() *flag.FlagSet {
 
 	fs.StringVar(&client_uri, "client-uri", "", "A valid aaronland/go-flickr-api client URI.")
 	fs.BoolVar(&use_runtimevar, "use-runtimevar", false, "Signal that the -client-uri flag is encoded as a gocloud.dev/runtimevar string URI.")
+	fs.StringVar(&ledger_uri, "ledger-uri", "", "An optional aaronland/go-flickr-api/ledger URI used to record the status of each upload. If present files that have already been uploaded are skipped and outstanding asynchronous upload tickets are resumed rather than being uploaded again. Valid schemes are: jsonl://, mem:// (or any registered gocloud.dev/docstore scheme).")
+	fs.BoolVar(&dedup, "dedup", false, "If true tag each upload with a \"file:sha256={HASH}\" machine tag and do not upload files whose SHA-256 hash matches a completed upload in the ledger (if present) or an existing photo with the same machine tag. Duplicates are reported with the ID of the existing photo.")
+	fs.BoolVar(&extract_metadata, "metadata", false, "If true derive the title, description and tags of each upload from the EXIF, XMP or IPTC metadata embedded in each file and then assign its location and date taken. Parameters derived from embedded metadata are overridden by -param and manifest values.")
+	fs.StringVar(&metadata_mapping, "metadata-mapping", "", "An optional URI of a JSON file containing custom rules for mapping embedded metadata to Flickr API parameters. If present the -metadata flag is implied.")
+	fs.Var(&photoset_ids, "photoset-id", "Zero or more IDs of existing photosets to add each photo to once it has been uploaded.")
+	fs.StringVar(&create_photoset, "create-photoset", "", "The title of a new photoset to create, using the first photo uploaded as its primary photo, and to add each subsequent photo to. If you already have a photoset with this title photos are added to it instead.")
+	fs.Var(&group_ids, "group-id", "Zero or more IDs of groups whose pools each photo is added to once it has been uploaded.")
+	fs.Var(&gallery_ids, "gallery-id", "Zero or more IDs of galleries to add each photo to once it has been uploaded.")
+	fs.StringVar(&license_id, "license-id", "", "The ID of the license to assign to each photo once it has been uploaded.")
+	fs.StringVar(&perms, "perms", "", "The permissions to assign to each photo once it has been uploaded. Valid options are: public, private, friends, family, \"friends,family\".")
+	fs.IntVar(&workers, "workers", uploader.DEFAULT_WORKERS, "The number of files to upload concurrently.")
+	fs.DurationVar(&progress_interval, "progress-interval", 5*time.Second, "How often to report progress (files processed, bytes sent and the estimated time remaining) to STDERR. If STDERR is a terminal progress is drawn as a progress bar. If 0 progress is not reported.")
+	fs.Int64Var(&max_bandwidth, "max-bandwidth", 0, "The maximum number of bytes per second to upload, shared by all the workers. If 0 bandwidth is not limited.")
+	fs.StringVar(&account_type, "account-type", uploader.ACCOUNT_FREE, "The type of Flickr account being uploaded to, used to determine the maximum size and duration of files. Valid options are: free, pro.")
+	fs.BoolVar(&validate, "validate", false, "If true validate the format, size and (video) duration of every file, and the values of enumerated parameters like safety_level, content_type, hidden and is_public, before uploading any files. If any files are invalid their problems are reported and nothing is uploaded.")
+	fs.BoolVar(&dry_run, "dry-run", false, "If true validate every file, as with the -validate flag, and report the results but do not upload anything.")
+	fs.Var(&include, "include", "Zero or more glob patterns for files to include when walking directories. Patterns without a \"/\" are matched against file names, otherwise against paths relative to the directory being walked.")
+	fs.Var(&exclude, "exclude", "Zero or more glob patterns for files to exclude when walking directories.")
+	fs.StringVar(&media, "media", uploader.MEDIA_ALL, "The kinds of files to include when walking directories. Valid options are: all, photos, videos.")
+	fs.Var(&manifests, "manifest", "Zero or more CSV or JSONL manifest files where each row specifies the path to a file and any parameters specific to that file. Per-file parameters override -param values.")
 	fs.Var(&params, "param", "Zero or more {KEY}={VALUE} Flickr API parameters to include with your uploads.")
 
 	fs.Usage = func() {
@@ -99,7 +118,73 @@ func (app *UploadApplication) RunWithFlagSet(ctx context.Context, fs *flag.FlagS
 		return nil, fmt.Errorf("Failed to set flags from environment variables, %v", err)
 	}
 
-	paths := fs.Args()
+	input_opts := &uploader.InputOptions{
+		Include: include,
+		Exclude: exclude,
+		Media:   media,
+	}
+
+	inputs, err := uploader.ExpandPaths(ctx, fs.Args(), input_opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to derive inputs, %v", err)
+	}
+
+	for _, uri := range manifests {
+
+		manifest_inputs, err := uploader.ReadManifest(ctx, uri)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read manifest '%s', %v", uri, err)
+		}
+
+		inputs = append(inputs, manifest_inputs...)
+	}
+
+	args := &url.Values{}
+
+	for _, kv := range params {
+		args.Set(kv.Key(), kv.Value().(string))
+	}
+
+	// Validate all the files up front, before creating a client, so that problems are reported before anything is uploaded.
+
+	if validate || dry_run {
+
+		limits, err := uploader.AccountLimits(account_type)
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid -account-type flag, %v", err)
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		invalid := 0
+
+		for rsp := range uploader.ValidateInputs(ctx, inputs, args, limits) {
+
+			if rsp.Valid() && !dry_run {
+				continue
+			}
+
+			if !rsp.Valid() {
+				invalid += 1
+			}
+
+			err := enc.Encode(rsp)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to encode validation result, %v", err)
+			}
+		}
+
+		if invalid > 0 {
+			return nil, fmt.Errorf("%d of %d files failed validation", invalid, len(inputs))
+		}
+
+		if dry_run {
+			return nil, nil
+		}
+	}
 
 	if use_runtimevar {
 
@@ -118,70 +203,126 @@ func (app *UploadApplication) RunWithFlagSet(ctx context.Context, fs *flag.FlagS
 		return nil, fmt.Errorf("Failed to create client, %v", err)
 	}
 
-	args := &url.Values{}
+	var led ledger.Ledger
 
-	for _, kv := range params {
-		args.Set(kv.Key(), kv.Value().(string))
+	if ledger_uri != "" {
+
+		l, err := ledger.NewLedger(ctx, ledger_uri)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create ledger, %v", err)
+		}
+
+		defer l.Close()
+		led = l
 	}
 
-	done_ch := make(chan bool)
-	rsp_ch := make(chan *UploadResult)
+	var mapping *metadata.Mapping
 
-	for _, path := range paths {
+	switch {
+	case metadata_mapping != "":
 
-		go func(path string) {
+		fh, err := reader.NewReader(ctx, metadata_mapping)
 
-			defer func() {
-				done_ch <- true
-			}()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to open metadata mapping, %v", err)
+		}
 
-			rsp := &UploadResult{
-				Path: path,
-			}
+		defer fh.Close()
 
-			fh, err := reader.NewReader(ctx, path)
+		m, err := metadata.ReadMapping(fh)
 
-			if err != nil {
-				rsp.Error = &UploadError{fmt.Errorf("Failed to create reader for '%s', %v", path, err)}
-				rsp_ch <- rsp
-				return
-			}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read metadata mapping, %v", err)
+		}
+
+		mapping = m
 
-			defer fh.Close()
+	case extract_metadata:
+		mapping = metadata.DefaultMapping()
+	}
 
-			photo_id, err := client.UploadAsyncWithClient(ctx, cl, fh, args)
+	actions := make([]uploader.Action, 0)
 
-			if err != nil {
-				rsp.Error = &UploadError{fmt.Errorf("Failed to upload image '%s', %v", path, err)}
-				rsp_ch <- rsp
-				return
-			}
+	for _, id := range photoset_ids {
+		actions = append(actions, uploader.NewAddToPhotosetAction(id))
+	}
+
+	if create_photoset != "" {
+		actions = append(actions, uploader.NewCreatePhotosetAction(create_photoset, ""))
+	}
+
+	for _, id := range group_ids {
+		actions = append(actions, uploader.NewAddToGroupAction(id))
+	}
+
+	for _, id := range gallery_ids {
+		actions = append(actions, uploader.NewAddToGalleryAction(id))
+	}
 
-			rsp.PhotoId = photo_id
-			rsp_ch <- rsp
-			return
-		}(path)
+	if license_id != "" {
+		actions = append(actions, uploader.NewSetLicenseAction(license_id))
 	}
 
-	remaining := len(paths)
-	results := make([]*UploadResult, 0)
+	if perms != "" {
+
+		a, err := uploader.NewSetPermsAction(perms)
 
-	for remaining > 0 {
-		select {
-		case <-done_ch:
-			remaining -= 1
-		case rsp := <-rsp_ch:
-			results = append(results, rsp)
-		default:
-			// pass
+		if err != nil {
+			return nil, fmt.Errorf("Invalid -perms flag, %v", err)
 		}
+
+		actions = append(actions, a)
 	}
 
-	enc := json.NewEncoder(os.Stdout)
-	err = enc.Encode(results)
+	// Cancel outstanding uploads on Ctrl-C so that their status is recorded (and results are reported) before exiting.
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	opts := &uploader.UploaderOptions{
+		Client:       cl,
+		Ledger:       led,
+		Workers:      workers,
+		Args:         args,
+		Dedup:        dedup,
+		Metadata:     mapping,
+		Actions:      actions,
+		MaxBandwidth: max_bandwidth,
+	}
+
+	up, err := uploader.NewUploader(ctx, opts)
 
 	if err != nil {
-		log.Fatalf("Failed to encode results, %v", err)
+		return nil, fmt.Errorf("Failed to create uploader, %v", err)
+	}
+
+	if progress_interval > 0 {
+		stop_progress := application.ReportProgress(ctx, up, progress_interval)
+		defer stop_progress()
+	}
+
+	// Results are emitted as line-separated JSON as soon as each upload completes.
+
+	enc := json.NewEncoder(os.Stdout)
+
+	failed := 0
+
+	for rsp := range up.UploadInputs(ctx, inputs) {
+
+		if rsp.Error != nil {
+			failed += 1
+		}
+
+		err := enc.Encode(rsp)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to encode result, %v", err)
+		}
+	}
+
+	if failed > 0 {
+		return nil, fmt.Errorf("%d of %d files failed to upload", failed, len(inputs))
 	}
 
 	return nil, nil
@@ -0,0 +1,601 @@
+// package backup provides a bounded pool of workers for downloading the photos matching a Flickr API "standard photo
+// response" query, along with a JSON sidecar file for each photo containing its metadata, EXIF data and comments, to a
+// gocloud.dev/blob bucket. Backups are incremental: photos whose "lastupdate" date has not changed since they were last
+// backed up are skipped.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// The default number of photos to back up concurrently.
+const DEFAULT_WORKERS int = 4
+
+// The "standard photo response" extras containing the URLs of each photo, in order of preference. The first URL present
+// in the response for a photo is the one that is downloaded.
+var URL_EXTRAS = []string{
+	"url_o",  // original
+	"url_4k", // has a unique secret; photo owner can restrict (4096)
+	"url_f",  // has a unique secret; photo owner can restrict (4096)
+	"url_k",  // has a unique secret; photo owner can restrict (2048)
+	"url_b",  // 1024
+}
+
+// Sidecar is a struct containing the metadata for a photo that has been backed up. It is stored as JSON alongside the
+// photo in the bucket.
+type Sidecar struct {
+	// The unique ID of the photo.
+	PhotoId int64 `json:"id"`
+	// The Unix timestamp when the photo was last updated, as reported by the Flickr API.
+	LastUpdate int64 `json:"lastupdate"`
+	// The URL of the photo that was downloaded.
+	URL string `json:"url"`
+	// The key of the downloaded photo in the bucket.
+	Path string `json:"path"`
+	// The "photo" element of the flickr.photos.getInfo API method for the photo.
+	Info json.RawMessage `json:"info"`
+	// The "exif" element of the flickr.photos.getExif API method for the photo, if the photo's EXIF data is available.
+	Exif json.RawMessage `json:"exif,omitempty"`
+	// The "comment" element of the flickr.photos.comments.getList API method for the photo.
+	Comments json.RawMessage `json:"comments,omitempty"`
+}
+
+// BackupResult is a struct containing information about the backup of a single photo.
+type BackupResult struct {
+	// The unique ID of the photo.
+	PhotoId int64 `json:"photoid,omitempty"`
+	// The key of the downloaded photo in the bucket.
+	Path string `json:"path,omitempty"`
+	// A boolean flag indicating the photo was not backed up because it has not been updated since it was last backed up.
+	Skipped bool `json:"skipped,omitempty"`
+	// A BackupError instance if the photo could not be backed up.
+	Error *BackupError `json:"error,omitempty"`
+}
+
+// BackupError is a custom error type that can be JSON-serialized.
+type BackupError struct {
+	error
+}
+
+// The error message associated with this instance.
+func (e *BackupError) Error() string {
+	return e.error.Error()
+}
+
+// The error message associated with this instance.
+func (e *BackupError) String() string {
+	return e.Error()
+}
+
+// Return the underlying error so that BackupError instances can be used with errors.Is and errors.As.
+func (e *BackupError) Unwrap() error {
+	return e.error
+}
+
+// This error instance serialized as a string for JSON-marshaling.
+func (e *BackupError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Error())
+}
+
+// Progress is a struct containing a snapshot of the progress of a Backup instance.
+type Progress struct {
+	// The number of photos that have been processed, whether they were downloaded, skipped or failed.
+	Done int64
+	// The number of photos that failed to be backed up.
+	Failed int64
+	// The number of photos that were skipped because they had not been updated since they were last backed up.
+	Skipped int64
+	// The number of bytes downloaded.
+	BytesDownloaded int64
+	// The amount of time since the backup started.
+	Elapsed time.Duration
+}
+
+// Return the progress as a human-readable string.
+func (p *Progress) String() string {
+	return fmt.Sprintf("%d photos processed (%d skipped, %d failed), %.2f MB downloaded in %v", p.Done, p.Skipped, p.Failed, float64(p.BytesDownloaded)/1024/1024, p.Elapsed.Round(time.Second))
+}
+
+// BackupOptions is a struct containing configuration details for a new Backup instance.
+type BackupOptions struct {
+	// The Client used to call the Flickr API.
+	Client client.Client
+	// The bucket that photos and sidecar files are written to.
+	Bucket *blob.Bucket
+	// The number of photos to back up concurrently. If 0 DEFAULT_WORKERS is used.
+	Workers int
+	// An optional http.Client used to download photos. If nil http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// Backup downloads photos, and their metadata, from Flickr to a gocloud.dev/blob bucket using a bounded pool of workers.
+type Backup struct {
+	client      client.Client
+	bucket      *blob.Bucket
+	workers     int
+	http_client *http.Client
+	started     time.Time
+	done        atomic.Int64
+	failed      atomic.Int64
+	skipped     atomic.Int64
+	bytes       atomic.Int64
+}
+
+// NewBackup returns a new Backup instance configured by 'opts'.
+func NewBackup(ctx context.Context, opts *BackupOptions) (*Backup, error) {
+
+	if opts.Client == nil {
+		return nil, fmt.Errorf("Missing client")
+	}
+
+	if opts.Bucket == nil {
+		return nil, fmt.Errorf("Missing bucket")
+	}
+
+	workers := opts.Workers
+
+	if workers == 0 {
+		workers = DEFAULT_WORKERS
+	}
+
+	if workers < 0 {
+		return nil, fmt.Errorf("Invalid number of workers")
+	}
+
+	http_client := opts.HTTPClient
+
+	if http_client == nil {
+		http_client = http.DefaultClient
+	}
+
+	b := &Backup{
+		client:      opts.Client,
+		bucket:      opts.Bucket,
+		workers:     workers,
+		http_client: http_client,
+		started:     time.Now(),
+	}
+
+	return b, nil
+}
+
+// Backup returns an iterator that backs up each of the photos matching the "standard photo response" query in 'args',
+// for example "method=flickr.people.getPhotos&user_id=me", using up to the Backup's number of workers concurrently, and
+// yields the result for each photo as soon as it completes. Queries using the flickr.photos.search method are sliced in
+// to date windows in order to work around the API's (roughly) 4000 result limit. Breaking out of the loop cancels any
+// downloads still in progress. The 'args' passed to this method are not modified.
+func (b *Backup) Backup(ctx context.Context, args *url.Values) iter.Seq[*BackupResult] {
+
+	return func(yield func(*BackupResult) bool) {
+
+		backup_ctx, cancel := context.WithCancel(ctx)
+
+		query := queryArgs(args)
+
+		var photos iter.Seq2[gjson.Result, error]
+
+		if query.Get("method") == "flickr.photos.search" {
+			photos = client.SearchPhotosWithClient(backup_ctx, b.client, query, nil)
+		} else {
+			photos = client.ExecuteMethodItemsWithClient(backup_ctx, b.client, query, nil)
+		}
+
+		photos_ch := make(chan gjson.Result)
+		results_ch := make(chan *BackupResult)
+
+		wg := new(sync.WaitGroup)
+
+		for i := 0; i < b.workers; i++ {
+
+			wg.Add(1)
+
+			go func() {
+
+				defer wg.Done()
+
+				for ph := range photos_ch {
+
+					rsp := b.backupPhoto(backup_ctx, ph)
+
+					b.done.Add(1)
+
+					switch {
+					case rsp.Error != nil:
+						b.failed.Add(1)
+					case rsp.Skipped:
+						b.skipped.Add(1)
+					}
+
+					results_ch <- rsp
+				}
+			}()
+		}
+
+		// Errors querying the API end the backup and are reported as a result without a photo ID.
+
+		wg.Add(1)
+
+		go func() {
+
+			defer wg.Done()
+			defer close(photos_ch)
+
+			for ph, err := range photos {
+
+				if err != nil {
+
+					if backup_ctx.Err() == nil {
+						results_ch <- &BackupResult{Error: &BackupError{fmt.Errorf("Failed to execute query, %w", err)}}
+					}
+
+					return
+				}
+
+				select {
+				case photos_ch <- ph:
+					// pass
+				case <-backup_ctx.Done():
+					return
+				}
+			}
+		}()
+
+		go func() {
+			wg.Wait()
+			close(results_ch)
+		}()
+
+		// Ensure that all the workers have stopped before returning.
+
+		defer func() {
+
+			cancel()
+
+			for range results_ch {
+				// pass
+			}
+		}()
+
+		for rsp := range results_ch {
+
+			if !yield(rsp) {
+				return
+			}
+		}
+	}
+}
+
+// Progress returns a snapshot of the progress of the Backup.
+func (b *Backup) Progress() *Progress {
+
+	p := &Progress{
+		Done:            b.done.Load(),
+		Failed:          b.failed.Load(),
+		Skipped:         b.skipped.Load(),
+		BytesDownloaded: b.bytes.Load(),
+		Elapsed:         time.Since(b.started),
+	}
+
+	return p
+}
+
+// SidecarPath returns the key of the sidecar file for 'photo_id' in a backup bucket.
+func SidecarPath(photo_id int64) string {
+	return fmt.Sprintf("%d.json", photo_id)
+}
+
+// PhotoPath returns the key of the photo for 'photo_id', downloaded from 'photo_url', in a backup bucket.
+func PhotoPath(photo_id int64, photo_url string) string {
+
+	ext := ".jpg"
+
+	u, err := url.Parse(photo_url)
+
+	if err == nil && path.Ext(u.Path) != "" {
+		ext = strings.ToLower(path.Ext(u.Path))
+	}
+
+	return fmt.Sprintf("%d%s", photo_id, ext)
+}
+
+// ReadSidecar returns the Sidecar for 'photo_id' in 'bucket'. If there is no sidecar for the photo it returns a
+// nil Sidecar and a nil error.
+func ReadSidecar(ctx context.Context, bucket *blob.Bucket, photo_id int64) (*Sidecar, error) {
+
+	body, err := bucket.ReadAll(ctx, SidecarPath(photo_id))
+
+	if err != nil {
+
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var sidecar *Sidecar
+
+	err = json.Unmarshal(body, &sidecar)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal sidecar, %w", err)
+	}
+
+	return sidecar, nil
+}
+
+// backupPhoto backs up the photo described by the "standard photo response" element 'ph'. If the sidecar for the photo
+// shows that it has not been updated since it was last backed up it is skipped. If it has been updated but its URL has
+// not changed then only the sidecar is updated. The sidecar is written last so that photos which fail to be backed
+// up are retried.
+func (b *Backup) backupPhoto(ctx context.Context, ph gjson.Result) *BackupResult {
+
+	photo_id := ph.Get("id").Int()
+	lastupdate := ph.Get("lastupdate").Int()
+
+	rsp := &BackupResult{
+		PhotoId: photo_id,
+	}
+
+	if photo_id == 0 {
+		rsp.Error = &BackupError{fmt.Errorf("Missing photo ID")}
+		return rsp
+	}
+
+	photo_url := ""
+
+	for _, extra := range URL_EXTRAS {
+
+		v := ph.Get(extra).String()
+
+		if v != "" {
+			photo_url = v
+			break
+		}
+	}
+
+	if photo_url == "" {
+		rsp.Error = &BackupError{fmt.Errorf("Failed to derive URL for photo %d", photo_id)}
+		return rsp
+	}
+
+	photo_path := PhotoPath(photo_id, photo_url)
+	rsp.Path = photo_path
+
+	prev, err := ReadSidecar(ctx, b.bucket, photo_id)
+
+	if err != nil {
+		rsp.Error = &BackupError{fmt.Errorf("Failed to read sidecar for photo %d, %w", photo_id, err)}
+		return rsp
+	}
+
+	if prev != nil && prev.LastUpdate >= lastupdate && prev.Path == photo_path {
+		rsp.Skipped = true
+		return rsp
+	}
+
+	download := true
+
+	if prev != nil && prev.URL == photo_url && prev.Path == photo_path {
+
+		exists, err := b.bucket.Exists(ctx, photo_path)
+
+		if err == nil && exists {
+			download = false
+		}
+	}
+
+	if download {
+
+		err := b.download(ctx, photo_url, photo_path)
+
+		if err != nil {
+			rsp.Error = &BackupError{fmt.Errorf("Failed to download photo %d, %w", photo_id, err)}
+			return rsp
+		}
+	}
+
+	sidecar := &Sidecar{
+		PhotoId:    photo_id,
+		LastUpdate: lastupdate,
+		URL:        photo_url,
+		Path:       photo_path,
+	}
+
+	info, err := b.executeMethod(ctx, "flickr.photos.getInfo", photo_id, "photo")
+
+	if err != nil {
+		rsp.Error = &BackupError{fmt.Errorf("Failed to retrieve info for photo %d, %w", photo_id, err)}
+		return rsp
+	}
+
+	sidecar.Info = info
+
+	// EXIF data may be hidden by the photo's owner (error code 2, permission denied) in which case it is omitted. Any
+	// other error fails the backup so that the photo is retried rather than recorded as up to date without its EXIF data.
+
+	exif, err := b.executeMethod(ctx, "flickr.photos.getExif", photo_id, "photo.exif")
+
+	var api_err *response.Error
+
+	switch {
+	case err == nil:
+		sidecar.Exif = exif
+	case errors.As(err, &api_err) && api_err.Code == 2:
+		// pass
+	default:
+		rsp.Error = &BackupError{fmt.Errorf("Failed to retrieve EXIF data for photo %d, %w", photo_id, err)}
+		return rsp
+	}
+
+	comments, err := b.executeMethod(ctx, "flickr.photos.comments.getList", photo_id, "comments")
+
+	if err != nil {
+		rsp.Error = &BackupError{fmt.Errorf("Failed to retrieve comments for photo %d, %w", photo_id, err)}
+		return rsp
+	}
+
+	// The Flickr API omits the "comment" element entirely for photos without any comments.
+
+	sidecar.Comments = json.RawMessage("[]")
+
+	comment_rsp := gjson.GetBytes(comments, "comment")
+
+	if comment_rsp.Exists() {
+		sidecar.Comments = json.RawMessage(comment_rsp.Raw)
+	}
+
+	enc_sidecar, err := json.Marshal(sidecar)
+
+	if err != nil {
+		rsp.Error = &BackupError{fmt.Errorf("Failed to marshal sidecar for photo %d, %w", photo_id, err)}
+		return rsp
+	}
+
+	err = b.bucket.WriteAll(ctx, SidecarPath(photo_id), enc_sidecar, nil)
+
+	if err != nil {
+		rsp.Error = &BackupError{fmt.Errorf("Failed to write sidecar for photo %d, %w", photo_id, err)}
+		return rsp
+	}
+
+	return rsp
+}
+
+// download copies the photo at 'photo_url' to 'photo_path' in the Backup's bucket.
+func (b *Backup) download(ctx context.Context, photo_url string, photo_path string) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, photo_url, nil)
+
+	if err != nil {
+		return fmt.Errorf("Failed to create request, %w", err)
+	}
+
+	http_rsp, err := b.http_client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("Failed to execute request, %w", err)
+	}
+
+	defer http_rsp.Body.Close()
+
+	if http_rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Request failed with status '%s'", http_rsp.Status)
+	}
+
+	opts := &blob.WriterOptions{
+		ContentType: http_rsp.Header.Get("Content-Type"),
+	}
+
+	// Cancelling the context before the writer is closed discards the partially written photo.
+
+	write_ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wr, err := b.bucket.NewWriter(write_ctx, photo_path, opts)
+
+	if err != nil {
+		return fmt.Errorf("Failed to create writer, %w", err)
+	}
+
+	n, err := io.Copy(wr, http_rsp.Body)
+
+	b.bytes.Add(n)
+
+	if err != nil {
+		cancel()
+		wr.Close()
+		return fmt.Errorf("Failed to copy photo, %w", err)
+	}
+
+	err = wr.Close()
+
+	if err != nil {
+		return fmt.Errorf("Failed to close writer, %w", err)
+	}
+
+	return nil
+}
+
+// executeMethod calls the API method 'method' for 'photo_id' and returns the raw JSON of the element at 'path' in the
+// response.
+func (b *Backup) executeMethod(ctx context.Context, method string, photo_id int64, path string) (json.RawMessage, error) {
+
+	args := &url.Values{}
+	args.Set("method", method)
+	args.Set("photo_id", strconv.FormatInt(photo_id, 10))
+
+	fh, err := b.client.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response, %w", err)
+	}
+
+	err = response.CheckMethodStatusBytes(body, method)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := gjson.GetBytes(body, path)
+
+	if !rsp.Exists() {
+		return nil, fmt.Errorf("Response is missing %s", path)
+	}
+
+	return json.RawMessage(rsp.Raw), nil
+}
+
+// queryArgs returns a copy of 'args' whose "extras" parameter includes URL_EXTRAS and "lastupdate".
+func queryArgs(args *url.Values) *url.Values {
+
+	query := &url.Values{}
+
+	for k, v := range *args {
+		(*query)[k] = v
+	}
+
+	extras := make([]string, 0)
+
+	if query.Get("extras") != "" {
+		extras = strings.Split(query.Get("extras"), ",")
+	}
+
+	for _, v := range append(slices.Clone(URL_EXTRAS), "lastupdate") {
+
+		if !slices.Contains(extras, v) {
+			extras = append(extras, v)
+		}
+	}
+
+	query.Set("extras", strings.Join(extras, ","))
+	return query
+}
@@ -0,0 +1,282 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/fileblob"
+)
+
+func TestBackup(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	last_update := time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+
+	for i := 0; i < 5; i++ {
+
+		ph := &flickrtest.Photo{
+			Owner:          svr.UserId,
+			Title:          fmt.Sprintf("Photo %d", i),
+			OriginalFormat: "jpg",
+			Body:           []byte(fmt.Sprintf("photo %d", i)),
+			ContentType:    "image/jpeg",
+			LastUpdate:     last_update,
+			Exif:           map[string]string{"Make": "Camera"},
+		}
+
+		// The last photo has no comments
+
+		if i < 4 {
+			ph.Comments = []*flickrtest.Comment{
+				{Id: fmt.Sprintf("c%d", i), Author: "999@N00", Body: "Nice", DateCreate: last_update},
+			}
+		}
+
+		svr.Store.AddPhoto(ph)
+	}
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	bucket, err := blob.OpenBucket(ctx, "file://"+t.TempDir())
+
+	if err != nil {
+		t.Fatalf("Failed to open bucket, %v", err)
+	}
+
+	defer bucket.Close()
+
+	opts := &BackupOptions{
+		Client:  cl,
+		Bucket:  bucket,
+		Workers: 3,
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.people.getPhotos")
+	args.Set("user_id", "me")
+	args.Set("per_page", "2")
+
+	backup := func() (int, int) {
+
+		b, err := NewBackup(ctx, opts)
+
+		if err != nil {
+			t.Fatalf("Failed to create backup, %v", err)
+		}
+
+		backed_up := 0
+		skipped := 0
+
+		for rsp := range b.Backup(ctx, args) {
+
+			if rsp.Error != nil {
+				t.Fatalf("Failed to back up photo %d, %v", rsp.PhotoId, rsp.Error)
+			}
+
+			if rsp.Skipped {
+				skipped += 1
+			} else {
+				backed_up += 1
+			}
+		}
+
+		return backed_up, skipped
+	}
+
+	backed_up, skipped := backup()
+
+	if backed_up != 5 || skipped != 0 {
+		t.Fatalf("Unexpected results for initial backup, %d backed up, %d skipped", backed_up, skipped)
+	}
+
+	if args.Get("extras") != "" {
+		t.Fatalf("Backup modified query arguments")
+	}
+
+	for _, ph := range svr.Store.Photos() {
+
+		body, err := bucket.ReadAll(ctx, fmt.Sprintf("%d.jpg", ph.Id))
+
+		if err != nil {
+			t.Fatalf("Failed to read photo %d, %v", ph.Id, err)
+		}
+
+		if !bytes.Equal(body, ph.Body) {
+			t.Fatalf("Unexpected body for photo %d", ph.Id)
+		}
+
+		sidecar, err := ReadSidecar(ctx, bucket, ph.Id)
+
+		if err != nil || sidecar == nil {
+			t.Fatalf("Failed to read sidecar for photo %d, %v", ph.Id, err)
+		}
+
+		if sidecar.LastUpdate != last_update.Unix() {
+			t.Fatalf("Unexpected lastupdate for photo %d, %d", ph.Id, sidecar.LastUpdate)
+		}
+
+		if gjson.GetBytes(sidecar.Info, "title._content").String() != ph.Title {
+			t.Fatalf("Unexpected info for photo %d, %s", ph.Id, string(sidecar.Info))
+		}
+
+		if len(gjson.ParseBytes(sidecar.Exif).Array()) != 1 {
+			t.Fatalf("Unexpected EXIF data for photo %d, %s", ph.Id, string(sidecar.Exif))
+		}
+
+		if len(ph.Comments) == 0 {
+
+			if string(sidecar.Comments) != "[]" {
+				t.Fatalf("Unexpected comments for photo %d, %s", ph.Id, string(sidecar.Comments))
+			}
+
+		} else if gjson.GetBytes(sidecar.Comments, "0._content").String() != "Nice" {
+			t.Fatalf("Unexpected comments for photo %d, %s", ph.Id, string(sidecar.Comments))
+		}
+	}
+
+	// Only photos which have been updated since the last backup are backed up again. Updating a photo in the store sets
+	// its lastupdate date to the current time.
+
+	updated := svr.Store.Photos()[0]
+
+	err = svr.Store.UpdatePhoto(updated.Id, func(ph *flickrtest.Photo) error {
+		ph.Title = "Updated"
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Failed to update photo, %v", err)
+	}
+
+	backed_up, skipped = backup()
+
+	if backed_up != 1 || skipped != 4 {
+		t.Fatalf("Unexpected results for incremental backup, %d backed up, %d skipped", backed_up, skipped)
+	}
+
+	sidecar, err := ReadSidecar(ctx, bucket, updated.Id)
+
+	if err != nil {
+		t.Fatalf("Failed to read sidecar, %v", err)
+	}
+
+	if gjson.GetBytes(sidecar.Info, "title._content").String() != "Updated" {
+		t.Fatalf("Sidecar was not updated, %s", string(sidecar.Info))
+	}
+}
+
+func TestBackupExifErrors(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	hidden := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner:          svr.UserId,
+		Title:          "Hidden",
+		OriginalFormat: "jpg",
+		Body:           []byte("hidden"),
+		ContentType:    "image/jpeg",
+	})
+
+	broken := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner:          svr.UserId,
+		Title:          "Broken",
+		OriginalFormat: "jpg",
+		Body:           []byte("broken"),
+		ContentType:    "image/jpeg",
+	})
+
+	// The owner of one photo has hidden its EXIF data and retrieving the EXIF data for the other fails
+
+	err := svr.HandleMethod("flickr.photos.getExif", "none", func(req *flickrtest.Request) (map[string]any, error) {
+
+		switch req.Args.Get("photo_id") {
+		case strconv.FormatInt(hidden.Id, 10):
+			return nil, &response.Error{Code: 2, Message: "Permission denied"}
+		default:
+			return nil, &response.Error{Code: 1, Message: "Photo not found"}
+		}
+	})
+
+	if err != nil {
+		t.Fatalf("Failed to register getExif handler, %v", err)
+	}
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	bucket, err := blob.OpenBucket(ctx, "file://"+t.TempDir())
+
+	if err != nil {
+		t.Fatalf("Failed to open bucket, %v", err)
+	}
+
+	defer bucket.Close()
+
+	b, err := NewBackup(ctx, &BackupOptions{Client: cl, Bucket: bucket})
+
+	if err != nil {
+		t.Fatalf("Failed to create backup, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.people.getPhotos")
+	args.Set("user_id", "me")
+
+	for rsp := range b.Backup(ctx, args) {
+
+		switch rsp.PhotoId {
+		case hidden.Id:
+
+			if rsp.Error != nil {
+				t.Fatalf("Failed to back up photo with hidden EXIF data, %v", rsp.Error)
+			}
+
+		case broken.Id:
+
+			if rsp.Error == nil {
+				t.Fatalf("Expected backup of photo %d to fail", broken.Id)
+			}
+
+		default:
+			t.Fatalf("Unexpected result, %v", rsp)
+		}
+	}
+
+	sidecar, err := ReadSidecar(ctx, bucket, hidden.Id)
+
+	if err != nil || sidecar == nil || sidecar.Exif != nil {
+		t.Fatalf("Unexpected sidecar for photo with hidden EXIF data, %v (%v)", sidecar, err)
+	}
+
+	// Photos that failed are not recorded as backed up so that they are retried the next time
+
+	sidecar, err = ReadSidecar(ctx, bucket, broken.Id)
+
+	if err != nil || sidecar != nil {
+		t.Fatalf("Expected no sidecar for photo %d, %v (%v)", broken.Id, sidecar, err)
+	}
+}
@@ -0,0 +1,508 @@
+package client
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// The kinds of requests stored in a Cassette.
+const (
+	// An ExecuteMethod request.
+	INTERACTION_EXECUTE string = "execute"
+	// An Upload request.
+	INTERACTION_UPLOAD string = "upload"
+	// A Replace request.
+	INTERACTION_REPLACE string = "replace"
+	// A GetRequestToken request.
+	INTERACTION_REQUEST_TOKEN string = "request_token"
+	// A GetAccessToken request.
+	INTERACTION_ACCESS_TOKEN string = "access_token"
+)
+
+// Interaction is a struct containing a single recorded request and response.
+type Interaction struct {
+	// The normalized key for the request, as returned by the InteractionKey method.
+	Key string `json:"key"`
+	// The body of the response. Responses that are not valid UTF-8 are stored in BodyBase64 instead.
+	Body string `json:"body,omitempty"`
+	// The base64-encoded body of the response.
+	BodyBase64 string `json:"body_base64,omitempty"`
+	// The HTTP status code for requests that failed with a *StatusError.
+	StatusCode int `json:"status_code,omitempty"`
+	// The HTTP status for requests that failed with a *StatusError.
+	Status string `json:"status,omitempty"`
+	// The numeric code for requests that failed with a *response.Error.
+	ErrorCode int `json:"error_code,omitempty"`
+	// The error message for requests that failed with a *response.Error or any other error.
+	Error string `json:"error,omitempty"`
+}
+
+// Cassette is a collection of recorded Interactions, keyed by normalized request, that are stored on disk
+// either as a single JSONL file (one Interaction per line) or as a directory of JSON files (one Interaction per file).
+type Cassette struct {
+	mu           sync.Mutex
+	path         string
+	is_dir       bool
+	interactions map[string][]*Interaction
+	offsets      map[string]int
+	// The number of Interactions written to a directory, keyed by the hashed prefix of their file names.
+	counts map[string]int
+}
+
+// re_interaction_file matches the names of files written by Cassette.Record in directory mode.
+var re_interaction_file = regexp.MustCompile(`^([0-9a-f]{16})-(\d+)\.json$`)
+
+// InteractionKey returns the normalized key for a request of type 'kind' with arguments 'args'. Arguments are sorted
+// and "oauth_*" parameters, which change with every request, are removed as are the "format=json" and "nojsoncallback"
+// parameters that the OAuth1Client assigns by default.
+func InteractionKey(kind string, args *url.Values) string {
+
+	q := url.Values{}
+
+	if args != nil {
+
+		for k, v := range *args {
+
+			if strings.HasPrefix(k, "oauth_") || k == "nojsoncallback" || k == "api_key" {
+				continue
+			}
+
+			if k == "format" && len(v) == 1 && v[0] == "json" {
+				continue
+			}
+
+			q[k] = v
+		}
+	}
+
+	return fmt.Sprintf("%s %s", kind, q.Encode())
+}
+
+// NewCassette returns a new, empty, Cassette instance that will be written to 'path'. If 'path' ends in a
+// path separator, or is an existing directory, Interactions are written as individual JSON files. Files for
+// Interactions already recorded in an existing directory are never overwritten; new Interactions for the same
+// request are numbered after them.
+func NewCassette(path string) (*Cassette, error) {
+
+	is_dir := strings.HasSuffix(path, string(os.PathSeparator))
+
+	info, err := os.Stat(path)
+
+	if err == nil && info.IsDir() {
+		is_dir = true
+	}
+
+	if is_dir {
+
+		err := os.MkdirAll(path, 0755)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create cassette directory, %w", err)
+		}
+	}
+
+	c := &Cassette{
+		path:         path,
+		is_dir:       is_dir,
+		interactions: make(map[string][]*Interaction),
+		offsets:      make(map[string]int),
+		counts:       make(map[string]int),
+	}
+
+	if is_dir {
+
+		entries, err := os.ReadDir(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read cassette directory, %w", err)
+		}
+
+		for _, e := range entries {
+
+			m := re_interaction_file.FindStringSubmatch(e.Name())
+
+			if e.IsDir() || m == nil {
+				continue
+			}
+
+			seq, err := strconv.Atoi(m[2])
+
+			if err != nil {
+				continue
+			}
+
+			c.counts[m[1]] = max(c.counts[m[1]], seq+1)
+		}
+	}
+
+	return c, nil
+}
+
+// ReadCassette reads the Interactions stored in 'path', which may be either a JSONL file or a directory of JSON files.
+func ReadCassette(path string) (*Cassette, error) {
+
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to stat cassette, %w", err)
+	}
+
+	c := &Cassette{
+		path:         path,
+		is_dir:       info.IsDir(),
+		interactions: make(map[string][]*Interaction),
+		offsets:      make(map[string]int),
+		counts:       make(map[string]int),
+	}
+
+	if !c.is_dir {
+
+		err := c.readJSONL(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return c, nil
+	}
+
+	entries, err := os.ReadDir(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read cassette directory, %w", err)
+	}
+
+	// os.ReadDir returns entries sorted by filename which preserves the order in which they were recorded.
+
+	for _, e := range entries {
+
+		if e.IsDir() {
+			continue
+		}
+
+		fname := filepath.Join(path, e.Name())
+
+		switch filepath.Ext(e.Name()) {
+		case ".jsonl":
+
+			err := c.readJSONL(fname)
+
+			if err != nil {
+				return nil, err
+			}
+
+		case ".json":
+
+			body, err := os.ReadFile(fname)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to read %s, %w", fname, err)
+			}
+
+			var i *Interaction
+
+			err = json.Unmarshal(body, &i)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to unmarshal %s, %w", fname, err)
+			}
+
+			c.add(i)
+		}
+	}
+
+	return c, nil
+}
+
+// Keys returns the sorted list of unique request keys in the cassette.
+func (c *Cassette) Keys() []string {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]string, 0, len(c.interactions))
+
+	for k := range c.interactions {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Next returns the next Interaction recorded for 'key'. Interactions recorded more than once for the same key are
+// returned in the order they were recorded; once they are exhausted the last Interaction is returned for any further
+// requests. If there is no Interaction for 'key' a *ReplayMismatchError is returned.
+func (c *Cassette) Next(key string) (*Interaction, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	recorded, exists := c.interactions[key]
+
+	if !exists || len(recorded) == 0 {
+
+		keys := make([]string, 0, len(c.interactions))
+
+		for k := range c.interactions {
+			keys = append(keys, k)
+		}
+
+		return nil, &ReplayMismatchError{
+			Key:     key,
+			Closest: closestKeys(key, keys, 3),
+		}
+	}
+
+	idx := min(c.offsets[key], len(recorded)-1)
+	c.offsets[key] = idx + 1
+
+	return recorded[idx], nil
+}
+
+// Record adds 'i' to the cassette and writes it to disk.
+func (c *Cassette) Record(i *Interaction) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.add(i)
+
+	body, err := json.Marshal(i)
+
+	if err != nil {
+		return fmt.Errorf("Failed to marshal interaction, %w", err)
+	}
+
+	if c.is_dir {
+
+		hash := sha256.Sum256([]byte(i.Key))
+		prefix := hex.EncodeToString(hash[:8])
+
+		seq := c.counts[prefix]
+		c.counts[prefix] = seq + 1
+
+		fname := fmt.Sprintf("%s-%04d.json", prefix, seq)
+
+		err := os.WriteFile(filepath.Join(c.path, fname), body, 0644)
+
+		if err != nil {
+			return fmt.Errorf("Failed to write interaction, %w", err)
+		}
+
+		return nil
+	}
+
+	fh, err := os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+
+	if err != nil {
+		return fmt.Errorf("Failed to open cassette, %w", err)
+	}
+
+	body = append(body, '\n')
+
+	_, err = fh.Write(body)
+
+	if err != nil {
+		fh.Close()
+		return fmt.Errorf("Failed to write interaction, %w", err)
+	}
+
+	return fh.Close()
+}
+
+// Response returns the body of the response, or the error, recorded in the Interaction.
+func (i *Interaction) Response() ([]byte, error) {
+
+	if i.StatusCode != 0 {
+		return nil, &StatusError{StatusCode: i.StatusCode, Status: i.Status}
+	}
+
+	if i.ErrorCode != 0 {
+		return nil, &response.Error{Code: i.ErrorCode, Message: i.Error}
+	}
+
+	if i.Error != "" {
+		return nil, errors.New(i.Error)
+	}
+
+	if i.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(i.BodyBase64)
+	}
+
+	return []byte(i.Body), nil
+}
+
+// newInteraction returns a new Interaction for 'key' containing either 'body' or the details of 'err'.
+func newInteraction(key string, body []byte, err error) *Interaction {
+
+	i := &Interaction{
+		Key: key,
+	}
+
+	if err != nil {
+
+		var status_err *StatusError
+		var api_err *response.Error
+
+		switch {
+		case errors.As(err, &status_err):
+			i.StatusCode = status_err.StatusCode
+			i.Status = status_err.Status
+		case errors.As(err, &api_err):
+			i.ErrorCode = api_err.Code
+			i.Error = api_err.Message
+		default:
+			i.Error = err.Error()
+		}
+
+		return i
+	}
+
+	if utf8.Valid(body) {
+		i.Body = string(body)
+	} else {
+		i.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+
+	return i
+}
+
+func (c *Cassette) readJSONL(path string) error {
+
+	fh, err := os.Open(path)
+
+	if err != nil {
+		return fmt.Errorf("Failed to open %s, %w", path, err)
+	}
+
+	defer fh.Close()
+
+	scanner := bufio.NewScanner(fh)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	lineno := 0
+
+	for scanner.Scan() {
+
+		lineno += 1
+
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		var i *Interaction
+
+		err := json.Unmarshal([]byte(line), &i)
+
+		if err != nil {
+			return fmt.Errorf("Failed to unmarshal %s at line %d, %w", path, lineno, err)
+		}
+
+		c.add(i)
+	}
+
+	err = scanner.Err()
+
+	if err != nil {
+		return fmt.Errorf("Failed to read %s, %w", path, err)
+	}
+
+	return nil
+}
+
+func (c *Cassette) add(i *Interaction) {
+	c.interactions[i.Key] = append(c.interactions[i.Key], i)
+}
+
+// ReplayMismatchError is an error returned when a request has no recorded Interaction.
+type ReplayMismatchError struct {
+	// The normalized key for the request.
+	Key string
+	// The recorded keys most similar to Key.
+	Closest []string
+}
+
+// Return the request key and the closest recorded keys as an error message.
+func (e *ReplayMismatchError) Error() string {
+
+	if len(e.Closest) == 0 {
+		return fmt.Sprintf("No recorded response for '%s', cassette is empty", e.Key)
+	}
+
+	return fmt.Sprintf("No recorded response for '%s', closest recorded keys are: '%s'", e.Key, strings.Join(e.Closest, "', '"))
+}
+
+// closestKeys returns up to 'count' of 'keys' with the smallest edit distance from 'key'.
+func closestKeys(key string, keys []string, count int) []string {
+
+	distances := make(map[string]int)
+
+	for _, k := range keys {
+		distances[k] = editDistance(key, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+
+		if distances[keys[i]] != distances[keys[j]] {
+			return distances[keys[i]] < distances[keys[j]]
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	if len(keys) > count {
+		keys = keys[:count]
+	}
+
+	return keys
+}
+
+// editDistance returns the Levenshtein distance between 'a' and 'b'.
+func editDistance(a string, b string) int {
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
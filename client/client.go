@@ -8,9 +8,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 
 	"github.com/aaronland/go-flickr-api/auth"
 	"github.com/aaronland/go-flickr-api/response"
@@ -46,13 +46,48 @@ type Client interface {
 	Replace(context.Context, io.Reader, *url.Values) (io.ReadSeekCloser, error)
 }
 
+// StatusError is an error returned when the Flickr API responds with a HTTP status code other than 200 OK.
+type StatusError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+	// The HTTP status of the response, for example "502 Bad Gateway".
+	Status string
+}
+
+// Return the HTTP status of the response as an error message.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("API call failed with status '%s'", e.Status)
+}
+
+// Is reports whether the StatusError matches 'target' so that it can be used with errors.Is. Status errors match
+// the response.ErrInvalidAuth, response.ErrPermissionDenied, response.ErrNotFound, response.ErrRateLimited and
+// response.ErrServiceUnavailable sentinel values.
+func (e *StatusError) Is(target error) bool {
+
+	switch target {
+	case response.ErrInvalidAuth:
+		return e.StatusCode == http.StatusUnauthorized
+	case response.ErrPermissionDenied:
+		return e.StatusCode == http.StatusForbidden
+	case response.ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case response.ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case response.ErrServiceUnavailable:
+		return e.StatusCode == http.StatusBadGateway || e.StatusCode == http.StatusServiceUnavailable || e.StatusCode == http.StatusGatewayTimeout
+	default:
+		return false
+	}
+}
+
 // ExecuteMethodPaginatedCallback is the interface for callback functions passed to the
 // ExecuteMethodPaginatedWithClient method.
 type ExecuteMethodPaginatedCallback func(context.Context, io.ReadSeekCloser, error) error
 
 // ExecuteMethodPaginatedWithClient invokes the Flickr API using a Client instance and then continues
 // to invoke that method as many times as necessary to paginate through all of the results. Each result
-// is passed to the ExecuteMethodPaginatedCallback for processing.
+// is passed to the ExecuteMethodPaginatedCallback for processing. See also the iterator-based ExecuteMethodPagesWithClient
+// and ExecuteMethodItemsWithClient methods.
 func ExecuteMethodPaginatedWithClient(ctx context.Context, cl Client, args *url.Values, cb ExecuteMethodPaginatedCallback) error {
 
 	page := 1
@@ -96,6 +131,15 @@ func ExecuteMethodPaginatedWithClient(ctx context.Context, cl Client, args *url.
 
 		if pages == -1 {
 
+			// Ensure that failed API responses return a *response.Error rather than
+			// a (confusing) error about missing pagination properties.
+
+			err = response.CheckMethodStatus(fh, args.Get("method"))
+
+			if err != nil {
+				return err
+			}
+
 			pagination, err := response.DerivePagination(ctx, fh)
 
 			if err != nil {
@@ -168,60 +212,19 @@ func checkAsyncResponseWithClient(ctx context.Context, cl Client, rsp_fh io.Read
 	return CheckTicketWithClient(ctx, cl, ticket)
 }
 
-// CheckTicketWithClient calls with Flickr API with Client and reponse.UploadTicket at regular intervals
-// (every 2 seconds) to check the status of an upload ticket. If successful it will return the photo ID
-// assigned to the upload.
+// CheckTicketWithClient calls the Flickr API with Client at regular intervals (starting every 2 seconds and backing
+// off while the ticket is still being processed) to check the status of an upload ticket. If successful it will return
+// the photo ID assigned to the upload. Failed and invalid tickets return ErrTicketFailed and ErrTicketInvalid errors,
+// tickets that do not resolve within DEFAULT_TICKET_MAX_WAIT return an ErrTicketTimeout error and cancelling 'ctx'
+// returns the context's error. Use the WaitForTicketWithClient method to configure the polling interval and maximum
+// wait time or to retrieve the full TicketResult.
 func CheckTicketWithClient(ctx context.Context, cl Client, ticket *response.UploadTicket) (int64, error) {
 
-	ticker := time.NewTicker(2 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return 0, nil
-		case <-ticker.C:
-
-			args := &url.Values{}
-			args.Set("method", "flickr.photos.upload.checkTickets")
-			args.Set("tickets", ticket.TicketId)
-
-			check_rsp, err := cl.ExecuteMethod(ctx, args)
+	r, err := WaitForTicketWithClient(ctx, cl, ticket.TicketId, nil)
 
-			if err != nil {
-				return 0, err
-			}
-
-			check_ticket, err := response.UnmarshalCheckTicketResponse(check_rsp)
-
-			if err != nil {
-				return 0, err
-			}
-
-			for _, t := range check_ticket.Uploader.Tickets {
-
-				if t.TicketId != ticket.TicketId {
-					continue
-				}
-
-				if t.Complete != 1 {
-					continue
-				}
-
-				// Because the Flickr API returns strings
-				// for photo IDs
-
-				str_id := t.PhotoId
-
-				id, err := strconv.ParseInt(str_id, 10, 64)
-
-				if err != nil {
-					return 0, err
-				}
-
-				return id, nil
-			}
-		}
+	if err != nil {
+		return 0, err
 	}
 
+	return r.PhotoId, nil
 }
@@ -2,15 +2,18 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/aaronland/go-flickr-api/auth"
+	"github.com/aaronland/go-flickr-api/response"
 	"github.com/whosonfirst/go-ioutil"
 )
 
@@ -36,19 +39,64 @@ func init() {
 // OAuth1Client implements the Client interface for invoking the Flickr API using the OAuth1 authentication
 // and authorization mechanism.
 type OAuth1Client struct {
-	http_client        *http.Client
-	api_endpoint       *url.URL
-	consumer_key       string
-	consumer_secret    string
-	oauth_token        string
-	oauth_token_secret string
+	http_client            *http.Client
+	api_endpoint           string
+	upload_endpoint        string
+	replace_endpoint       string
+	authorize_endpoint     string
+	request_token_endpoint string
+	access_token_endpoint  string
+	consumer_key           string
+	consumer_secret        string
+	oauth_token            string
+	oauth_token_secret     string
+	retry_policy           *RetryPolicy
+	check_status           bool
+	user_agent             string
 }
 
+// OAuth1ClientOption is the signature for functions that configure an OAuth1Client instance created by the
+// NewOAuth1ClientWithOptions method.
+type OAuth1ClientOption func(*OAuth1Client) error
+
+// WithHTTPClient returns an OAuth1ClientOption that configures an OAuth1Client instance to use 'http_client' for all
+// HTTP requests. This takes precedence over the ?timeout and ?proxy parameters.
+func WithHTTPClient(http_client *http.Client) OAuth1ClientOption {
+
+	return func(cl *OAuth1Client) error {
+
+		if http_client == nil {
+			return fmt.Errorf("Invalid HTTP client")
+		}
+
+		cl.http_client = http_client
+		return nil
+	}
+}
+
+// newRequestFunc is the signature for functions that create a new (signed) HTTP request for each attempt
+// at calling the Flickr API.
+type newRequestFunc func(context.Context) (*http.Request, error)
+
 // Create a new OAuth1Client instance conforming to the Client interface. OAuth1Client instances are
 // create by passing in a context.Context instance and a URI string in the form of:
 // oauth1://?consumer_key={KEY}&consumer_secret={SECRET} or:
 // oauth1://?consumer_key={KEY}&consumer_secret={SECRET}&oauth1_token={TOKEN}&oauth1_token_secret={SECRET}
+// Failed requests can be retried by including the query parameters described in NewRetryPolicyFromQuery, for example:
+// oauth1://?consumer_key={KEY}&consumer_secret={SECRET}&retries=5&max_backoff=30s
+// The default Flickr API endpoints can be overridden using the optional ?api_endpoint, ?upload_endpoint, ?replace_endpoint,
+// ?authorize_endpoint, ?request_token_endpoint and ?access_token_endpoint parameters.
+// If the optional ?check_status=true parameter is present then the ExecuteMethod method will inspect the "stat" property of
+// each API response and return an *response.Error instance for failed API calls. The optional ?timeout={DURATION} parameter
+// sets the timeout for HTTP requests, ?proxy={URL} routes HTTP requests through a proxy server and ?user_agent={STRING}
+// sets the "User-Agent" header for HTTP requests.
 func NewOAuth1Client(ctx context.Context, uri string) (Client, error) {
+	return NewOAuth1ClientWithOptions(ctx, uri)
+}
+
+// NewOAuth1ClientWithOptions returns a new OAuth1Client instance derived from 'uri', as described in NewOAuth1Client,
+// and then configured by zero or more OAuth1ClientOption functions.
+func NewOAuth1ClientWithOptions(ctx context.Context, uri string, opts ...OAuth1ClientOption) (*OAuth1Client, error) {
 
 	u, err := url.Parse(uri)
 
@@ -69,12 +117,102 @@ func NewOAuth1Client(ctx context.Context, uri string) (Client, error) {
 		return nil, fmt.Errorf("Missing ?consumer_secret parameter")
 	}
 
+	retry_policy, err := NewRetryPolicyFromQuery(q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	check_status := false
+
+	if q.Has("check_status") {
+
+		v, err := strconv.ParseBool(q.Get("check_status"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?check_status parameter, %w", err)
+		}
+
+		check_status = v
+	}
+
+	endpoints := map[string]string{
+		"api_endpoint":           API_ENDPOINT,
+		"upload_endpoint":        UPLOAD_ENDPOINT,
+		"replace_endpoint":       REPLACE_ENDPOINT,
+		"authorize_endpoint":     OAUTH1_AUTHORIZE_ENDPOINT,
+		"request_token_endpoint": OAUTH1_REQUEST_TOKEN_ENDPOINT,
+		"access_token_endpoint":  OAUTH1_ACCESS_TOKEN_ENDPOINT,
+	}
+
+	for k := range endpoints {
+
+		if !q.Has(k) {
+			continue
+		}
+
+		v := q.Get(k)
+		endpoint_u, err := url.Parse(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?%s parameter, %w", k, err)
+		}
+
+		if endpoint_u.Scheme == "" || endpoint_u.Host == "" {
+			return nil, fmt.Errorf("Invalid ?%s parameter, must be a fully qualified URL", k)
+		}
+
+		endpoints[k] = v
+	}
+
 	http_client := &http.Client{}
 
+	if q.Has("timeout") {
+
+		v, err := time.ParseDuration(q.Get("timeout"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?timeout parameter, %w", err)
+		}
+
+		if v < 0 {
+			return nil, fmt.Errorf("Invalid ?timeout parameter, must be a positive duration")
+		}
+
+		http_client.Timeout = v
+	}
+
+	if q.Has("proxy") {
+
+		proxy_u, err := url.Parse(q.Get("proxy"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?proxy parameter, %w", err)
+		}
+
+		if proxy_u.Scheme == "" || proxy_u.Host == "" {
+			return nil, fmt.Errorf("Invalid ?proxy parameter, must be a fully qualified URL")
+		}
+
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.Proxy = http.ProxyURL(proxy_u)
+
+		http_client.Transport = tr
+	}
+
 	cl := &OAuth1Client{
-		http_client:     http_client,
-		consumer_key:    key,
-		consumer_secret: secret,
+		http_client:            http_client,
+		api_endpoint:           endpoints["api_endpoint"],
+		upload_endpoint:        endpoints["upload_endpoint"],
+		replace_endpoint:       endpoints["replace_endpoint"],
+		authorize_endpoint:     endpoints["authorize_endpoint"],
+		request_token_endpoint: endpoints["request_token_endpoint"],
+		access_token_endpoint:  endpoints["access_token_endpoint"],
+		consumer_key:           key,
+		consumer_secret:        secret,
+		retry_policy:           retry_policy,
+		check_status:           check_status,
+		user_agent:             q.Get("user_agent"),
 	}
 
 	oauth_token := q.Get("oauth_token")
@@ -88,27 +226,33 @@ func NewOAuth1Client(ctx context.Context, uri string) (Client, error) {
 		cl.oauth_token_secret = oauth_token_secret
 	}
 
+	for _, opt := range opts {
+
+		err := opt(cl)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to apply client option, %w", err)
+		}
+	}
+
 	return cl, nil
 }
 
-// Return a new Client instance that uses the credentials included in the auth.AccessToken instance.
+// Return a new Client instance that uses the credentials included in the auth.AccessToken instance. The new
+// Client shares the same endpoints, HTTP client and other settings as the client it was derived from.
 func (cl *OAuth1Client) WithAccessToken(ctx context.Context, access_token auth.AccessToken) (Client, error) {
 
-	new_cl := &OAuth1Client{
-		http_client:        cl.http_client,
-		consumer_key:       cl.consumer_key,
-		consumer_secret:    cl.consumer_secret,
-		oauth_token:        access_token.Token(),
-		oauth_token_secret: access_token.Secret(),
-	}
+	new_cl := *cl
+	new_cl.oauth_token = access_token.Token()
+	new_cl.oauth_token_secret = access_token.Secret()
 
-	return new_cl, nil
+	return &new_cl, nil
 }
 
 // Call the Flickr API and create a new request token as part of the token authorization flow.
 func (cl *OAuth1Client) GetRequestToken(ctx context.Context, cb_url string) (auth.RequestToken, error) {
 
-	endpoint, err := url.Parse(OAUTH1_REQUEST_TOKEN_ENDPOINT)
+	endpoint, err := url.Parse(cl.request_token_endpoint)
 
 	if err != nil {
 		return nil, err
@@ -119,21 +263,11 @@ func (cl *OAuth1Client) GetRequestToken(ctx context.Context, cb_url string) (aut
 	args := &url.Values{}
 	args.Set("oauth_callback", cb_url)
 
-	args, err = cl.signArgs(http_method, endpoint, args, "")
-
-	if err != nil {
-		return nil, err
-	}
-
-	endpoint.RawQuery = args.Encode()
-
-	req, err := http.NewRequest(http_method, endpoint.String(), nil)
-
-	if err != nil {
-		return nil, err
+	new_req := func(ctx context.Context) (*http.Request, error) {
+		return cl.newSignedRequest(ctx, http_method, endpoint, args, "")
 	}
 
-	fh, err := cl.call(ctx, req)
+	fh, err := cl.call(ctx, new_req, true)
 
 	if err != nil {
 		return nil, err
@@ -152,29 +286,13 @@ func (cl *OAuth1Client) GetRequestToken(ctx context.Context, cb_url string) (aut
 
 // Generate the URL using a request token and permissions string used to redirect a user to in order to authorize a token request.
 func (cl *OAuth1Client) GetAuthorizationURL(ctx context.Context, req auth.RequestToken, perms string) (string, error) {
-
-	q := url.Values{}
-	q.Set("oauth_token", req.Token())
-
-	if perms != "" {
-		q.Set("perms", perms)
-	}
-
-	endpoint, err := url.Parse(OAUTH1_AUTHORIZE_ENDPOINT)
-
-	if err != nil {
-		return "", err
-	}
-
-	endpoint.RawQuery = q.Encode()
-
-	return endpoint.String(), nil
+	return authorizationURL(cl.authorize_endpoint, req, perms)
 }
 
 // Call the Flickr API to exchange a request and authorization token for a permanent access token.
 func (cl *OAuth1Client) GetAccessToken(ctx context.Context, req_token auth.RequestToken, auth_token auth.AuthorizationToken) (auth.AccessToken, error) {
 
-	endpoint, err := url.Parse(OAUTH1_ACCESS_TOKEN_ENDPOINT)
+	endpoint, err := url.Parse(cl.access_token_endpoint)
 
 	if err != nil {
 		return nil, err
@@ -191,21 +309,11 @@ func (cl *OAuth1Client) GetAccessToken(ctx context.Context, req_token auth.Reque
 	args.Set("oauth_token", auth_token.Token())
 	args.Set("oauth_verifier", auth_token.Verifier())
 
-	args, err = cl.signArgs(http_method, endpoint, args, req_token.Secret())
-
-	if err != nil {
-		return nil, err
-	}
-
-	endpoint.RawQuery = args.Encode()
-
-	req, err := http.NewRequest(http_method, endpoint.String(), nil)
-
-	if err != nil {
-		return nil, err
+	new_req := func(ctx context.Context) (*http.Request, error) {
+		return cl.newSignedRequest(ctx, http_method, endpoint, args, req_token.Secret())
 	}
 
-	fh, err := cl.call(ctx, req)
+	fh, err := cl.call(ctx, new_req, true)
 
 	if err != nil {
 		return nil, err
@@ -223,9 +331,10 @@ func (cl *OAuth1Client) GetAccessToken(ctx context.Context, req_token auth.Reque
 }
 
 // Execute a Flickr API method. If not "format" parameter in include in the url.Values instance passed to the method API responses will be returned as JSON (by automatically assign the 'nojsoncallback=1' and 'format=json' parameters).
+// If the client was created with the ?check_status=true parameter then API responses that do not have an "ok" status will return an *response.Error instance.
 func (cl *OAuth1Client) ExecuteMethod(ctx context.Context, args *url.Values) (io.ReadSeekCloser, error) {
 
-	endpoint, err := url.Parse(API_ENDPOINT)
+	endpoint, err := url.Parse(cl.api_endpoint)
 
 	if err != nil {
 		return nil, err
@@ -242,27 +351,40 @@ func (cl *OAuth1Client) ExecuteMethod(ctx context.Context, args *url.Values) (io
 		args.Set("oauth_token", cl.oauth_token)
 	}
 
-	args, err = cl.signArgs(http_method, endpoint, args, cl.oauth_token_secret)
+	// Requests are (re)signed for each attempt so that the nonce and timestamp are always fresh.
 
-	if err != nil {
-		return nil, err
+	new_req := func(ctx context.Context) (*http.Request, error) {
+		return cl.newSignedRequest(ctx, http_method, endpoint, args, cl.oauth_token_secret)
 	}
 
-	endpoint.RawQuery = args.Encode()
+	// Only read methods are retried unless the client's retry policy says otherwise since retrying a write method
+	// whose first attempt succeeded, but whose response was lost, may create duplicate photosets, comments and so on.
 
-	req, err := http.NewRequest(http_method, endpoint.String(), nil)
+	replayable := cl.retry_policy.RetryWrites || IsReadMethod(args.Get("method"))
+
+	fh, err := cl.call(ctx, new_req, replayable)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return cl.call(ctx, req)
+	if cl.check_status {
+
+		err := response.CheckMethodStatus(fh, args.Get("method"))
+
+		if err != nil {
+			fh.Close()
+			return nil, err
+		}
+	}
+
+	return fh, nil
 }
 
 // Upload an image using the Flickr API.
 func (cl *OAuth1Client) Upload(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
 
-	endpoint, err := url.Parse(UPLOAD_ENDPOINT)
+	endpoint, err := url.Parse(cl.upload_endpoint)
 
 	if err != nil {
 		return nil, err
@@ -274,7 +396,7 @@ func (cl *OAuth1Client) Upload(ctx context.Context, fh io.Reader, args *url.Valu
 // Replace an image using the Flickr API.
 func (cl *OAuth1Client) Replace(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
 
-	endpoint, err := url.Parse(REPLACE_ENDPOINT)
+	endpoint, err := url.Parse(cl.replace_endpoint)
 
 	if err != nil {
 		return nil, err
@@ -289,65 +411,209 @@ func (cl *OAuth1Client) upload(ctx context.Context, endpoint *url.URL, fh io.Rea
 
 	args.Set("oauth_token", cl.oauth_token)
 
-	args, err := cl.signArgs(http_method, endpoint, args, cl.oauth_token_secret)
+	fh, fname, size, content_type, err := prepareUploadFile(fh)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fname := "upload"
-	boundary, err := randomBoundary()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	if err != nil {
-		return nil, err
+	// Upload bodies are streamed using an io.Pipe so they can only be replayed if the
+	// underlying reader can be rewound to where it started.
+
+	replayable := false
+	var offset int64
+
+	if cl.retry_policy.RetryUploads {
+
+		seeker, ok := fh.(io.Seeker)
+
+		if ok {
+
+			pos, err := seeker.Seek(0, io.SeekCurrent)
+
+			if err == nil {
+				offset = pos
+				replayable = true
+			}
+		}
 	}
 
-	r, w := io.Pipe()
+	var stream_done chan bool
+	var stream_r *io.PipeReader
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	new_req := func(ctx context.Context) (*http.Request, error) {
 
-	go func() {
+		// Wait for the goroutine writing the previous attempt's body to exit before rewinding.
+		// The HTTP transport closes the request body (the pipe's reader) once a request is
+		// complete which will cause any pending writes to fail.
 
-		err := streamUploadBody(ctx, w, fname, boundary, fh, args)
+		if stream_done != nil {
+
+			<-stream_done
+
+			_, err := fh.(io.Seeker).Seek(offset, io.SeekStart)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to rewind upload body, %w", err)
+			}
+		}
+
+		args, err := cl.signArgs(http_method, endpoint, args, cl.oauth_token_secret)
 
 		if err != nil {
-			log.Printf("Failed to stream upload body for '%s', %v", fname, err)
-			cancel()
+			return nil, err
 		}
-	}()
 
-	req, err := http.NewRequest(http_method, endpoint.String(), r)
+		boundary, err := randomBoundary()
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := newMultipartBody(boundary, fname, content_type, size, args)
 
-	req.Header.Set("content-type", "multipart/form-data; boundary="+boundary)
-	req.ContentLength = -1 // unknown
+		if err != nil {
+			return nil, err
+		}
+
+		r, w := io.Pipe()
+		done_ch := make(chan bool)
+
+		go func() {
+
+			defer close(done_ch)
+
+			err := streamUploadBody(ctx, w, body, fh)
+
+			// The pipe will be closed by the HTTP transport if the server responds before
+			// the body has been completely sent, for example during an error.
+
+			if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+				log.Printf("Failed to stream upload body for '%s', %v", fname, err)
+				cancel()
+			}
+		}()
+
+		stream_done = done_ch
+		stream_r = r
+
+		req, err := http.NewRequestWithContext(ctx, http_method, endpoint.String(), r)
+
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+
+		req.Header.Set("content-type", "multipart/form-data; boundary="+boundary)
+
+		// If the size of the file is not known this will be -1 and the body will be sent using chunked transfer encoding.
+		req.ContentLength = body.ContentLength()
+
+		return req, nil
+	}
 
 	// This response is formatted in the REST API response style.
 	// https://www.flickr.com/services/api/response.rest.html
 
-	return cl.call(ctx, req)
+	rsp, err := cl.call(ctx, new_req, replayable)
+
+	// Wait for the goroutine writing the body to exit, so that any upload progress has been reported, before
+	// returning. Closing the pipe's reader ensures the goroutine doesn't block if the server responded before
+	// the body was completely sent.
+
+	if stream_done != nil {
+		stream_r.Close()
+		<-stream_done
+	}
+
+	return rsp, err
 }
 
-func (cl *OAuth1Client) call(ctx context.Context, req *http.Request) (io.ReadSeekCloser, error) {
+// call invokes 'new_req' to create and execute an HTTP request, retrying failed requests according to
+// the client's RetryPolicy. If 'replayable' is false the request will only be attempted once.
+func (cl *OAuth1Client) call(ctx context.Context, new_req newRequestFunc, replayable bool) (io.ReadSeekCloser, error) {
+
+	retries := 0
+
+	for {
+
+		// Each retry is a separate API call as far as Flickr is concerned so it must also
+		// draw from the rate limiter, if present, which the first attempt already has.
+
+		if retries > 0 {
+
+			err := waitForRateLimiter(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := new_req(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.WithContext(ctx)
 
-	req = req.WithContext(ctx)
+		if cl.user_agent != "" {
+			req.Header.Set("User-Agent", cl.user_agent)
+		}
+
+		rsp, err := cl.http_client.Do(req)
+
+		if err == nil && rsp.StatusCode == http.StatusOK {
+			return ioutil.NewReadSeekCloser(rsp.Body)
+		}
+
+		if !replayable || !cl.retry_policy.ShouldRetry(ctx, retries, rsp, err) {
+
+			if err != nil {
+				return nil, err
+			}
+
+			rsp.Body.Close()
+			return nil, &StatusError{StatusCode: rsp.StatusCode, Status: rsp.Status}
+		}
+
+		delay := cl.retry_policy.Backoff(retries, rsp)
+
+		if err != nil {
+			slog.Debug("API call failed, retrying", "url", req.URL.Redacted(), "retry", retries+1, "delay", delay, "error", err)
+		} else {
+			slog.Debug("API call failed, retrying", "url", req.URL.Redacted(), "retry", retries+1, "delay", delay, "status", rsp.Status)
+			io.Copy(io.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+
+		err = sleepWithContext(ctx, delay)
 
-	rsp, err := cl.http_client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		retries += 1
+	}
+}
+
+// newSignedRequest returns a new HTTP request for 'endpoint' whose query parameters are 'args' signed
+// using the client's consumer secret and 'secret'.
+func (cl *OAuth1Client) newSignedRequest(ctx context.Context, http_method string, endpoint *url.URL, args *url.Values, secret string) (*http.Request, error) {
+
+	args, err := cl.signArgs(http_method, endpoint, args, secret)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if rsp.StatusCode != http.StatusOK {
-		rsp.Body.Close()
-		return nil, fmt.Errorf("API call failed with status '%s'", rsp.Status)
-	}
+	req_url := *endpoint
+	req_url.RawQuery = args.Encode()
 
-	return ioutil.NewReadSeekCloser(rsp.Body)
+	return http.NewRequestWithContext(ctx, http_method, req_url.String(), nil)
 }
 
 func (cl *OAuth1Client) signArgs(http_method string, endpoint *url.URL, args *url.Values, secret string) (*url.Values, error) {
@@ -359,6 +625,10 @@ func (cl *OAuth1Client) signArgs(http_method string, endpoint *url.URL, args *ur
 
 	nonce := auth.GenerateNonce()
 
+	// Remove any signature left over from a previous request (or attempt) using the same arguments
+	// so that it isn't included in the signing base string.
+	args.Del("oauth_signature")
+
 	args.Set("oauth_version", "1.0")
 	args.Set("oauth_signature_method", "HMAC-SHA1")
 
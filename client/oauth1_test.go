@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/auth"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+func TestNewOAuth1ClientInvalidParameters(t *testing.T) {
+
+	ctx := context.Background()
+
+	tests := []string{
+		"oauth1://?consumer_key=key&consumer_secret=secret&timeout=soon",
+		"oauth1://?consumer_key=key&consumer_secret=secret&timeout=-1s",
+		"oauth1://?consumer_key=key&consumer_secret=secret&proxy=localhost",
+		"oauth1://?consumer_key=key&consumer_secret=secret&api_endpoint=/services/rest",
+		"oauth1://?consumer_key=key&consumer_secret=secret&upload_endpoint=up.flickr.com",
+	}
+
+	for _, uri := range tests {
+
+		_, err := NewOAuth1Client(ctx, uri)
+
+		if err == nil {
+			t.Fatalf("Expected '%s' to fail", uri)
+		}
+	}
+}
+
+func TestOAuth1ClientWithProxy(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	var count int32
+	var user_agent atomic.Value
+
+	proxy_handler := func(rsp http.ResponseWriter, req *http.Request) {
+
+		atomic.AddInt32(&count, 1)
+		user_agent.Store(req.Header.Get("User-Agent"))
+
+		out := req.Clone(req.Context())
+		out.RequestURI = ""
+
+		proxy_rsp, err := http.DefaultTransport.RoundTrip(out)
+
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		defer proxy_rsp.Body.Close()
+
+		rsp.WriteHeader(proxy_rsp.StatusCode)
+		io.Copy(rsp, proxy_rsp.Body)
+	}
+
+	proxy := httptest.NewServer(http.HandlerFunc(proxy_handler))
+	defer proxy.Close()
+
+	q := url.Values{}
+	q.Set("proxy", proxy.URL)
+	q.Set("timeout", "5s")
+	q.Set("user_agent", "flickr-test/1.0")
+	q.Set("check_status", "true")
+
+	cl, err := NewClient(ctx, svr.ConsumerClientURI(q))
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	oauth1_cl := cl.(*OAuth1Client)
+
+	if oauth1_cl.http_client.Timeout != 5*time.Second {
+		t.Fatalf("Unexpected timeout, %v", oauth1_cl.http_client.Timeout)
+	}
+
+	access_token := &auth.OAuth1AccessToken{
+		OAuthToken:       svr.OAuthToken,
+		OAuthTokenSecret: svr.OAuthTokenSecret,
+	}
+
+	auth_cl, err := cl.WithAccessToken(ctx, access_token)
+
+	if err != nil {
+		t.Fatalf("Failed to create client with access token, %v", err)
+	}
+
+	if auth_cl.(*OAuth1Client).http_client != oauth1_cl.http_client {
+		t.Fatalf("Expected derived client to share HTTP client")
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.login")
+
+	fh, err := auth_cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		t.Fatalf("Failed to execute method, %v", err)
+	}
+
+	fh.Close()
+
+	if atomic.LoadInt32(&count) != 1 {
+		t.Fatalf("Expected request to be routed through proxy, %d", count)
+	}
+
+	if user_agent.Load().(string) != "flickr-test/1.0" {
+		t.Fatalf("Unexpected user agent, %s", user_agent.Load())
+	}
+}
+
+func TestNewOAuth1ClientWithOptions(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	http_client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	cl, err := NewOAuth1ClientWithOptions(ctx, svr.ClientURI(url.Values{"timeout": []string{"1s"}}), WithHTTPClient(http_client))
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	if cl.http_client != http_client {
+		t.Fatalf("Expected client to use custom HTTP client")
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.echo")
+	args.Set("hello", "world")
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		t.Fatalf("Failed to execute method, %v", err)
+	}
+
+	defer fh.Close()
+
+	err = response.CheckStatus(fh)
+
+	if err != nil {
+		t.Fatalf("API call failed, %v", err)
+	}
+
+	_, err = NewOAuth1ClientWithOptions(ctx, svr.ClientURI(), WithHTTPClient(nil))
+
+	if err == nil {
+		t.Fatalf("Expected nil HTTP client to fail")
+	}
+}
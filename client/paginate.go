@@ -0,0 +1,353 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+// The default path used to find individual items in a paginated API response, for example the list of photos
+// returned by the flickr.photos.search or flickr.people.getPhotos methods.
+const DEFAULT_ITEMS_PATH string = "*.photo"
+
+// PaginateOptions is a struct containing options for the ExecuteMethodPagesWithClient and ExecuteMethodItemsWithClient methods.
+type PaginateOptions struct {
+	// The maximum number of pages to fetch. If 0 all the pages are fetched.
+	MaxPages int
+	// The maximum number of items to yield. If 0 all the items are yielded. This is only used by ExecuteMethodItemsWithClient.
+	MaxItems int
+	// The (gjson) path used to find individual items in each page. If empty DEFAULT_ITEMS_PATH is used.
+	ItemsPath string
+	// The maximum number of pages to fetch concurrently once the first page has been fetched. If less than 2 pages are fetched one at a time.
+	Workers int
+	// Yield pages (and items) as soon as they have been fetched rather than in page order. This is only used if Workers is greater than 1.
+	AsCompleted bool
+}
+
+// Page is a struct containing a single page of results for a paginated API request.
+type Page struct {
+	// The pagination metrics reported by the API response.
+	Pagination *response.Pagination
+	// The body of the API response.
+	Body []byte
+}
+
+// pageResult is a struct containing the results of fetching a single page concurrently.
+type pageResult struct {
+	number int
+	page   *Page
+	err    error
+}
+
+// ExecuteMethodPagesWithClient returns an iterator that invokes the Flickr API using a Client instance as many times as
+// necessary to paginate through all of the results, yielding each page in turn. Iteration stops when the last page (as
+// reported by each response, rather than derived from the "per_page" argument which some methods cap at less than 500)
+// has been fetched, when opts.MaxPages pages have been fetched, when the caller breaks out of the loop or when an error
+// is yielded. If 'ctx' is cancelled ctx.Err() is yielded. 'args' is not modified. 'opts' may be nil.
+//
+// If opts.Workers is greater than 1 then the first page is fetched in order to determine the total number of pages and the
+// remaining pages are fetched concurrently, by up to opts.Workers goroutines, and yielded in page order unless opts.AsCompleted
+// is true. Any pages still being fetched are cancelled when iteration stops.
+func ExecuteMethodPagesWithClient(ctx context.Context, cl Client, args *url.Values, opts *PaginateOptions) iter.Seq2[*Page, error] {
+
+	if opts == nil {
+		opts = &PaginateOptions{}
+	}
+
+	return func(yield func(*Page, error) bool) {
+
+		page := 1
+
+		if args.Get("page") != "" {
+
+			p, err := strconv.Atoi(args.Get("page"))
+
+			if err != nil {
+				yield(nil, fmt.Errorf("Invalid page number '%s', %w", args.Get("page"), err))
+				return
+			}
+
+			page = p
+		}
+
+		fetched := 0
+
+		for {
+
+			err := ctx.Err()
+
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			pg, err := executeMethodPage(ctx, cl, args, page)
+
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(pg, nil) {
+				return
+			}
+
+			fetched += 1
+
+			if opts.MaxPages > 0 && fetched >= opts.MaxPages {
+				return
+			}
+
+			if page >= pg.Pagination.Pages {
+				return
+			}
+
+			if opts.Workers > 1 {
+
+				last := pg.Pagination.Pages
+
+				if opts.MaxPages > 0 {
+					last = min(last, page+opts.MaxPages-fetched)
+				}
+
+				executeMethodPagesConcurrently(ctx, cl, args, page+1, last, opts, yield)
+				return
+			}
+
+			page += 1
+		}
+	}
+}
+
+// executeMethodPagesConcurrently fetches pages 'first' through 'last' using up to opts.Workers goroutines and passes
+// each one to 'yield', in page order unless opts.AsCompleted is true, until 'yield' returns false or an error is yielded.
+func executeMethodPagesConcurrently(ctx context.Context, cl Client, args *url.Values, first int, last int, opts *PaginateOptions, yield func(*Page, error) bool) {
+
+	fetch_ctx, cancel := context.WithCancel(ctx)
+
+	pages_ch := make(chan int)
+	results_ch := make(chan *pageResult)
+
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < min(opts.Workers, last-first+1); i++ {
+
+		wg.Add(1)
+
+		go func() {
+
+			defer wg.Done()
+
+			for number := range pages_ch {
+
+				pg, err := executeMethodPage(fetch_ctx, cl, args, number)
+
+				select {
+				case results_ch <- &pageResult{number: number, page: pg, err: err}:
+					// pass
+				case <-fetch_ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+
+		defer close(pages_ch)
+
+		for number := first; number <= last; number++ {
+
+			select {
+			case pages_ch <- number:
+				// pass
+			case <-fetch_ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results_ch)
+	}()
+
+	// Ensure that all the workers have stopped before returning.
+
+	defer func() {
+
+		cancel()
+
+		for range results_ch {
+			// pass
+		}
+	}()
+
+	pending := make(map[int]*pageResult)
+	next := first
+
+	for rsp := range results_ch {
+
+		if opts.AsCompleted {
+
+			if rsp.err != nil {
+				yield(nil, rsp.err)
+				return
+			}
+
+			if !yield(rsp.page, nil) {
+				return
+			}
+
+			next += 1
+			continue
+		}
+
+		pending[rsp.number] = rsp
+
+		for {
+
+			rsp, exists := pending[next]
+
+			if !exists {
+				break
+			}
+
+			delete(pending, next)
+			next += 1
+
+			if rsp.err != nil {
+				yield(nil, rsp.err)
+				return
+			}
+
+			if !yield(rsp.page, nil) {
+				return
+			}
+		}
+	}
+
+	// Workers only stop early if the context has been cancelled.
+
+	if next <= last {
+
+		err := ctx.Err()
+
+		if err == nil {
+			err = fmt.Errorf("Failed to fetch all pages")
+		}
+
+		yield(nil, err)
+	}
+}
+
+// ExecuteMethodItemsWithClient returns an iterator that invokes the Flickr API using a Client instance as many times as
+// necessary to paginate through all of the results, yielding each individual item (by default each element of the "*.photo"
+// array in standard photo list responses) in turn. Iteration stops when all the items have been yielded, when opts.MaxItems
+// items have been yielded or for any of the reasons described in ExecuteMethodPagesWithClient. 'opts' may be nil.
+func ExecuteMethodItemsWithClient(ctx context.Context, cl Client, args *url.Values, opts *PaginateOptions) iter.Seq2[gjson.Result, error] {
+
+	if opts == nil {
+		opts = &PaginateOptions{}
+	}
+
+	path := opts.ItemsPath
+
+	if path == "" {
+		path = DEFAULT_ITEMS_PATH
+	}
+
+	return func(yield func(gjson.Result, error) bool) {
+
+		count := 0
+
+		for page, err := range ExecuteMethodPagesWithClient(ctx, cl, args, opts) {
+
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return
+			}
+
+			items_rsp := gjson.GetBytes(page.Body, path)
+
+			if !items_rsp.Exists() {
+				yield(gjson.Result{}, fmt.Errorf("Unable to find items (%s) in response", path))
+				return
+			}
+
+			for _, item := range items_rsp.Array() {
+
+				if !yield(item, nil) {
+					return
+				}
+
+				count += 1
+
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return
+				}
+			}
+		}
+	}
+}
+
+// executeMethodPage invokes the Flickr API using a Client instance to fetch page number 'page' of the results for 'args',
+// which is not modified.
+func executeMethodPage(ctx context.Context, cl Client, args *url.Values, page int) (*Page, error) {
+
+	page_args := &url.Values{}
+
+	for k, v := range *args {
+		(*page_args)[k] = v
+	}
+
+	page_args.Set("page", strconv.Itoa(page))
+
+	body, err := executeMethodBytes(ctx, cl, page_args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := response.DerivePaginationBytes(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Page{Pagination: pagination, Body: body}, nil
+}
+
+// executeMethodBytes invokes the Flickr API using a Client instance and returns the body of the response, or an
+// *response.Error if the response does not have an "ok" status.
+func executeMethodBytes(ctx context.Context, cl Client, args *url.Values) ([]byte, error) {
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response, %w", err)
+	}
+
+	err = response.CheckMethodStatusBytes(body, args.Get("method"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/flickrtest"
+)
+
+func TestExecuteMethodPagesWithClient(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	for i := 0; i < 5; i++ {
+		svr.Store.AddPhoto(&flickrtest.Photo{Owner: svr.UserId, Title: fmt.Sprintf("Photo %d", i)})
+	}
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.people.getPhotos")
+	args.Set("user_id", "me")
+	args.Set("per_page", "2")
+
+	count := 0
+
+	for page, err := range ExecuteMethodPagesWithClient(ctx, cl, args, nil) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate results, %v", err)
+		}
+
+		count += 1
+
+		if page.Pagination.Page != count || page.Pagination.Pages != 3 {
+			t.Fatalf("Unexpected pagination, %v", page.Pagination)
+		}
+	}
+
+	if count != 3 {
+		t.Fatalf("Unexpected number of pages, %d", count)
+	}
+
+	if args.Has("page") {
+		t.Fatalf("Expected arguments to be left unchanged")
+	}
+
+	count = 0
+
+	for _, err := range ExecuteMethodPagesWithClient(ctx, cl, args, &PaginateOptions{MaxPages: 2}) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate results, %v", err)
+		}
+
+		count += 1
+	}
+
+	if count != 2 {
+		t.Fatalf("Unexpected number of pages with MaxPages, %d", count)
+	}
+
+	ids := make(map[string]bool)
+
+	for item, err := range ExecuteMethodItemsWithClient(ctx, cl, args, nil) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate items, %v", err)
+		}
+
+		ids[item.Get("id").String()] = true
+	}
+
+	if len(ids) != 5 {
+		t.Fatalf("Unexpected number of items, %d", len(ids))
+	}
+
+	count = 0
+
+	for _, err := range ExecuteMethodItemsWithClient(ctx, cl, args, &PaginateOptions{MaxItems: 3}) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate items, %v", err)
+		}
+
+		count += 1
+	}
+
+	if count != 3 {
+		t.Fatalf("Unexpected number of items with MaxItems, %d", count)
+	}
+
+	count = 0
+
+	for _, err := range ExecuteMethodItemsWithClient(ctx, cl, args, nil) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate items, %v", err)
+		}
+
+		count += 1
+
+		if count == 1 {
+			break
+		}
+	}
+
+	if count != 1 {
+		t.Fatalf("Expected break to stop iteration, %d", count)
+	}
+
+	cancel_ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	for _, err := range ExecuteMethodPagesWithClient(cancel_ctx, cl, args, nil) {
+
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context cancelled error, %v", err)
+		}
+	}
+}
+
+func TestExecuteMethodItemsWithStringPagination(t *testing.T) {
+
+	ctx := context.Background()
+
+	c, err := NewCassette(filepath.Join(t.TempDir(), "cassette.jsonl"))
+
+	if err != nil {
+		t.Fatalf("Failed to create cassette, %v", err)
+	}
+
+	// Responses where "pages" and "total" are strings and where the method caps "per_page" at
+	// less than the number requested.
+
+	bodies := []string{
+		`{"photos":{"page":1,"pages":"2","perpage":2,"total":"3","photo":[{"id":"1"},{"id":"2"}]},"stat":"ok"}`,
+		`{"photos":{"page":2,"pages":"2","perpage":2,"total":"3","photo":[{"id":"3"}]},"stat":"ok"}`,
+	}
+
+	for i, body := range bodies {
+
+		args := &url.Values{}
+		args.Set("method", "flickr.photos.search")
+		args.Set("per_page", "500")
+		args.Set("page", fmt.Sprintf("%d", i+1))
+
+		err := c.Record(newInteraction(InteractionKey(INTERACTION_EXECUTE, args), []byte(body), nil))
+
+		if err != nil {
+			t.Fatalf("Failed to record interaction, %v", err)
+		}
+	}
+
+	cl := NewReplayClientWithCassette(c)
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.search")
+	args.Set("per_page", "500")
+
+	ids := make([]string, 0)
+
+	for item, err := range ExecuteMethodItemsWithClient(ctx, cl, args, nil) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate items, %v", err)
+		}
+
+		ids = append(ids, item.Get("id").String())
+	}
+
+	if len(ids) != 3 || ids[2] != "3" {
+		t.Fatalf("Unexpected items, %v", ids)
+	}
+}
+
+func TestExecuteMethodPagesWithClientConcurrently(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	for i := 0; i < 7; i++ {
+		svr.Store.AddPhoto(&flickrtest.Photo{Owner: svr.UserId, Title: fmt.Sprintf("Photo %d", i)})
+	}
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.people.getPhotos")
+	args.Set("user_id", "me")
+	args.Set("per_page", "1")
+
+	count := 0
+
+	for page, err := range ExecuteMethodPagesWithClient(ctx, cl, args, &PaginateOptions{Workers: 3}) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate results, %v", err)
+		}
+
+		count += 1
+
+		if page.Pagination.Page != count {
+			t.Fatalf("Expected page %d, got %d", count, page.Pagination.Page)
+		}
+	}
+
+	if count != 7 {
+		t.Fatalf("Unexpected number of pages, %d", count)
+	}
+
+	seen := make(map[int]bool)
+
+	for page, err := range ExecuteMethodPagesWithClient(ctx, cl, args, &PaginateOptions{Workers: 3, AsCompleted: true}) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate results, %v", err)
+		}
+
+		seen[page.Pagination.Page] = true
+	}
+
+	if len(seen) != 7 {
+		t.Fatalf("Unexpected number of pages when yielding as completed, %d", len(seen))
+	}
+
+	count = 0
+
+	for _, err := range ExecuteMethodPagesWithClient(ctx, cl, args, &PaginateOptions{Workers: 3, MaxPages: 4}) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate results, %v", err)
+		}
+
+		count += 1
+	}
+
+	if count != 4 {
+		t.Fatalf("Unexpected number of pages with MaxPages, %d", count)
+	}
+
+	count = 0
+
+	for _, err := range ExecuteMethodItemsWithClient(ctx, cl, args, &PaginateOptions{Workers: 3}) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate items, %v", err)
+		}
+
+		count += 1
+
+		if count == 2 {
+			break
+		}
+	}
+
+	if count != 2 {
+		t.Fatalf("Expected break to stop iteration, %d", count)
+	}
+
+	args.Set("method", "flickr.photosets.getPhotos")
+	args.Set("photoset_id", "1")
+
+	for _, err := range ExecuteMethodPagesWithClient(ctx, cl, args, &PaginateOptions{Workers: 3}) {
+
+		if err == nil {
+			t.Fatalf("Expected invalid photoset to fail")
+		}
+	}
+}
@@ -0,0 +1,387 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/auth"
+)
+
+// The default number of API calls allowed by a RateLimiter per DEFAULT_RATE_LIMIT_PER. This is the
+// published per-key quota for the Flickr API.
+const DEFAULT_RATE_LIMIT int = 3600
+
+// The default duration over which DEFAULT_RATE_LIMIT calls are allowed.
+const DEFAULT_RATE_LIMIT_PER time.Duration = 1 * time.Hour
+
+// The default number of API calls that a RateLimiter allows in a single burst.
+const DEFAULT_RATE_LIMIT_BURST int = 10
+
+func init() {
+
+	ctx := context.Background()
+	err := RegisterClient(ctx, "ratelimit", NewRateLimitedClient)
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RateLimiter implements a token bucket rate limiter that is safe to share across goroutines.
+type RateLimiter struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	tokens     float64
+	last       time.Time
+	calls      int64
+	waits      int64
+	total_wait time.Duration
+}
+
+// RateLimiterStats is a struct containing point-in-time statistics for a RateLimiter instance.
+type RateLimiterStats struct {
+	// The number of API calls currently available without waiting. This may be negative if goroutines are already waiting.
+	Available float64 `json:"available"`
+	// The maximum number of API calls allowed in a single burst.
+	Burst int `json:"burst"`
+	// The number of API calls allowed per second.
+	Rate float64 `json:"rate"`
+	// The total number of API calls that have been allowed.
+	Calls int64 `json:"calls"`
+	// The total number of API calls that had to wait before being allowed.
+	Waits int64 `json:"waits"`
+	// The total amount of time spent waiting.
+	TotalWait time.Duration `json:"total_wait"`
+}
+
+// NewRateLimiter returns a new RateLimiter instance allowing 'calls' API calls every 'per' duration with
+// bursts of up to 'burst' calls. The limiter starts with a full bucket.
+func NewRateLimiter(calls int, per time.Duration, burst int) (*RateLimiter, error) {
+
+	if calls <= 0 {
+		return nil, fmt.Errorf("Invalid number of calls, must be greater than zero")
+	}
+
+	if per <= 0 {
+		return nil, fmt.Errorf("Invalid duration, must be greater than zero")
+	}
+
+	if burst <= 0 {
+		return nil, fmt.Errorf("Invalid burst, must be greater than zero")
+	}
+
+	l := &RateLimiter{
+		rate:   float64(calls) / per.Seconds(),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+
+	return l, nil
+}
+
+// Wait blocks until an API call is allowed or 'ctx' is cancelled, in which case the context's error is returned.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	return l.WaitN(ctx, 1)
+}
+
+// WaitN blocks until 'n' tokens are available or 'ctx' is cancelled, in which case the context's error is returned.
+// 'n' must not be greater than the limiter's burst. This is used to limit the number of bytes, rather than API calls,
+// allowed per second. See also NewBandwidthLimiter.
+func (l *RateLimiter) WaitN(ctx context.Context, n int) error {
+
+	if float64(n) > l.burst {
+		return fmt.Errorf("Number of tokens (%d) exceeds burst (%d)", n, int(l.burst))
+	}
+
+	err := ctx.Err()
+
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+
+	now := time.Now()
+	l.refill(now)
+
+	// Reserve the tokens even if they aren't available yet. This will make the bucket's
+	// balance negative which ensures that waiting goroutines are served in order.
+
+	l.tokens -= float64(n)
+	l.calls += 1
+
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+
+	l.waits += 1
+	l.total_wait += delay
+
+	l.mu.Unlock()
+
+	err = sleepWithContext(ctx, delay)
+
+	if err != nil {
+
+		// Return the reserved tokens to the bucket and remove the call, and its wait, from the stats
+
+		l.mu.Lock()
+		l.tokens += float64(n)
+		l.calls -= 1
+		l.waits -= 1
+		l.total_wait -= delay
+		l.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// Stats returns a RateLimiterStats instance describing the current state of the RateLimiter.
+func (l *RateLimiter) Stats() *RateLimiterStats {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+
+	s := &RateLimiterStats{
+		Available: l.tokens,
+		Burst:     int(l.burst),
+		Rate:      l.rate,
+		Calls:     l.calls,
+		Waits:     l.waits,
+		TotalWait: l.total_wait,
+	}
+
+	return s
+}
+
+// refill adds tokens to the bucket for the time elapsed since it was last refilled. It is
+// assumed that the caller holds the lock.
+func (l *RateLimiter) refill(now time.Time) {
+
+	elapsed := now.Sub(l.last)
+
+	if elapsed <= 0 {
+		return
+	}
+
+	l.tokens = min(l.burst, l.tokens+(elapsed.Seconds()*l.rate))
+	l.last = now
+}
+
+// rateLimiterKey is the context.Context key for the RateLimiter that a call, and its retries, draws from.
+type rateLimiterKey struct{}
+
+// waitForRateLimiter blocks until the RateLimiter associated with 'ctx', if present, allows an API call. It is
+// used by clients that retry failed requests so that each attempt draws from the RateLimiter and not just the first.
+func waitForRateLimiter(ctx context.Context) error {
+
+	l, ok := ctx.Value(rateLimiterKey{}).(*RateLimiter)
+
+	if !ok {
+		return nil
+	}
+
+	return l.Wait(ctx)
+}
+
+// RateLimitedClient implements the Client interface by wrapping another Client instance and ensuring that
+// all API calls draw from a shared RateLimiter.
+type RateLimitedClient struct {
+	client  Client
+	limiter *RateLimiter
+}
+
+// Create a new RateLimitedClient instance conforming to the Client interface. RateLimitedClient instances are
+// created by passing in a context.Context instance and a URI string in the form of:
+// ratelimit://?client_uri={URL_ENCODED_CLIENT_URI}&rate={CALLS}&per={DURATION}&burst={CALLS}
+// Where ?client_uri is the URI of the Client being rate limited. The ?rate, ?per and ?burst parameters are optional
+// and default to 3600 calls per hour with bursts of 10 calls.
+func NewRateLimitedClient(ctx context.Context, uri string) (Client, error) {
+
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+
+	client_uri := q.Get("client_uri")
+
+	if client_uri == "" {
+		return nil, fmt.Errorf("Missing ?client_uri parameter")
+	}
+
+	calls := DEFAULT_RATE_LIMIT
+	per := DEFAULT_RATE_LIMIT_PER
+	burst := DEFAULT_RATE_LIMIT_BURST
+
+	if q.Has("rate") {
+
+		v, err := strconv.Atoi(q.Get("rate"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?rate parameter, %w", err)
+		}
+
+		calls = v
+	}
+
+	if q.Has("per") {
+
+		v, err := time.ParseDuration(q.Get("per"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?per parameter, %w", err)
+		}
+
+		per = v
+	}
+
+	if q.Has("burst") {
+
+		v, err := strconv.Atoi(q.Get("burst"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?burst parameter, %w", err)
+		}
+
+		burst = v
+	}
+
+	limiter, err := NewRateLimiter(calls, per, burst)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create rate limiter, %w", err)
+	}
+
+	cl, err := NewClient(ctx, client_uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create client, %w", err)
+	}
+
+	return NewRateLimitedClientWithLimiter(cl, limiter), nil
+}
+
+// NewRateLimitedClientWithLimiter returns a new RateLimitedClient instance wrapping 'cl' that draws from 'limiter'.
+// The same RateLimiter may be shared by multiple clients.
+func NewRateLimitedClientWithLimiter(cl Client, limiter *RateLimiter) *RateLimitedClient {
+
+	rl := &RateLimitedClient{
+		client:  cl,
+		limiter: limiter,
+	}
+
+	return rl
+}
+
+// wait blocks until the client's RateLimiter allows an API call and returns a new context.Context instance that
+// carries the RateLimiter so that any subsequent attempts (retries) of the same call also draw from it.
+func (cl *RateLimitedClient) wait(ctx context.Context) (context.Context, error) {
+
+	err := cl.limiter.Wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return context.WithValue(ctx, rateLimiterKey{}, cl.limiter), nil
+}
+
+// Stats returns a RateLimiterStats instance describing the current state of the client's RateLimiter.
+func (cl *RateLimitedClient) Stats() *RateLimiterStats {
+	return cl.limiter.Stats()
+}
+
+// Return a new Client instance that uses the credentials included in the auth.AccessToken instance. The new
+// Client shares the same RateLimiter as the client it was derived from.
+func (cl *RateLimitedClient) WithAccessToken(ctx context.Context, access_token auth.AccessToken) (Client, error) {
+
+	new_cl, err := cl.client.WithAccessToken(ctx, access_token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRateLimitedClientWithLimiter(new_cl, cl.limiter), nil
+}
+
+// Call the Flickr API and create a new request token as part of the token authorization flow.
+func (cl *RateLimitedClient) GetRequestToken(ctx context.Context, cb_url string) (auth.RequestToken, error) {
+
+	ctx, err := cl.wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.client.GetRequestToken(ctx, cb_url)
+}
+
+// Generate the URL using a request token and permissions string used to redirect a user to in order to authorize a token request.
+func (cl *RateLimitedClient) GetAuthorizationURL(ctx context.Context, req auth.RequestToken, perms string) (string, error) {
+	return cl.client.GetAuthorizationURL(ctx, req, perms)
+}
+
+// Call the Flickr API to exchange a request and authorization token for a permanent access token.
+func (cl *RateLimitedClient) GetAccessToken(ctx context.Context, req_token auth.RequestToken, auth_token auth.AuthorizationToken) (auth.AccessToken, error) {
+
+	ctx, err := cl.wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.client.GetAccessToken(ctx, req_token, auth_token)
+}
+
+// Execute a Flickr API method.
+func (cl *RateLimitedClient) ExecuteMethod(ctx context.Context, args *url.Values) (io.ReadSeekCloser, error) {
+
+	ctx, err := cl.wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.client.ExecuteMethod(ctx, args)
+}
+
+// Upload an image using the Flickr API.
+func (cl *RateLimitedClient) Upload(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+
+	ctx, err := cl.wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.client.Upload(ctx, fh, args)
+}
+
+// Replace an image using the Flickr API.
+func (cl *RateLimitedClient) Replace(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+
+	ctx, err := cl.wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.client.Replace(ctx, fh, args)
+}
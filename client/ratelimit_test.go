@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+
+	ctx := context.Background()
+
+	l, err := NewRateLimiter(100, time.Second, 2)
+
+	if err != nil {
+		t.Fatalf("Failed to create rate limiter, %v", err)
+	}
+
+	start := time.Now()
+
+	for i := 0; i < 4; i++ {
+
+		err := l.Wait(ctx)
+
+		if err != nil {
+			t.Fatalf("Failed to wait, %v", err)
+		}
+	}
+
+	// 2 calls from the initial burst and 2 more at 10ms each
+
+	if time.Since(start) < 15*time.Millisecond {
+		t.Fatalf("Rate limiter did not wait long enough, %v", time.Since(start))
+	}
+
+	stats := l.Stats()
+
+	if stats.Calls != 4 {
+		t.Fatalf("Unexpected number of calls, %d", stats.Calls)
+	}
+
+	if stats.Waits != 2 {
+		t.Fatalf("Unexpected number of waits, %d", stats.Waits)
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+
+	l, err := NewRateLimiter(1, time.Hour, 1)
+
+	if err != nil {
+		t.Fatalf("Failed to create rate limiter, %v", err)
+	}
+
+	ctx := context.Background()
+
+	err = l.Wait(ctx)
+
+	if err != nil {
+		t.Fatalf("Failed to wait, %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	err = l.Wait(ctx)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded error, %v", err)
+	}
+
+	// Cancelled waits are not counted as calls or waits
+
+	stats := l.Stats()
+
+	if stats.Calls != 1 || stats.Waits != 0 || stats.TotalWait != 0 {
+		t.Fatalf("Unexpected stats, %d calls, %d waits, %v total wait", stats.Calls, stats.Waits, stats.TotalWait)
+	}
+}
+
+func TestNewRateLimitedClient(t *testing.T) {
+
+	ctx := context.Background()
+
+	_, err := NewClient(ctx, "ratelimit://?rate=10&per=1s")
+
+	if err == nil {
+		t.Fatalf("Expected missing client_uri to fail")
+	}
+
+	cl, err := NewClient(ctx, "ratelimit://?rate=10&per=1s&client_uri=oauth1%3A%2F%2F%3Fconsumer_key%3Dkey%26consumer_secret%3Dsecret")
+
+	if err != nil {
+		t.Fatalf("Failed to create rate limited client, %v", err)
+	}
+
+	_, ok := cl.(*RateLimitedClient)
+
+	if !ok {
+		t.Fatalf("Unexpected client type")
+	}
+}
+
+func TestRateLimitedClientWithRetries(t *testing.T) {
+
+	ctx := context.Background()
+
+	var count int32
+
+	handler := func(rsp http.ResponseWriter, req *http.Request) {
+
+		if atomic.AddInt32(&count, 1) < 3 {
+			rsp.Header().Set("Retry-After", "0")
+			http.Error(rsp, "Bad gateway", http.StatusBadGateway)
+			return
+		}
+
+		rsp.Write([]byte(`{"stat":"ok"}`))
+	}
+
+	svr := httptest.NewServer(http.HandlerFunc(handler))
+	defer svr.Close()
+
+	q, _ := url.ParseQuery("retries=2&min_backoff=1ms&max_backoff=10ms")
+	policy, _ := NewRetryPolicyFromQuery(q)
+
+	cl := &OAuth1Client{
+		http_client:     svr.Client(),
+		consumer_key:    "key",
+		consumer_secret: "secret",
+		retry_policy:    policy,
+		api_endpoint:    svr.URL,
+	}
+
+	limiter, err := NewRateLimiter(100, time.Second, 10)
+
+	if err != nil {
+		t.Fatalf("Failed to create rate limiter, %v", err)
+	}
+
+	rl := NewRateLimitedClientWithLimiter(cl, limiter)
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.echo")
+
+	fh, err := rl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		t.Fatalf("Failed to execute method, %v", err)
+	}
+
+	fh.Close()
+
+	// Every attempt, not just the first, should draw from the rate limiter
+
+	stats := limiter.Stats()
+
+	if count != 3 || stats.Calls != 3 {
+		t.Fatalf("Unexpected number of attempts (%d) or rate limited calls (%d)", count, stats.Calls)
+	}
+}
@@ -0,0 +1,309 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/aaronland/go-flickr-api/auth"
+	"github.com/whosonfirst/go-ioutil"
+)
+
+// The placeholder value written to cassettes in place of OAuth token secrets unless the RecordClient was created
+// with the ?record_secrets=true parameter.
+const REDACTED_TOKEN_SECRET string = "REDACTED"
+
+func init() {
+
+	ctx := context.Background()
+	err := RegisterClient(ctx, "record", NewRecordClient)
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RecordClient implements the Client interface by wrapping another Client instance and recording every request,
+// and its response, to a Cassette that can be replayed using the ReplayClient.
+type RecordClient struct {
+	client         Client
+	cassette       *Cassette
+	record_secrets bool
+}
+
+// Create a new RecordClient instance conforming to the Client interface. RecordClient instances are created by passing
+// in a context.Context instance and a URI string in the form of:
+// record://{PATH}?client_uri={URL_ENCODED_CLIENT_URI}
+// Where {PATH} is the path to the JSONL cassette file to write, or a directory (ending in "/") in which to write one
+// JSON file per request, and ?client_uri is the URI of the Client whose requests are being recorded. Existing JSONL
+// cassette files are overwritten, and existing cassette directories that already contain recorded requests are refused,
+// unless the optional ?append=true parameter is present. OAuth token secrets returned by the GetRequestToken and
+// GetAccessToken methods are recorded as REDACTED_TOKEN_SECRET unless the optional ?record_secrets=true parameter is present.
+func NewRecordClient(ctx context.Context, uri string) (Client, error) {
+
+	path, err := cassettePath(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u, _ := url.Parse(uri)
+	q := u.Query()
+
+	client_uri := q.Get("client_uri")
+
+	if client_uri == "" {
+		return nil, fmt.Errorf("Missing ?client_uri parameter")
+	}
+
+	append_cassette := false
+
+	if q.Has("append") {
+
+		v, err := strconv.ParseBool(q.Get("append"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?append parameter, %w", err)
+		}
+
+		append_cassette = v
+	}
+
+	record_secrets := false
+
+	if q.Has("record_secrets") {
+
+		v, err := strconv.ParseBool(q.Get("record_secrets"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?record_secrets parameter, %w", err)
+		}
+
+		record_secrets = v
+	}
+
+	c, err := NewCassette(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create cassette, %w", err)
+	}
+
+	if c.is_dir && !append_cassette && len(c.counts) > 0 {
+		return nil, fmt.Errorf("Cassette directory %s already contains recorded requests, remove them or set ?append=true", path)
+	}
+
+	if !c.is_dir && !append_cassette {
+
+		err := os.WriteFile(path, []byte{}, 0644)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to truncate cassette, %w", err)
+		}
+	}
+
+	cl, err := NewClient(ctx, client_uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create client, %w", err)
+	}
+
+	rec := NewRecordClientWithCassette(cl, c)
+	rec.record_secrets = record_secrets
+
+	return rec, nil
+}
+
+// NewRecordClientWithCassette returns a new RecordClient instance that records requests made using 'cl' to 'c'. OAuth
+// token secrets are recorded as REDACTED_TOKEN_SECRET.
+func NewRecordClientWithCassette(cl Client, c *Cassette) *RecordClient {
+
+	rec := &RecordClient{
+		client:   cl,
+		cassette: c,
+	}
+
+	return rec
+}
+
+// Return a new Client instance that uses the credentials included in the auth.AccessToken instance. The new Client
+// records requests to the same cassette as the client it was derived from.
+func (cl *RecordClient) WithAccessToken(ctx context.Context, access_token auth.AccessToken) (Client, error) {
+
+	new_cl, err := cl.client.WithAccessToken(ctx, access_token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rec := NewRecordClientWithCassette(new_cl, cl.cassette)
+	rec.record_secrets = cl.record_secrets
+
+	return rec, nil
+}
+
+// Call the Flickr API and create a new request token as part of the token authorization flow, recording the response.
+func (cl *RecordClient) GetRequestToken(ctx context.Context, cb_url string) (auth.RequestToken, error) {
+
+	key := InteractionKey(INTERACTION_REQUEST_TOKEN, requestTokenArgs(cb_url))
+
+	req_token, err := cl.client.GetRequestToken(ctx, cb_url)
+
+	var body []byte
+
+	if err == nil {
+
+		q := url.Values{}
+		q.Set("oauth_token", req_token.Token())
+		q.Set("oauth_token_secret", cl.tokenSecret(req_token.Secret()))
+
+		body = []byte(q.Encode())
+	}
+
+	rec_err := cl.cassette.Record(newInteraction(key, body, err))
+
+	if rec_err != nil {
+		return nil, rec_err
+	}
+
+	return req_token, err
+}
+
+// Generate the URL using a request token and permissions string used to redirect a user to in order to authorize a token request.
+func (cl *RecordClient) GetAuthorizationURL(ctx context.Context, req auth.RequestToken, perms string) (string, error) {
+	return cl.client.GetAuthorizationURL(ctx, req, perms)
+}
+
+// Call the Flickr API to exchange a request and authorization token for a permanent access token, recording the response.
+func (cl *RecordClient) GetAccessToken(ctx context.Context, req_token auth.RequestToken, auth_token auth.AuthorizationToken) (auth.AccessToken, error) {
+
+	key := InteractionKey(INTERACTION_ACCESS_TOKEN, accessTokenArgs(auth_token))
+
+	access_token, err := cl.client.GetAccessToken(ctx, req_token, auth_token)
+
+	var body []byte
+
+	if err == nil {
+
+		q := url.Values{}
+		q.Set("oauth_token", access_token.Token())
+		q.Set("oauth_token_secret", cl.tokenSecret(access_token.Secret()))
+
+		body = []byte(q.Encode())
+	}
+
+	rec_err := cl.cassette.Record(newInteraction(key, body, err))
+
+	if rec_err != nil {
+		return nil, rec_err
+	}
+
+	return access_token, err
+}
+
+// Execute a Flickr API method, recording the response.
+func (cl *RecordClient) ExecuteMethod(ctx context.Context, args *url.Values) (io.ReadSeekCloser, error) {
+
+	// Derive the key before calling the underlying client since it may add its own parameters to 'args'.
+
+	key := InteractionKey(INTERACTION_EXECUTE, args)
+
+	fh, err := cl.client.ExecuteMethod(ctx, args)
+
+	return cl.record(key, fh, err)
+}
+
+// Upload an image using the Flickr API, recording the response.
+func (cl *RecordClient) Upload(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+
+	// Preserve the file name and MIME type of the original reader
+
+	file_name, _, content_type := uploadFileInfo(fh)
+
+	body, upload_args, err := uploadArgs(fh, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := InteractionKey(INTERACTION_UPLOAD, upload_args)
+
+	upload_fh := &UploadFile{
+		Reader:      bytes.NewReader(body),
+		FileName:    file_name,
+		ContentType: content_type,
+	}
+
+	rsp, err := cl.client.Upload(ctx, upload_fh, args)
+
+	return cl.record(key, rsp, err)
+}
+
+// Replace an image using the Flickr API, recording the response.
+func (cl *RecordClient) Replace(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+
+	// Preserve the file name and MIME type of the original reader
+
+	file_name, _, content_type := uploadFileInfo(fh)
+
+	body, upload_args, err := uploadArgs(fh, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := InteractionKey(INTERACTION_REPLACE, upload_args)
+
+	upload_fh := &UploadFile{
+		Reader:      bytes.NewReader(body),
+		FileName:    file_name,
+		ContentType: content_type,
+	}
+
+	rsp, err := cl.client.Replace(ctx, upload_fh, args)
+
+	return cl.record(key, rsp, err)
+}
+
+// tokenSecret returns the value to record for the OAuth token secret 'secret' which is REDACTED_TOKEN_SECRET unless
+// the client was created with the ?record_secrets=true parameter.
+func (cl *RecordClient) tokenSecret(secret string) string {
+
+	if !cl.record_secrets {
+		return REDACTED_TOKEN_SECRET
+	}
+
+	return secret
+}
+
+// record writes the response (or error) for 'key' to the cassette and returns a new io.ReadSeekCloser for the response body.
+func (cl *RecordClient) record(key string, fh io.ReadSeekCloser, err error) (io.ReadSeekCloser, error) {
+
+	var body []byte
+
+	if err == nil {
+
+		defer fh.Close()
+
+		body, err = io.ReadAll(fh)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read response, %w", err)
+		}
+	}
+
+	rec_err := cl.cassette.Record(newInteraction(key, body, err))
+
+	if rec_err != nil {
+		return nil, rec_err
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.NewReadSeekCloser(bytes.NewReader(body))
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/aaronland/go-flickr-api/auth"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/whosonfirst/go-ioutil"
+)
+
+func init() {
+
+	ctx := context.Background()
+	err := RegisterClient(ctx, "replay", NewReplayClient)
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ReplayClient implements the Client interface by returning responses recorded in a Cassette rather than calling the Flickr API.
+type ReplayClient struct {
+	cassette *Cassette
+}
+
+// Create a new ReplayClient instance conforming to the Client interface. ReplayClient instances are created by passing
+// in a context.Context instance and a URI string in the form of:
+// replay://{PATH}
+// Where {PATH} is the path to a JSONL cassette file or a directory of JSON cassette files, as written by the RecordClient.
+// Relative paths are resolved against the current working directory, for example "replay://testdata/cassette.jsonl".
+func NewReplayClient(ctx context.Context, uri string) (Client, error) {
+
+	path, err := cassettePath(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := ReadCassette(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read cassette, %w", err)
+	}
+
+	return NewReplayClientWithCassette(c), nil
+}
+
+// NewReplayClientWithCassette returns a new ReplayClient instance that returns the responses recorded in 'c'.
+func NewReplayClientWithCassette(c *Cassette) *ReplayClient {
+
+	cl := &ReplayClient{
+		cassette: c,
+	}
+
+	return cl
+}
+
+// Return a new Client instance that uses the credentials included in the auth.AccessToken instance. Since credentials
+// are not recorded the new Client replays responses from the same cassette.
+func (cl *ReplayClient) WithAccessToken(ctx context.Context, access_token auth.AccessToken) (Client, error) {
+	return NewReplayClientWithCassette(cl.cassette), nil
+}
+
+// Return the recorded request token for 'cb_url'.
+func (cl *ReplayClient) GetRequestToken(ctx context.Context, cb_url string) (auth.RequestToken, error) {
+
+	body, err := cl.replay(INTERACTION_REQUEST_TOKEN, requestTokenArgs(cb_url))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.UnmarshalOAuth1RequestToken(string(body))
+}
+
+// Generate the URL using a request token and permissions string used to redirect a user to in order to authorize a token request.
+func (cl *ReplayClient) GetAuthorizationURL(ctx context.Context, req auth.RequestToken, perms string) (string, error) {
+	return authorizationURL(OAUTH1_AUTHORIZE_ENDPOINT, req, perms)
+}
+
+// Return the recorded access token for 'auth_token'.
+func (cl *ReplayClient) GetAccessToken(ctx context.Context, req_token auth.RequestToken, auth_token auth.AuthorizationToken) (auth.AccessToken, error) {
+
+	body, err := cl.replay(INTERACTION_ACCESS_TOKEN, accessTokenArgs(auth_token))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.UnmarshalOAuth1AccessToken(string(body))
+}
+
+// Return the recorded response for a Flickr API method.
+func (cl *ReplayClient) ExecuteMethod(ctx context.Context, args *url.Values) (io.ReadSeekCloser, error) {
+
+	body, err := cl.replay(INTERACTION_EXECUTE, args)
+
+	if err != nil {
+
+		// Recorded API errors don't include the method so assign it in order that method-specific error codes can be matched.
+
+		var api_err *response.Error
+
+		if errors.As(err, &api_err) {
+			api_err.Method = args.Get("method")
+		}
+
+		return nil, err
+	}
+
+	return ioutil.NewReadSeekCloser(bytes.NewReader(body))
+}
+
+// Return the recorded response for uploading the image in 'fh'.
+func (cl *ReplayClient) Upload(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+	return cl.replayUpload(INTERACTION_UPLOAD, fh, args)
+}
+
+// Return the recorded response for replacing an image with the image in 'fh'.
+func (cl *ReplayClient) Replace(ctx context.Context, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+	return cl.replayUpload(INTERACTION_REPLACE, fh, args)
+}
+
+func (cl *ReplayClient) replayUpload(kind string, fh io.Reader, args *url.Values) (io.ReadSeekCloser, error) {
+
+	_, upload_args, err := uploadArgs(fh, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := cl.replay(kind, upload_args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.NewReadSeekCloser(bytes.NewReader(body))
+}
+
+func (cl *ReplayClient) replay(kind string, args *url.Values) ([]byte, error) {
+
+	i, err := cl.cassette.Next(InteractionKey(kind, args))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return i.Response()
+}
+
+// cassettePath derives the path to a cassette from 'uri', for example "replay:///path/to/cassette.jsonl" or "replay://testdata/cassette.jsonl".
+func cassettePath(uri string) (string, error) {
+
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return "", err
+	}
+
+	path := u.Host + u.Path
+
+	if path == "" {
+		return "", fmt.Errorf("Missing cassette path")
+	}
+
+	return path, nil
+}
+
+// uploadArgs reads the body of the image in 'fh' and returns it along with a copy of 'args' that includes a
+// "body_sha256" key containing the SHA-256 hash of the body, so that recorded uploads are keyed by their contents.
+func uploadArgs(fh io.Reader, args *url.Values) ([]byte, *url.Values, error) {
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to read upload body, %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+
+	upload_args := &url.Values{}
+
+	for k, v := range *args {
+		(*upload_args)[k] = v
+	}
+
+	upload_args.Set("body_sha256", hex.EncodeToString(hash[:]))
+
+	return body, upload_args, nil
+}
+
+// requestTokenArgs returns the arguments used to key request token requests. Note that "oauth_" prefixes are
+// omitted since they are removed by InteractionKey.
+func requestTokenArgs(cb_url string) *url.Values {
+
+	args := &url.Values{}
+	args.Set("callback", cb_url)
+
+	return args
+}
+
+// accessTokenArgs returns the arguments used to key access token requests.
+func accessTokenArgs(auth_token auth.AuthorizationToken) *url.Values {
+
+	args := &url.Values{}
+	args.Set("token", auth_token.Token())
+	args.Set("verifier", auth_token.Verifier())
+
+	return args
+}
+
+// authorizationURL returns the URL to redirect a user to in order to authorize 'req' with 'perms' permissions.
+func authorizationURL(endpoint string, req auth.RequestToken, perms string) (string, error) {
+
+	q := url.Values{}
+	q.Set("oauth_token", req.Token())
+
+	if perms != "" {
+		q.Set("perms", perms)
+	}
+
+	u, err := url.Parse(endpoint)
+
+	if err != nil {
+		return "", err
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,328 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+func TestInteractionKey(t *testing.T) {
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.search")
+	args.Set("user_id", "me")
+	args.Set("format", "json")
+	args.Set("nojsoncallback", "1")
+	args.Set("oauth_nonce", "abc")
+	args.Set("oauth_signature", "xyz")
+
+	key := InteractionKey(INTERACTION_EXECUTE, args)
+
+	if key != "execute method=flickr.photos.search&user_id=me" {
+		t.Fatalf("Unexpected key, '%s'", key)
+	}
+
+	args.Set("format", "rest")
+
+	key = InteractionKey(INTERACTION_EXECUTE, args)
+
+	if key != "execute format=rest&method=flickr.photos.search&user_id=me" {
+		t.Fatalf("Unexpected key, '%s'", key)
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	for i := 0; i < 5; i++ {
+		svr.Store.AddPhoto(&flickrtest.Photo{Owner: svr.UserId, Title: fmt.Sprintf("Photo %d", i)})
+	}
+
+	root := t.TempDir()
+
+	paths := []string{
+		filepath.Join(root, "cassette.jsonl"),
+		filepath.Join(root, "cassette") + string(filepath.Separator),
+	}
+
+	for _, path := range paths {
+
+		record_q := url.Values{}
+		record_q.Set("client_uri", svr.ClientURI(url.Values{"check_status": []string{"true"}}))
+
+		record_cl, err := NewClient(ctx, "record://"+path+"?"+record_q.Encode())
+
+		if err != nil {
+			t.Fatalf("Failed to create record client, %v", err)
+		}
+
+		recorded, err := runReplayTest(ctx, record_cl)
+
+		if err != nil {
+			t.Fatalf("Failed to record requests for %s, %v", path, err)
+		}
+
+		replay_cl, err := NewClient(ctx, "replay://"+path)
+
+		if err != nil {
+			t.Fatalf("Failed to create replay client, %v", err)
+		}
+
+		replayed, err := runReplayTest(ctx, replay_cl)
+
+		if err != nil {
+			t.Fatalf("Failed to replay requests for %s, %v", path, err)
+		}
+
+		if len(recorded) != len(replayed) {
+			t.Fatalf("Unexpected number of replayed responses for %s, %d", path, len(replayed))
+		}
+
+		for i, body := range recorded {
+
+			if !bytes.Equal(body, replayed[i]) {
+				t.Fatalf("Replayed response %d for %s does not match recorded response", i, path)
+			}
+		}
+
+		args := &url.Values{}
+		args.Set("method", "flickr.people.getPhotos")
+		args.Set("user_id", "you")
+		args.Set("per_page", "2")
+
+		_, err = replay_cl.ExecuteMethod(ctx, args)
+
+		var mismatch_err *ReplayMismatchError
+
+		if !errors.As(err, &mismatch_err) {
+			t.Fatalf("Expected replay mismatch error, got %v", err)
+		}
+
+		if len(mismatch_err.Closest) == 0 || !strings.Contains(mismatch_err.Closest[0], "flickr.people.getPhotos") {
+			t.Fatalf("Unexpected closest keys, %v", mismatch_err.Closest)
+		}
+	}
+}
+
+func TestRecordTokenSecrets(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	for _, record_secrets := range []bool{false, true} {
+
+		path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+		record_q := url.Values{}
+		record_q.Set("client_uri", svr.ClientURI())
+
+		if record_secrets {
+			record_q.Set("record_secrets", "true")
+		}
+
+		record_cl, err := NewClient(ctx, "record://"+path+"?"+record_q.Encode())
+
+		if err != nil {
+			t.Fatalf("Failed to create record client, %v", err)
+		}
+
+		req_token, err := record_cl.GetRequestToken(ctx, "oob")
+
+		if err != nil {
+			t.Fatalf("Failed to get request token, %v", err)
+		}
+
+		body, err := os.ReadFile(path)
+
+		if err != nil {
+			t.Fatalf("Failed to read cassette, %v", err)
+		}
+
+		if bytes.Contains(body, []byte(req_token.Secret())) != record_secrets {
+			t.Fatalf("Unexpected token secret in cassette (record secrets: %t), %s", record_secrets, string(body))
+		}
+
+		replay_cl, err := NewClient(ctx, "replay://"+path)
+
+		if err != nil {
+			t.Fatalf("Failed to create replay client, %v", err)
+		}
+
+		replayed, err := replay_cl.GetRequestToken(ctx, "oob")
+
+		if err != nil {
+			t.Fatalf("Failed to replay request token, %v", err)
+		}
+
+		if replayed.Token() != req_token.Token() || (!record_secrets && replayed.Secret() != REDACTED_TOKEN_SECRET) {
+			t.Fatalf("Unexpected replayed request token, %s %s", replayed.Token(), replayed.Secret())
+		}
+	}
+}
+
+func TestRecordCassetteDirectory(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette") + string(filepath.Separator)
+
+	record_q := url.Values{}
+	record_q.Set("client_uri", svr.ClientURI())
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.echo")
+
+	record := func(q url.Values) error {
+
+		record_cl, err := NewClient(ctx, "record://"+path+"?"+q.Encode())
+
+		if err != nil {
+			return err
+		}
+
+		_, err = record_cl.ExecuteMethod(ctx, args)
+		return err
+	}
+
+	err := record(record_q)
+
+	if err != nil {
+		t.Fatalf("Failed to record request, %v", err)
+	}
+
+	// Existing recordings are not overwritten...
+
+	err = record(record_q)
+
+	if err == nil {
+		t.Fatalf("Expected recording to a non-empty cassette directory to fail")
+	}
+
+	// ...unless they are appended to
+
+	record_q.Set("append", "true")
+
+	err = record(record_q)
+
+	if err != nil {
+		t.Fatalf("Failed to append request, %v", err)
+	}
+
+	entries, err := os.ReadDir(path)
+
+	if err != nil {
+		t.Fatalf("Failed to read cassette directory, %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Unexpected number of recorded requests, %d", len(entries))
+	}
+
+	c, err := ReadCassette(path)
+
+	if err != nil {
+		t.Fatalf("Failed to read cassette, %v", err)
+	}
+
+	if len(c.interactions[InteractionKey(INTERACTION_EXECUTE, args)]) != 2 {
+		t.Fatalf("Unexpected number of interactions")
+	}
+}
+
+// runReplayTest executes a sequence of requests using 'cl' returning the response bodies.
+func runReplayTest(ctx context.Context, cl Client) ([][]byte, error) {
+
+	responses := make([][]byte, 0)
+
+	args := &url.Values{}
+	args.Set("method", "flickr.people.getPhotos")
+	args.Set("user_id", "me")
+	args.Set("per_page", "2")
+
+	cb := func(ctx context.Context, fh io.ReadSeekCloser, err error) error {
+
+		if err != nil {
+			return err
+		}
+
+		body, err := io.ReadAll(fh)
+
+		if err != nil {
+			return err
+		}
+
+		responses = append(responses, body)
+		return nil
+	}
+
+	err := ExecuteMethodPaginatedWithClient(ctx, cl, args, cb)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(responses) != 3 {
+		return nil, fmt.Errorf("Unexpected number of pages, %d", len(responses))
+	}
+
+	fh, err := cl.Upload(ctx, strings.NewReader("photo"), &url.Values{"title": []string{"Upload"}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, err
+	}
+
+	responses = append(responses, body)
+
+	args = &url.Values{}
+	args.Set("method", "flickr.photos.getInfo")
+	args.Set("photo_id", "1")
+
+	_, err = cl.ExecuteMethod(ctx, args)
+
+	if !errors.Is(err, response.ErrNotFound) {
+		return nil, fmt.Errorf("Expected not found error, got %v", err)
+	}
+
+	return responses, nil
+}
+
+func TestClosestKeys(t *testing.T) {
+
+	keys := []string{
+		"execute method=flickr.photos.getInfo&photo_id=123",
+		"execute method=flickr.photos.getInfo&photo_id=456",
+		"execute method=flickr.test.echo",
+		"upload body_sha256=abc",
+	}
+
+	closest := closestKeys("execute method=flickr.photos.getInfo&photo_id=124", keys, 2)
+
+	if len(closest) != 2 || closest[0] != keys[0] {
+		t.Fatalf("Unexpected closest keys, %v", closest)
+	}
+}
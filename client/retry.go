@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The default initial delay before retrying a failed request.
+const DEFAULT_MIN_BACKOFF time.Duration = 1 * time.Second
+
+// The default maximum delay before retrying a failed request.
+const DEFAULT_MAX_BACKOFF time.Duration = 30 * time.Second
+
+// RetryPolicy is a struct containing the rules for retrying failed HTTP requests to the Flickr API.
+type RetryPolicy struct {
+	// The maximum number of times a failed request will be retried. If 0 failed requests are never retried.
+	MaxRetries int
+	// The initial delay before retrying a failed request. Each subsequent retry doubles the delay.
+	MinBackoff time.Duration
+	// The maximum delay between retries, including any delay derived from a "Retry-After" header.
+	MaxBackoff time.Duration
+	// A boolean flag signaling that a random amount of time (up to the computed delay) should be used between retries.
+	Jitter bool
+	// A boolean flag signaling that upload and replace (POST) requests should be retried. Uploads will only be retried
+	// if the body being uploaded implements the io.Seeker interface and can be rewound.
+	RetryUploads bool
+	// A boolean flag signaling that API methods which are not read methods (see IsReadMethod), for example methods that
+	// create photosets or add comments, should be retried. Retrying these methods may apply their changes more than once.
+	RetryWrites bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy instance that never retries failed requests.
+func DefaultRetryPolicy() *RetryPolicy {
+
+	p := &RetryPolicy{
+		MaxRetries: 0,
+		MinBackoff: DEFAULT_MIN_BACKOFF,
+		MaxBackoff: DEFAULT_MAX_BACKOFF,
+		Jitter:     true,
+	}
+
+	return p
+}
+
+// NewRetryPolicyFromQuery derives a new RetryPolicy instance from the following query parameters:
+// ?retries={INT}, ?min_backoff={DURATION}, ?max_backoff={DURATION}, ?retry_jitter={BOOLEAN}
+// ?retry_uploads={BOOLEAN} and ?retry_writes={BOOLEAN}. Missing parameters are assigned the values from DefaultRetryPolicy.
+func NewRetryPolicyFromQuery(q url.Values) (*RetryPolicy, error) {
+
+	p := DefaultRetryPolicy()
+
+	if q.Has("retries") {
+
+		v, err := strconv.Atoi(q.Get("retries"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?retries parameter, %w", err)
+		}
+
+		if v < 0 {
+			return nil, fmt.Errorf("Invalid ?retries parameter, must be a positive number")
+		}
+
+		p.MaxRetries = v
+	}
+
+	if q.Has("min_backoff") {
+
+		v, err := time.ParseDuration(q.Get("min_backoff"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?min_backoff parameter, %w", err)
+		}
+
+		p.MinBackoff = v
+	}
+
+	if q.Has("max_backoff") {
+
+		v, err := time.ParseDuration(q.Get("max_backoff"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?max_backoff parameter, %w", err)
+		}
+
+		p.MaxBackoff = v
+	}
+
+	if p.MinBackoff > p.MaxBackoff {
+		return nil, fmt.Errorf("Invalid retry policy, ?min_backoff is greater than ?max_backoff")
+	}
+
+	if q.Has("retry_jitter") {
+
+		v, err := strconv.ParseBool(q.Get("retry_jitter"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?retry_jitter parameter, %w", err)
+		}
+
+		p.Jitter = v
+	}
+
+	if q.Has("retry_uploads") {
+
+		v, err := strconv.ParseBool(q.Get("retry_uploads"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?retry_uploads parameter, %w", err)
+		}
+
+		p.RetryUploads = v
+	}
+
+	if q.Has("retry_writes") {
+
+		v, err := strconv.ParseBool(q.Get("retry_writes"))
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ?retry_writes parameter, %w", err)
+		}
+
+		p.RetryWrites = v
+	}
+
+	return p, nil
+}
+
+// IsReadMethod returns a boolean value indicating whether the Flickr API method 'method' only reads data and is safe to
+// retry. Read methods are those in the flickr.test and flickr.reflection namespaces and those whose name starts with
+// "get", "search", "find", "lookup" or "check" (for example flickr.photos.getInfo, flickr.photosets.getList,
+// flickr.photos.search or flickr.photos.upload.checkTickets).
+func IsReadMethod(method string) bool {
+
+	if strings.HasPrefix(method, "flickr.test.") || strings.HasPrefix(method, "flickr.reflection.") {
+		return true
+	}
+
+	idx := strings.LastIndex(method, ".")
+	name := method[idx+1:]
+
+	for _, prefix := range []string{"get", "search", "find", "lookup", "check"} {
+
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShouldRetry returns a boolean value indicating whether a request should be retried given its
+// HTTP response (or error) and the number of retries that have already been attempted.
+func (p *RetryPolicy) ShouldRetry(ctx context.Context, retries int, rsp *http.Response, err error) bool {
+
+	if retries >= p.MaxRetries {
+		return false
+	}
+
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// Backoff returns the amount of time to wait before retrying a request given the number of retries that
+// have already been attempted and the (optional) HTTP response of the last attempt. If the response contains
+// a "Retry-After" header that value is used (capped at MaxBackoff), otherwise the delay is computed using
+// exponential backoff.
+func (p *RetryPolicy) Backoff(retries int, rsp *http.Response) time.Duration {
+
+	if rsp != nil {
+
+		d, ok := parseRetryAfter(rsp.Header.Get("Retry-After"), time.Now())
+
+		if ok {
+			return min(d, p.MaxBackoff)
+		}
+	}
+
+	d := p.MinBackoff
+
+	for i := 0; i < retries; i++ {
+
+		d = d * 2
+
+		if d >= p.MaxBackoff {
+			d = p.MaxBackoff
+			break
+		}
+	}
+
+	if p.Jitter && d > 0 {
+		d = time.Duration(rand.Int63n(int64(d)) + 1)
+	}
+
+	return d
+}
+
+// parseRetryAfter parses the value of a "Retry-After" header which may be either a number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+
+	if v == "" {
+		return 0, false
+	}
+
+	secs, err := strconv.Atoi(v)
+
+	if err == nil {
+
+		if secs < 0 {
+			return 0, false
+		}
+
+		return time.Duration(secs) * time.Second, true
+	}
+
+	t, err := http.ParseTime(v)
+
+	if err != nil {
+		return 0, false
+	}
+
+	d := t.Sub(now)
+
+	if d < 0 {
+		d = 0
+	}
+
+	return d, true
+}
+
+// sleepWithContext waits for 'd' or until 'ctx' is cancelled, whichever comes first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
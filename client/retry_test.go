@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRetryPolicyFromQuery(t *testing.T) {
+
+	q, _ := url.ParseQuery("retries=5&max_backoff=30s&min_backoff=250ms&retry_jitter=false")
+
+	p, err := NewRetryPolicyFromQuery(q)
+
+	if err != nil {
+		t.Fatalf("Failed to derive retry policy, %v", err)
+	}
+
+	if p.MaxRetries != 5 {
+		t.Fatalf("Unexpected max retries, %d", p.MaxRetries)
+	}
+
+	if p.MaxBackoff != 30*time.Second {
+		t.Fatalf("Unexpected max backoff, %v", p.MaxBackoff)
+	}
+
+	if p.Backoff(0, nil) != 250*time.Millisecond {
+		t.Fatalf("Unexpected initial backoff, %v", p.Backoff(0, nil))
+	}
+
+	if p.Backoff(2, nil) != time.Second {
+		t.Fatalf("Unexpected backoff, %v", p.Backoff(2, nil))
+	}
+
+	if p.Backoff(10, nil) != 30*time.Second {
+		t.Fatalf("Unexpected capped backoff, %v", p.Backoff(10, nil))
+	}
+
+	bad, _ := url.ParseQuery("retries=5&min_backoff=1m&max_backoff=1s")
+
+	_, err = NewRetryPolicyFromQuery(bad)
+
+	if err == nil {
+		t.Fatalf("Expected invalid retry policy to fail")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	now := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("7", now)
+
+	if !ok || d != 7*time.Second {
+		t.Fatalf("Failed to parse Retry-After seconds, %v", d)
+	}
+
+	d, ok = parseRetryAfter("Thu, 01 Apr 2021 12:00:10 GMT", now)
+
+	if !ok || d != 10*time.Second {
+		t.Fatalf("Failed to parse Retry-After date, %v", d)
+	}
+
+	_, ok = parseRetryAfter("soon", now)
+
+	if ok {
+		t.Fatalf("Expected invalid Retry-After value to fail")
+	}
+}
+
+func TestCallWithRetries(t *testing.T) {
+
+	ctx := context.Background()
+
+	var count int32
+	signatures := make(map[string]bool)
+
+	handler := func(rsp http.ResponseWriter, req *http.Request) {
+
+		signatures[req.URL.Query().Get("oauth_signature")] = true
+
+		if atomic.AddInt32(&count, 1) < 3 {
+			rsp.Header().Set("Retry-After", "0")
+			http.Error(rsp, "Bad gateway", http.StatusBadGateway)
+			return
+		}
+
+		rsp.Write([]byte(`{"stat":"ok"}`))
+	}
+
+	svr := httptest.NewServer(http.HandlerFunc(handler))
+	defer svr.Close()
+
+	endpoint, _ := url.Parse(svr.URL)
+
+	q, _ := url.ParseQuery("retries=2&min_backoff=1ms&max_backoff=10ms")
+	policy, _ := NewRetryPolicyFromQuery(q)
+
+	cl := &OAuth1Client{
+		http_client:     svr.Client(),
+		consumer_key:    "key",
+		consumer_secret: "secret",
+		retry_policy:    policy,
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.echo")
+
+	new_req := func(ctx context.Context) (*http.Request, error) {
+		return cl.newSignedRequest(ctx, "GET", endpoint, args, "")
+	}
+
+	fh, err := cl.call(ctx, new_req, true)
+
+	if err != nil {
+		t.Fatalf("Failed to call API with retries, %v", err)
+	}
+
+	defer fh.Close()
+
+	body, _ := io.ReadAll(fh)
+
+	if string(body) != `{"stat":"ok"}` {
+		t.Fatalf("Unexpected response body, %s", string(body))
+	}
+
+	if count != 3 {
+		t.Fatalf("Unexpected number of attempts, %d", count)
+	}
+
+	// Nonces and timestamps should be regenerated for each attempt, the latter only if
+	// the attempts span more than a second, so only ensure that signatures are not reused.
+
+	if len(signatures) != 3 {
+		t.Fatalf("Expected each attempt to be signed separately, %d", len(signatures))
+	}
+
+	atomic.StoreInt32(&count, 0)
+
+	_, err = cl.call(ctx, new_req, false)
+
+	if err == nil {
+		t.Fatalf("Expected non-replayable request to fail")
+	}
+
+	if count != 1 {
+		t.Fatalf("Unexpected number of attempts for non-replayable request, %d", count)
+	}
+}
+
+func TestUploadWithRetries(t *testing.T) {
+
+	ctx := context.Background()
+
+	var count int32
+
+	handler := func(rsp http.ResponseWriter, req *http.Request) {
+
+		if atomic.AddInt32(&count, 1) < 2 {
+			http.Error(rsp, "Service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		fh, _, err := req.FormFile("photo")
+
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		defer fh.Close()
+
+		body, _ := io.ReadAll(fh)
+		rsp.Write(body)
+	}
+
+	svr := httptest.NewServer(http.HandlerFunc(handler))
+	defer svr.Close()
+
+	endpoint, _ := url.Parse(svr.URL)
+
+	q, _ := url.ParseQuery("retries=2&min_backoff=1ms&max_backoff=10ms&retry_uploads=true")
+	policy, _ := NewRetryPolicyFromQuery(q)
+
+	cl := &OAuth1Client{
+		http_client:     svr.Client(),
+		consumer_key:    "key",
+		consumer_secret: "secret",
+		retry_policy:    policy,
+	}
+
+	photo := strings.NewReader("this is a photo")
+
+	fh, err := cl.upload(ctx, endpoint, photo, &url.Values{})
+
+	if err != nil {
+		t.Fatalf("Failed to upload with retries, %v", err)
+	}
+
+	defer fh.Close()
+
+	body, _ := io.ReadAll(fh)
+
+	if string(body) != "this is a photo" {
+		t.Fatalf("Unexpected upload body, '%s'", string(body))
+	}
+
+	if count != 2 {
+		t.Fatalf("Unexpected number of attempts, %d", count)
+	}
+}
+
+func TestExecuteMethodRetries(t *testing.T) {
+
+	ctx := context.Background()
+
+	var count int32
+
+	handler := func(rsp http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&count, 1)
+		http.Error(rsp, "Internal server error", http.StatusInternalServerError)
+	}
+
+	svr := httptest.NewServer(http.HandlerFunc(handler))
+	defer svr.Close()
+
+	tests := []struct {
+		query    string
+		method   string
+		attempts int32
+	}{
+		{"retries=2", "flickr.photos.getInfo", 3},
+		{"retries=2", "flickr.photos.upload.checkTickets", 3},
+		{"retries=2", "flickr.test.login", 3},
+		{"retries=2", "flickr.photos.delete", 1},
+		{"retries=2", "flickr.photosets.create", 1},
+		{"retries=2", "flickr.photos.comments.addComment", 1},
+		{"retries=2&retry_writes=true", "flickr.photosets.create", 3},
+	}
+
+	for _, test := range tests {
+
+		q, _ := url.ParseQuery(test.query + "&min_backoff=1ms&max_backoff=10ms")
+		policy, err := NewRetryPolicyFromQuery(q)
+
+		if err != nil {
+			t.Fatalf("Failed to derive retry policy for '%s', %v", test.query, err)
+		}
+
+		cl := &OAuth1Client{
+			http_client:     svr.Client(),
+			api_endpoint:    svr.URL,
+			consumer_key:    "key",
+			consumer_secret: "secret",
+			retry_policy:    policy,
+		}
+
+		atomic.StoreInt32(&count, 0)
+
+		args := &url.Values{}
+		args.Set("method", test.method)
+
+		_, err = cl.ExecuteMethod(ctx, args)
+
+		if err == nil {
+			t.Fatalf("Expected %s to fail", test.method)
+		}
+
+		if count != test.attempts {
+			t.Fatalf("Unexpected number of attempts for %s (%s), %d", test.method, test.query, count)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// The (approximate) maximum number of results the flickr.photos.search API method will return for a single query. Requests
+// for pages past this limit return duplicate results.
+const SEARCH_RESULTS_CAP int = 4000
+
+// Slice flickr.photos.search queries in to windows using the "min_upload_date" and "max_upload_date" arguments.
+const SEARCH_DATE_UPLOAD string = "upload"
+
+// Slice flickr.photos.search queries in to windows using the "min_taken_date" and "max_taken_date" arguments.
+const SEARCH_DATE_TAKEN string = "taken"
+
+// The earliest upload date used when slicing queries in to windows if no "min_upload_date" argument is present.
+var SEARCH_MIN_UPLOAD_DATE = time.Date(2004, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// The earliest taken date used when slicing queries in to windows if no "min_taken_date" argument is present.
+var SEARCH_MIN_TAKEN_DATE = time.Date(1826, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// The format for "min_taken_date" and "max_taken_date" arguments.
+const SEARCH_TAKEN_DATE_FORMAT string = "2006-01-02 15:04:05"
+
+// SearchOptions is a struct containing options for the SearchPhotosWithClient method.
+type SearchOptions struct {
+	// The date (SEARCH_DATE_UPLOAD or SEARCH_DATE_TAKEN) used to slice queries in to windows. If empty SEARCH_DATE_UPLOAD
+	// is used unless "min_taken_date" or "max_taken_date" arguments are present.
+	DateField string
+	// The maximum number of results for a single window. Windows with more results are bisected. If 0 SEARCH_RESULTS_CAP is used.
+	ResultsCap int
+	// The maximum number of photos to yield. If 0 all the photos are yielded.
+	MaxItems int
+	// The maximum number of pages, within a window, to fetch concurrently. See PaginateOptions for details.
+	Workers int
+}
+
+// SearchPhotosWithClient returns an iterator that invokes the flickr.photos.search API method using a Client instance,
+// yielding each photo matching 'args' exactly once. Since the Flickr API will only return (roughly) the first 4000 results
+// for a query, it is sliced in to date windows (bounded by any "min_" and "max_" date arguments in 'args') and any window
+// whose total number of results exceeds opts.ResultsCap is recursively bisected. Windows are searched in the order specified
+// by the "sort" argument. Photos that appear in more than one window are only yielded once. Windows that can not be bisected
+// any further (because they are only one second wide) return, at most, opts.ResultsCap results. If no "per_page" argument
+// is present the maximum value (500) is used. 'args' is not modified. 'opts' may be nil.
+func SearchPhotosWithClient(ctx context.Context, cl Client, args *url.Values, opts *SearchOptions) iter.Seq2[gjson.Result, error] {
+
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	return func(yield func(gjson.Result, error) bool) {
+
+		date_field := opts.DateField
+
+		if date_field == "" {
+
+			date_field = SEARCH_DATE_UPLOAD
+
+			if args.Get("min_taken_date") != "" || args.Get("max_taken_date") != "" {
+				date_field = SEARCH_DATE_TAKEN
+			}
+		}
+
+		var min_arg string
+		var max_arg string
+		var min_date time.Time
+
+		switch date_field {
+		case SEARCH_DATE_UPLOAD:
+			min_arg = "min_upload_date"
+			max_arg = "max_upload_date"
+			min_date = SEARCH_MIN_UPLOAD_DATE
+		case SEARCH_DATE_TAKEN:
+			min_arg = "min_taken_date"
+			max_arg = "max_taken_date"
+			min_date = SEARCH_MIN_TAKEN_DATE
+		default:
+			yield(gjson.Result{}, fmt.Errorf("Invalid date field '%s'", date_field))
+			return
+		}
+
+		max_date := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+		if args.Get(min_arg) != "" {
+
+			t, err := parseSearchDate(args.Get(min_arg))
+
+			if err != nil {
+				yield(gjson.Result{}, fmt.Errorf("Invalid %s argument, %w", min_arg, err))
+				return
+			}
+
+			min_date = t
+		}
+
+		if args.Get(max_arg) != "" {
+
+			t, err := parseSearchDate(args.Get(max_arg))
+
+			if err != nil {
+				yield(gjson.Result{}, fmt.Errorf("Invalid %s argument, %w", max_arg, err))
+				return
+			}
+
+			max_date = t
+		}
+
+		results_cap := opts.ResultsCap
+
+		if results_cap == 0 {
+			results_cap = SEARCH_RESULTS_CAP
+		}
+
+		search_args := &url.Values{}
+
+		for k, v := range *args {
+			(*search_args)[k] = v
+		}
+
+		search_args.Set("method", "flickr.photos.search")
+
+		if search_args.Get("per_page") == "" {
+			search_args.Set("per_page", "500")
+		}
+
+		ascending := strings.HasSuffix(search_args.Get("sort"), "-asc")
+
+		format_date := func(t time.Time) string {
+
+			if date_field == SEARCH_DATE_TAKEN {
+				return t.UTC().Format(SEARCH_TAKEN_DATE_FORMAT)
+			}
+
+			return strconv.FormatInt(t.Unix(), 10)
+		}
+
+		seen := make(map[string]bool)
+		count := 0
+
+		// yieldItems yields the photos in 'body' that haven't already been seen. It returns false if iteration should stop.
+
+		yieldItems := func(body []byte) bool {
+
+			for _, ph := range gjson.GetBytes(body, DEFAULT_ITEMS_PATH).Array() {
+
+				id := ph.Get("id").String()
+
+				if seen[id] {
+					continue
+				}
+
+				seen[id] = true
+
+				if !yield(ph, nil) {
+					return false
+				}
+
+				count += 1
+
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		// searchWindow yields the photos uploaded (or taken) between 'min_date' and 'max_date', inclusive, bisecting the
+		// window if necessary. It returns false if iteration should stop.
+
+		var searchWindow func(time.Time, time.Time) bool
+
+		searchWindow = func(min_date time.Time, max_date time.Time) bool {
+
+			err := ctx.Err()
+
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return false
+			}
+
+			window_args := &url.Values{}
+
+			for k, v := range *search_args {
+				(*window_args)[k] = v
+			}
+
+			window_args.Set(min_arg, format_date(min_date))
+			window_args.Set(max_arg, format_date(max_date))
+
+			pg, err := executeMethodPage(ctx, cl, window_args, 1)
+
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return false
+			}
+
+			if pg.Pagination.Total > results_cap && max_date.Sub(min_date) > time.Second {
+
+				mid_date := min_date.Add(max_date.Sub(min_date) / 2).Truncate(time.Second)
+
+				if ascending {
+					return searchWindow(min_date, mid_date) && searchWindow(mid_date.Add(time.Second), max_date)
+				}
+
+				return searchWindow(mid_date.Add(time.Second), max_date) && searchWindow(min_date, mid_date)
+			}
+
+			if !yieldItems(pg.Body) {
+				return false
+			}
+
+			// Only fetch the pages within the results cap since later pages contain duplicate results.
+
+			max_pages := min(pg.Pagination.Pages, (results_cap+pg.Pagination.PerPage-1)/max(pg.Pagination.PerPage, 1)) - 1
+
+			if max_pages < 1 {
+				return true
+			}
+
+			window_args.Set("page", "2")
+
+			paginate_opts := &PaginateOptions{
+				MaxPages: max_pages,
+				Workers:  opts.Workers,
+			}
+
+			for pg, err := range ExecuteMethodPagesWithClient(ctx, cl, window_args, paginate_opts) {
+
+				if err != nil {
+					yield(gjson.Result{}, err)
+					return false
+				}
+
+				if !yieldItems(pg.Body) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		searchWindow(min_date, max_date)
+	}
+}
+
+// parseSearchDate parses 'v', which may be either a Unix timestamp or a MySQL datetime string, in to a time.Time instance.
+func parseSearchDate(v string) (time.Time, error) {
+
+	ts, err := strconv.ParseInt(v, 10, 64)
+
+	if err == nil {
+		return time.Unix(ts, 0), nil
+	}
+
+	for _, layout := range []string{SEARCH_TAKEN_DATE_FORMAT, "2006-01-02"} {
+
+		t, err := time.Parse(layout, v)
+
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("Invalid date '%s'", v)
+}
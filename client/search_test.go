@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/flickrtest"
+)
+
+func TestSearchPhotosWithClient(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	svr.SearchLimit = 10
+
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 25; i++ {
+
+		ph := &flickrtest.Photo{
+			Owner:      svr.UserId,
+			Title:      fmt.Sprintf("Photo %d", i),
+			DateUpload: base.Add(time.Duration(i) * time.Hour),
+		}
+
+		svr.Store.AddPhoto(ph)
+	}
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.search")
+	args.Set("user_id", "me")
+	args.Set("per_page", "4")
+
+	// Plain pagination only ever sees the first SearchLimit results.
+
+	paginated := make(map[string]bool)
+
+	for ph, err := range ExecuteMethodItemsWithClient(ctx, cl, args, nil) {
+
+		if err != nil {
+			t.Fatalf("Failed to paginate results, %v", err)
+		}
+
+		paginated[ph.Get("id").String()] = true
+	}
+
+	if len(paginated) != 10 {
+		t.Fatalf("Expected search limit to be applied, %d", len(paginated))
+	}
+
+	opts := &SearchOptions{
+		ResultsCap: 10,
+		Workers:    2,
+	}
+
+	searched := make(map[string]bool)
+
+	for ph, err := range SearchPhotosWithClient(ctx, cl, args, opts) {
+
+		if err != nil {
+			t.Fatalf("Failed to search photos, %v", err)
+		}
+
+		id := ph.Get("id").String()
+
+		if searched[id] {
+			t.Fatalf("Photo %s yielded more than once", id)
+		}
+
+		searched[id] = true
+	}
+
+	if len(searched) != 25 {
+		t.Fatalf("Unexpected number of photos, %d", len(searched))
+	}
+
+	args.Set("sort", "date-posted-asc")
+	args.Set("max_upload_date", fmt.Sprintf("%d", base.Add(19*time.Hour).Unix()))
+
+	titles := make([]string, 0)
+
+	for ph, err := range SearchPhotosWithClient(ctx, cl, args, opts) {
+
+		if err != nil {
+			t.Fatalf("Failed to search photos, %v", err)
+		}
+
+		titles = append(titles, ph.Get("title").String())
+	}
+
+	if len(titles) != 20 {
+		t.Fatalf("Unexpected number of photos with max_upload_date, %d", len(titles))
+	}
+
+	for i, title := range titles {
+
+		if title != fmt.Sprintf("Photo %d", i) {
+			t.Fatalf("Unexpected order, expected 'Photo %d' but got '%s'", i, title)
+		}
+	}
+
+	opts.MaxItems = 5
+	count := 0
+
+	for _, err := range SearchPhotosWithClient(ctx, cl, args, opts) {
+
+		if err != nil {
+			t.Fatalf("Failed to search photos, %v", err)
+		}
+
+		count += 1
+	}
+
+	if count != 5 {
+		t.Fatalf("Unexpected number of photos with MaxItems, %d", count)
+	}
+}
@@ -0,0 +1,517 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// The default interval between calls to the flickr.photos.upload.checkTickets method.
+const DEFAULT_TICKET_POLL_INTERVAL time.Duration = 2 * time.Second
+
+// The default maximum interval between calls to the flickr.photos.upload.checkTickets method when backing off.
+const DEFAULT_TICKET_MAX_POLL_INTERVAL time.Duration = 30 * time.Second
+
+// The default maximum number of tickets to check in a single call to the flickr.photos.upload.checkTickets method.
+const DEFAULT_TICKET_BATCH_SIZE int = 100
+
+// The default maximum amount of time to wait for an upload ticket to resolve.
+const DEFAULT_TICKET_MAX_WAIT time.Duration = 30 * time.Minute
+
+// The status of an upload ticket that has not resolved yet (because the wait timed out or was cancelled).
+const TICKET_STATUS_PENDING string = "pending"
+
+// The status of an upload ticket that has completed successfully.
+const TICKET_STATUS_COMPLETE string = "complete"
+
+// The status of an upload ticket that the Flickr API reports as failed.
+const TICKET_STATUS_FAILED string = "failed"
+
+// The status of an upload ticket that the Flickr API reports as invalid.
+const TICKET_STATUS_INVALID string = "invalid"
+
+// ErrTicketFailed is returned when the Flickr API reports that an upload ticket has failed.
+var ErrTicketFailed = errors.New("Upload ticket failed")
+
+// ErrTicketInvalid is returned when the Flickr API reports that an upload ticket ID is invalid.
+var ErrTicketInvalid = errors.New("Invalid upload ticket")
+
+// ErrTicketTimeout is returned when an upload ticket does not resolve within the maximum wait time.
+var ErrTicketTimeout = errors.New("Timed out waiting for upload ticket")
+
+// ErrTicketPollerClosed is returned for tickets that are still outstanding when a TicketPoller is closed.
+var ErrTicketPollerClosed = errors.New("Ticket poller closed")
+
+// TicketResult is a struct containing the outcome of an asynchronous upload ticket.
+type TicketResult struct {
+	// The ID of the upload ticket.
+	TicketId string
+	// The status of the upload ticket. One of TICKET_STATUS_COMPLETE, TICKET_STATUS_FAILED, TICKET_STATUS_INVALID or TICKET_STATUS_PENDING.
+	Status string
+	// The photo ID assigned to a successful upload.
+	PhotoId int64
+	// The time a successful upload was imported.
+	Imported time.Time
+	// An error if the upload failed, the ticket is invalid or the wait timed out or was cancelled.
+	Error error
+}
+
+// TicketPollerOptions is a struct containing configuration details for a new TicketPoller instance.
+type TicketPollerOptions struct {
+	// The initial interval between calls to the flickr.photos.upload.checkTickets method. If 0 DEFAULT_TICKET_POLL_INTERVAL is used.
+	Interval time.Duration
+	// The maximum interval between calls to the flickr.photos.upload.checkTickets method. If 0 DEFAULT_TICKET_MAX_POLL_INTERVAL is used.
+	MaxInterval time.Duration
+	// The maximum number of tickets to check in a single API call. If 0 DEFAULT_TICKET_BATCH_SIZE is used.
+	BatchSize int
+	// The maximum amount of time to wait for each ticket to resolve, after which it resolves with ErrTicketTimeout. If 0
+	// DEFAULT_TICKET_MAX_WAIT is used. If less than 0 there is no maximum.
+	MaxWait time.Duration
+}
+
+// TicketPoller checks the status of many outstanding asynchronous upload tickets by multiplexing them in to a single
+// call to the flickr.photos.upload.checkTickets method per interval (or more than one call if there are more tickets than
+// the batch size). The first ticket added while there are no outstanding tickets is checked immediately. The interval is
+// reset whenever a ticket resolves or a new ticket is added and otherwise backs off, up to a maximum interval, while tickets
+// are still being processed or if they could not be checked. TicketPoller instances are safe to use from multiple goroutines.
+type TicketPoller struct {
+	client       Client
+	interval     time.Duration
+	max_interval time.Duration
+	batch_size   int
+	max_wait     time.Duration
+	mu           sync.Mutex
+	waiters      map[string][]chan *TicketResult
+	deadlines    map[string]time.Time
+	closed_err   error
+	check_err    error
+	wake         chan bool
+	done         chan bool
+	close_once   sync.Once
+}
+
+// NewTicketPoller returns a new TicketPoller instance that checks tickets using 'cl' until 'ctx' is cancelled or the Close method is called.
+func NewTicketPoller(ctx context.Context, cl Client, opts *TicketPollerOptions) (*TicketPoller, error) {
+
+	if opts == nil {
+		opts = &TicketPollerOptions{}
+	}
+
+	interval := opts.Interval
+
+	if interval == 0 {
+		interval = DEFAULT_TICKET_POLL_INTERVAL
+	}
+
+	max_interval := opts.MaxInterval
+
+	if max_interval == 0 {
+		max_interval = max(DEFAULT_TICKET_MAX_POLL_INTERVAL, interval)
+	}
+
+	batch_size := opts.BatchSize
+
+	if batch_size == 0 {
+		batch_size = DEFAULT_TICKET_BATCH_SIZE
+	}
+
+	max_wait := opts.MaxWait
+
+	if max_wait == 0 {
+		max_wait = DEFAULT_TICKET_MAX_WAIT
+	}
+
+	if interval < 0 || max_interval < interval || batch_size < 0 {
+		return nil, fmt.Errorf("Invalid ticket poller options")
+	}
+
+	p := &TicketPoller{
+		client:       cl,
+		interval:     interval,
+		max_interval: max_interval,
+		batch_size:   batch_size,
+		max_wait:     max_wait,
+		waiters:      make(map[string][]chan *TicketResult),
+		deadlines:    make(map[string]time.Time),
+		wake:         make(chan bool, 1),
+		done:         make(chan bool),
+	}
+
+	go p.run(ctx)
+
+	return p, nil
+}
+
+// Watch adds 'ticket_id' to the set of tickets being checked and returns a channel that will receive exactly one TicketResult
+// once the ticket resolves, the maximum wait time for the ticket has elapsed or the TicketPoller is closed. The ticket continues
+// to be checked until one of those things happens.
+func (p *TicketPoller) Watch(ticket_id string) <-chan *TicketResult {
+	return p.watch(ticket_id)
+}
+
+func (p *TicketPoller) watch(ticket_id string) chan *TicketResult {
+
+	ch := make(chan *TicketResult, 1)
+
+	p.mu.Lock()
+
+	if p.closed_err != nil {
+		p.mu.Unlock()
+		ch <- pendingTicketResult(ticket_id, p.closed_err)
+		return ch
+	}
+
+	p.waiters[ticket_id] = append(p.waiters[ticket_id], ch)
+
+	_, exists := p.deadlines[ticket_id]
+
+	if !exists && p.max_wait > 0 {
+		p.deadlines[ticket_id] = time.Now().Add(p.max_wait)
+	}
+
+	p.mu.Unlock()
+
+	select {
+	case p.wake <- true:
+	default:
+	}
+
+	return ch
+}
+
+// Wait blocks until 'ticket_id' resolves and returns its TicketResult, or until 'ctx' is cancelled in which case the result
+// has a TICKET_STATUS_PENDING status and the context's error is returned (and the ticket is no longer checked on behalf of
+// the caller). If the ticket failed, is invalid or timed out the result is returned along with its error.
+func (p *TicketPoller) Wait(ctx context.Context, ticket_id string) (*TicketResult, error) {
+
+	ch := p.watch(ticket_id)
+
+	select {
+	case <-ctx.Done():
+		p.forget(ticket_id, ch)
+		return pendingTicketResult(ticket_id, ctx.Err()), ctx.Err()
+	case r := <-ch:
+		return r, r.Error
+	}
+}
+
+// Close stops checking tickets. Any outstanding tickets, and tickets added after the TicketPoller is closed, are
+// resolved with ErrTicketPollerClosed.
+func (p *TicketPoller) Close() error {
+
+	p.close_once.Do(func() {
+		close(p.done)
+	})
+
+	return nil
+}
+
+// Pending returns the number of outstanding tickets.
+func (p *TicketPoller) Pending() int {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.waiters)
+}
+
+// run checks the outstanding tickets at regular intervals until 'ctx' is cancelled or the TicketPoller is closed.
+func (p *TicketPoller) run(ctx context.Context) {
+
+	interval := p.interval
+
+	// idle is true when there were no outstanding tickets the last time they were checked (or they have never been
+	// checked) in which case the next ticket to be added is checked immediately rather than after a full interval.
+
+	idle := true
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+
+		select {
+		case <-ctx.Done():
+			p.shutdown(ctx.Err())
+			return
+		case <-p.done:
+			p.shutdown(ErrTicketPollerClosed)
+			return
+		case <-p.wake:
+
+			// A new ticket was added so check it right away if the poller was idle. Otherwise stop backing off so that
+			// it is checked, along with the other outstanding tickets, after the initial interval.
+
+			switch {
+			case idle:
+				idle = false
+				timer.Reset(0)
+			case interval != p.interval:
+				interval = p.interval
+				timer.Reset(interval)
+			}
+
+		case <-timer.C:
+
+			resolved := p.check(ctx)
+			idle = p.Pending() == 0
+
+			if resolved > 0 {
+				interval = p.interval
+			} else {
+				interval = min(interval*3/2, p.max_interval)
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// check calls the flickr.photos.upload.checkTickets method for all the outstanding tickets, in batches, and returns
+// the number of tickets that were resolved.
+func (p *TicketPoller) check(ctx context.Context) int {
+
+	p.mu.Lock()
+
+	ticket_ids := make([]string, 0, len(p.waiters))
+
+	for id := range p.waiters {
+		ticket_ids = append(ticket_ids, id)
+	}
+
+	p.mu.Unlock()
+
+	resolved := 0
+
+	for start := 0; start < len(ticket_ids); start += p.batch_size {
+
+		end := min(start+p.batch_size, len(ticket_ids))
+		batch := ticket_ids[start:end]
+
+		results, err := checkTickets(ctx, p.client, batch)
+
+		// Failing to check a batch of tickets says nothing about the tickets themselves so they are left outstanding,
+		// and checked again after backing off, until they resolve or their own deadlines elapse.
+
+		if err != nil {
+
+			if ctx.Err() != nil {
+				return resolved
+			}
+
+			p.mu.Lock()
+			p.check_err = err
+			p.mu.Unlock()
+
+			continue
+		}
+
+		p.mu.Lock()
+		p.check_err = nil
+		p.mu.Unlock()
+
+		for _, r := range results {
+			p.resolve(r)
+			resolved += 1
+		}
+	}
+
+	now := time.Now()
+
+	p.mu.Lock()
+
+	expired := make([]string, 0)
+
+	for id, deadline := range p.deadlines {
+
+		if now.After(deadline) {
+			expired = append(expired, id)
+		}
+	}
+
+	check_err := p.check_err
+
+	p.mu.Unlock()
+
+	for _, id := range expired {
+
+		err := fmt.Errorf("%w, %s", ErrTicketTimeout, id)
+
+		if check_err != nil {
+			err = fmt.Errorf("%w, %s (last error checking tickets was: %v)", ErrTicketTimeout, id, check_err)
+		}
+
+		p.resolve(pendingTicketResult(id, err))
+		resolved += 1
+	}
+
+	return resolved
+}
+
+// resolve sends 'r' to all the channels waiting on its ticket and stops checking that ticket.
+func (p *TicketPoller) resolve(r *TicketResult) {
+
+	p.mu.Lock()
+	waiters := p.waiters[r.TicketId]
+	delete(p.waiters, r.TicketId)
+	delete(p.deadlines, r.TicketId)
+	p.mu.Unlock()
+
+	for _, ch := range waiters {
+		ch <- r
+	}
+}
+
+// shutdown resolves all the outstanding tickets, and any tickets that are added afterwards, with 'err'.
+func (p *TicketPoller) shutdown(err error) {
+
+	p.mu.Lock()
+	waiters := p.waiters
+	p.waiters = make(map[string][]chan *TicketResult)
+	p.deadlines = make(map[string]time.Time)
+	p.closed_err = err
+	p.mu.Unlock()
+
+	for id, chs := range waiters {
+
+		for _, ch := range chs {
+			ch <- pendingTicketResult(id, err)
+		}
+	}
+}
+
+// forget removes 'ch' from the channels waiting on 'ticket_id', and stops checking the ticket if there are no other waiters.
+func (p *TicketPoller) forget(ticket_id string, ch chan *TicketResult) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	waiters := make([]chan *TicketResult, 0)
+
+	for _, w := range p.waiters[ticket_id] {
+
+		if w != ch {
+			waiters = append(waiters, w)
+		}
+	}
+
+	if len(waiters) == 0 {
+		delete(p.waiters, ticket_id)
+		delete(p.deadlines, ticket_id)
+		return
+	}
+
+	p.waiters[ticket_id] = waiters
+}
+
+// checkTickets calls the flickr.photos.upload.checkTickets method for 'ticket_ids' and returns the results for the
+// tickets that have resolved (completed, failed or are invalid).
+func checkTickets(ctx context.Context, cl Client, ticket_ids []string) ([]*TicketResult, error) {
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.upload.checkTickets")
+	args.Set("tickets", strings.Join(ticket_ids, ","))
+
+	check_rsp, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer check_rsp.Close()
+
+	check_ticket, err := response.UnmarshalCheckTicketResponse(check_rsp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if check_ticket.Uploader == nil {
+		return nil, fmt.Errorf("Invalid checkTickets response")
+	}
+
+	results := make([]*TicketResult, 0)
+
+	for _, t := range check_ticket.Uploader.Tickets {
+
+		r := &TicketResult{
+			TicketId: t.TicketId,
+		}
+
+		switch {
+		case t.Invalid == 1:
+			r.Status = TICKET_STATUS_INVALID
+			r.Error = fmt.Errorf("%w, %s", ErrTicketInvalid, t.TicketId)
+		case t.Complete == 2:
+			r.Status = TICKET_STATUS_FAILED
+			r.Error = fmt.Errorf("%w, %s", ErrTicketFailed, t.TicketId)
+		case t.Complete == 1:
+
+			// Because the Flickr API returns strings for photo IDs. A completed ticket without a valid photo ID is
+			// of no use to the caller so it is reported as failed rather than complete.
+
+			id, err := strconv.ParseInt(t.PhotoId, 10, 64)
+
+			if err != nil || id <= 0 {
+				r.Status = TICKET_STATUS_FAILED
+				r.Error = fmt.Errorf("%w, %s (invalid photo ID '%s')", ErrTicketFailed, t.TicketId, t.PhotoId)
+				break
+			}
+
+			r.Status = TICKET_STATUS_COMPLETE
+			r.PhotoId = id
+
+			// The imported time is informational only so a value that can't be parsed is left as the zero time.
+
+			if t.Imported != "" {
+
+				imported, err := t.ImportedTime()
+
+				if err == nil {
+					r.Imported = imported
+				}
+			}
+
+		default:
+			continue
+		}
+
+		results = append(results, r)
+	}
+
+	return results, nil
+}
+
+// WaitForTicketWithClient checks the status of the upload ticket 'ticket_id', using a new TicketPoller configured by 'opts'
+// (which may be nil), until it resolves and returns its TicketResult. See also the TicketPoller.Wait method.
+func WaitForTicketWithClient(ctx context.Context, cl Client, ticket_id string, opts *TicketPollerOptions) (*TicketResult, error) {
+
+	p, err := NewTicketPoller(ctx, cl, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer p.Close()
+
+	return p.Wait(ctx, ticket_id)
+}
+
+// pendingTicketResult returns a TicketResult with a TICKET_STATUS_PENDING status for 'ticket_id' and 'err'.
+func pendingTicketResult(ticket_id string, err error) *TicketResult {
+
+	r := &TicketResult{
+		TicketId: ticket_id,
+		Status:   TICKET_STATUS_PENDING,
+		Error:    err,
+	}
+
+	return r
+}
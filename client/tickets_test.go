@@ -0,0 +1,420 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+func TestTicketPoller(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewUnstartedServer()
+	svr.TicketDelay = 50 * time.Millisecond
+	svr.Start()
+
+	defer svr.Close()
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	opts := &TicketPollerOptions{
+		Interval:    20 * time.Millisecond,
+		MaxInterval: 100 * time.Millisecond,
+		BatchSize:   8,
+	}
+
+	p, err := NewTicketPoller(ctx, cl, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create ticket poller, %v", err)
+	}
+
+	defer p.Close()
+
+	count := 20
+
+	ticket_ids := make([]string, count)
+
+	for i := 0; i < count; i++ {
+		ticket_ids[i] = uploadTicket(t, ctx, cl, fmt.Sprintf("photo %d", i))
+	}
+
+	if !svr.FailTicket(ticket_ids[0]) {
+		t.Fatalf("Failed to mark ticket as failed")
+	}
+
+	photo_ids := new(sync.Map)
+	errs := make(chan error, count)
+
+	wg := new(sync.WaitGroup)
+
+	for i, id := range ticket_ids {
+
+		wg.Add(1)
+
+		go func() {
+
+			defer wg.Done()
+
+			r, err := p.Wait(ctx, id)
+
+			if i == 0 {
+
+				if !errors.Is(err, ErrTicketFailed) || r.Status != TICKET_STATUS_FAILED {
+					errs <- fmt.Errorf("Expected failed ticket error for %s, %v", id, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				errs <- fmt.Errorf("Failed to wait for ticket %s, %v", id, err)
+				return
+			}
+
+			if r.Status != TICKET_STATUS_COMPLETE || r.Imported.IsZero() {
+				errs <- fmt.Errorf("Unexpected result for ticket %s, %v", id, r)
+				return
+			}
+
+			photo_id := r.PhotoId
+
+			_, loaded := photo_ids.LoadOrStore(photo_id, true)
+
+			if photo_id == 0 || loaded {
+				errs <- fmt.Errorf("Unexpected photo ID %d for ticket %s", photo_id, id)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// Tickets are multiplexed in to batches of 8 so there should be far fewer calls than one per ticket per interval
+
+	calls := svr.Calls("flickr.photos.upload.checkTickets")
+
+	if calls == 0 || calls > count {
+		t.Fatalf("Unexpected number of calls to check tickets, %d", calls)
+	}
+
+	if p.Pending() != 0 {
+		t.Fatalf("Expected no pending tickets, %d", p.Pending())
+	}
+
+	r, err := p.Wait(ctx, "invalid")
+
+	if !errors.Is(err, ErrTicketInvalid) || r.Status != TICKET_STATUS_INVALID {
+		t.Fatalf("Expected invalid ticket error, %v", err)
+	}
+
+	cancel_ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	svr.TicketDelay = time.Hour
+	pending_id := uploadTicket(t, ctx, cl, "pending")
+
+	r, err = p.Wait(cancel_ctx, pending_id)
+
+	if !errors.Is(err, context.DeadlineExceeded) || r.Status != TICKET_STATUS_PENDING {
+		t.Fatalf("Expected deadline exceeded error, %v", err)
+	}
+
+	ch := p.Watch(pending_id)
+
+	p.Close()
+
+	r = <-ch
+
+	if !errors.Is(r.Error, ErrTicketPollerClosed) {
+		t.Fatalf("Expected poller closed error, %v", r.Error)
+	}
+
+	_, err = p.Wait(ctx, pending_id)
+
+	if !errors.Is(err, ErrTicketPollerClosed) {
+		t.Fatalf("Expected poller closed error, %v", err)
+	}
+}
+
+func TestWaitForTicketWithClient(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewUnstartedServer()
+	svr.TicketDelay = time.Hour
+	svr.Start()
+
+	defer svr.Close()
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	ticket_id := uploadTicket(t, ctx, cl, "photo")
+
+	opts := &TicketPollerOptions{
+		Interval: 10 * time.Millisecond,
+		MaxWait:  50 * time.Millisecond,
+	}
+
+	r, err := WaitForTicketWithClient(ctx, cl, ticket_id, opts)
+
+	if !errors.Is(err, ErrTicketTimeout) || r.Status != TICKET_STATUS_PENDING {
+		t.Fatalf("Expected timeout error, %v", err)
+	}
+
+	_, err = CheckTicketWithClient(ctx, cl, &response.UploadTicket{TicketId: "invalid"})
+
+	if !errors.Is(err, ErrTicketInvalid) {
+		t.Fatalf("Expected invalid ticket error, %v", err)
+	}
+
+	cancel_ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	photo_id, err := CheckTicketWithClient(cancel_ctx, cl, &response.UploadTicket{TicketId: ticket_id})
+
+	if !errors.Is(err, context.Canceled) || photo_id != 0 {
+		t.Fatalf("Expected context cancelled error, %v", err)
+	}
+}
+
+// uploadTicket uploads 'body' asynchronously and returns the upload ticket ID.
+func uploadTicket(t *testing.T, ctx context.Context, cl Client, body string) string {
+
+	args := &url.Values{}
+	args.Set("async", "1")
+
+	fh, err := cl.Upload(ctx, bytes.NewReader([]byte(body)), args)
+
+	if err != nil {
+		t.Fatalf("Failed to upload, %v", err)
+	}
+
+	defer fh.Close()
+
+	ticket, err := response.UnmarshalUploadTicketResponse(fh)
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal ticket, %v", err)
+	}
+
+	return ticket.TicketId
+}
+
+func TestTicketPollerCheckError(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewUnstartedServer()
+	svr.TicketDelay = 10 * time.Millisecond
+	svr.Start()
+
+	defer svr.Close()
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	ticket_ids := make([]string, 3)
+
+	for i := range ticket_ids {
+		ticket_ids[i] = uploadTicket(t, ctx, cl, fmt.Sprintf("photo %d", i))
+	}
+
+	// The first call to check tickets fails, the next one succeeds
+
+	flaky_cl := &failingCheckTicketsClient{
+		Client:   cl,
+		failures: 1,
+	}
+
+	opts := &TicketPollerOptions{
+		Interval:    20 * time.Millisecond,
+		MaxInterval: 50 * time.Millisecond,
+	}
+
+	p, err := NewTicketPoller(ctx, flaky_cl, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create ticket poller, %v", err)
+	}
+
+	defer p.Close()
+
+	chs := make([]<-chan *TicketResult, len(ticket_ids))
+
+	for i, id := range ticket_ids {
+		chs[i] = p.Watch(id)
+	}
+
+	for i, ch := range chs {
+
+		r := <-ch
+
+		if r.Error != nil || r.Status != TICKET_STATUS_COMPLETE || r.PhotoId == 0 {
+			t.Fatalf("Unexpected result for ticket %s, %v", ticket_ids[i], r)
+		}
+	}
+
+	if flaky_cl.Calls() < 2 {
+		t.Fatalf("Expected tickets to be checked again after failing, %d calls", flaky_cl.Calls())
+	}
+
+	// Tickets that can never be checked resolve once their own deadline elapses
+
+	flaky_cl = &failingCheckTicketsClient{
+		Client:   cl,
+		failures: -1,
+	}
+
+	opts.MaxWait = 100 * time.Millisecond
+
+	r, err := WaitForTicketWithClient(ctx, flaky_cl, ticket_ids[0], opts)
+
+	if !errors.Is(err, ErrTicketTimeout) || r.Status != TICKET_STATUS_PENDING {
+		t.Fatalf("Expected timeout error, %v", err)
+	}
+}
+
+// failingCheckTicketsClient wraps a Client and fails the first 'failures' calls to the flickr.photos.upload.checkTickets
+// method, or every call if 'failures' is less than 0.
+type failingCheckTicketsClient struct {
+	Client
+	mu       sync.Mutex
+	failures int
+	calls    int
+}
+
+func (cl *failingCheckTicketsClient) ExecuteMethod(ctx context.Context, args *url.Values) (io.ReadSeekCloser, error) {
+
+	if args.Get("method") == "flickr.photos.upload.checkTickets" {
+
+		cl.mu.Lock()
+		cl.calls += 1
+		fail := cl.failures < 0 || cl.calls <= cl.failures
+		cl.mu.Unlock()
+
+		if fail {
+			return nil, &response.Error{Code: 105, Message: "Service currently unavailable"}
+		}
+	}
+
+	return cl.Client.ExecuteMethod(ctx, args)
+}
+
+func (cl *failingCheckTicketsClient) Calls() int {
+
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	return cl.calls
+}
+
+func TestTicketPollerImmediateCheck(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	ticket_id := uploadTicket(t, ctx, cl, "photo")
+
+	// The interval is much longer than the test's timeout so the ticket only resolves if the first check is immediate
+
+	opts := &TicketPollerOptions{
+		Interval:    time.Hour,
+		MaxInterval: time.Hour,
+	}
+
+	wait_ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	r, err := WaitForTicketWithClient(wait_ctx, cl, ticket_id, opts)
+
+	if err != nil || r.Status != TICKET_STATUS_COMPLETE || r.PhotoId == 0 {
+		t.Fatalf("Expected ticket to be checked immediately, %v (%v)", r, err)
+	}
+}
+
+func TestTicketInvalidPhotoId(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	// The ticket is reported as complete but without a usable photo ID
+
+	err := svr.HandleMethod("flickr.photos.upload.checkTickets", "none", func(req *flickrtest.Request) (map[string]any, error) {
+
+		ticket := map[string]any{
+			"id":       req.Args.Get("tickets"),
+			"complete": 1,
+			"photoid":  "not-a-number",
+		}
+
+		rsp := map[string]any{
+			"uploader": map[string]any{
+				"ticket": []map[string]any{ticket},
+			},
+		}
+
+		return rsp, nil
+	})
+
+	if err != nil {
+		t.Fatalf("Failed to register checkTickets handler, %v", err)
+	}
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	opts := &TicketPollerOptions{
+		Interval: 10 * time.Millisecond,
+	}
+
+	r, err := WaitForTicketWithClient(ctx, cl, "1234", opts)
+
+	if !errors.Is(err, ErrTicketFailed) || r.Status != TICKET_STATUS_FAILED || r.PhotoId != 0 {
+		t.Fatalf("Expected failed ticket, %v (%v)", r, err)
+	}
+
+	photo_id, err := CheckTicketWithClient(ctx, cl, &response.UploadTicket{TicketId: "1234"})
+
+	if !errors.Is(err, ErrTicketFailed) || photo_id != 0 {
+		t.Fatalf("Expected failed ticket error, %d (%v)", photo_id, err)
+	}
+}
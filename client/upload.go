@@ -1,15 +1,113 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"fmt"
 	"io"
+	"io/fs"
+	"mime"
 	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"net/url"
 	"path/filepath"
+	"strings"
+	"time"
 )
 
+// The size, in bytes, of the chunks in which upload bodies are written.
+const UPLOAD_CHUNK_SIZE int = 32 * 1024
+
+// UploadProgressFunc is the interface for callback functions which are invoked as the body of an upload is sent. It is
+// passed the number of bytes sent so far and the total size of the body, or -1 if the size is not known. If an upload
+// is retried the number of bytes sent starts again from zero.
+type UploadProgressFunc func(int64, int64)
+
+// UploadOptions is a struct containing options for sending the body of an upload (or replacement). UploadOptions are
+// assigned to the context.Context passed to a Client's Upload or Replace methods using the WithUploadOptions method.
+type UploadOptions struct {
+	// An optional callback function invoked each time a chunk of the upload body is sent.
+	Progress UploadProgressFunc
+	// An optional RateLimiter, where each token is a single byte, used to limit the number of bytes sent per second.
+	// Limiters may be shared by concurrent uploads to cap their combined bandwidth. See also NewBandwidthLimiter.
+	Limiter *RateLimiter
+}
+
+type uploadOptionsKey struct{}
+
+// WithUploadOptions returns a copy of 'ctx' with 'opts' assigned as the options for any uploads (or replacements)
+// performed using that context.
+func WithUploadOptions(ctx context.Context, opts *UploadOptions) context.Context {
+	return context.WithValue(ctx, uploadOptionsKey{}, opts)
+}
+
+// UploadOptionsFromContext returns the UploadOptions assigned to 'ctx', by the WithUploadOptions method, and a boolean
+// flag indicating whether any options were found.
+func UploadOptionsFromContext(ctx context.Context) (*UploadOptions, bool) {
+
+	opts, ok := ctx.Value(uploadOptionsKey{}).(*UploadOptions)
+
+	if !ok || opts == nil {
+		return nil, false
+	}
+
+	return opts, true
+}
+
+// NewBandwidthLimiter returns a new RateLimiter instance allowing 'bytes_per_second' bytes to be sent per second for use
+// with the UploadOptions.Limiter property.
+func NewBandwidthLimiter(bytes_per_second int64) (*RateLimiter, error) {
+
+	if bytes_per_second <= 0 {
+		return nil, fmt.Errorf("Invalid bandwidth, must be greater than zero")
+	}
+
+	burst := min(bytes_per_second, int64(UPLOAD_CHUNK_SIZE))
+	return NewRateLimiter(int(bytes_per_second), time.Second, int(burst))
+}
+
+// The file name used for uploads whose file name is not known.
+const DEFAULT_UPLOAD_FILE_NAME string = "upload"
+
+// The number of bytes read from the start of an upload to detect its MIME type.
+const UPLOAD_SNIFF_LENGTH int = 512
+
+// UploadFile is an io.ReadSeeker that wraps an io.Reader with the file name, size and MIME type to use when it is uploaded.
+// The Flickr API uses the file name to derive a default title for uploads. If an UploadFile (or the underlying reader) is
+// passed to the Upload or Replace methods of the OAuth1Client any properties that are not set are derived from the
+// underlying reader if it implements the Name, Stat, Size or ContentType methods (as *os.File, fs.File and *blob.Reader
+// instances do), or the io.Seeker interface, or else from the file name and contents.
+type UploadFile struct {
+	// The body of the file to upload.
+	Reader io.Reader
+	// The name of the file to upload.
+	FileName string
+	// The size, in bytes, of the file to upload. If 0 the size is derived from Reader. If the size is not known the body
+	// of the upload is sent using chunked transfer encoding.
+	Size int64
+	// The MIME type of the file to upload. If empty the MIME type is derived from the file name or its contents.
+	ContentType string
+}
+
+// Read reads from the underlying reader.
+func (f *UploadFile) Read(p []byte) (int, error) {
+	return f.Reader.Read(p)
+}
+
+// Seek seeks the underlying reader, returning an error if it does not implement the io.Seeker interface.
+func (f *UploadFile) Seek(offset int64, whence int) (int64, error) {
+
+	seeker, ok := f.Reader.(io.Seeker)
+
+	if !ok {
+		return 0, fmt.Errorf("Underlying reader does not implement io.Seeker")
+	}
+
+	return seeker.Seek(offset, whence)
+}
+
 // Most of the code in this file has been copypasted with minor
 // updates from https://github.com/masci/flickr/blob/v2/upload.go
 
@@ -27,34 +125,326 @@ func randomBoundary() (string, error) {
 	return boundary, nil
 }
 
-// Encode the file and request parameters in a multipart body.
-// File contents are streamed into the request using an io.Pipe in a separated goroutine
-func streamUploadBody(ctx context.Context, body *io.PipeWriter, file_name string, boundary string, fh io.Reader, args *url.Values) error {
+// multipartBody is a struct containing the multipart encoding of a file and request parameters. The body is the
+// concatenation of prefix, the contents of the file and suffix so its length is known whenever the size of the file is.
+type multipartBody struct {
+	boundary string
+	prefix   []byte
+	suffix   []byte
+	size     int64
+}
 
-	// multipart writer to fill the body
-	defer body.Close()
+// Encode the file and request parameters in a multipart body. 'size' is the size of the file, or -1 if it is not known.
+func newMultipartBody(boundary string, file_name string, content_type string, size int64, args *url.Values) (*multipartBody, error) {
 
-	writer := multipart.NewWriter(body)
-	writer.SetBoundary(boundary)
+	buf := new(bytes.Buffer)
 
-	part, err := writer.CreateFormFile("photo", filepath.Base(file_name))
+	// multipart writer to fill the body
+	writer := multipart.NewWriter(buf)
+
+	err := writer.SetBoundary(boundary)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// fill the photo field
-	_, err = io.Copy(part, fh)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="photo"; filename="%s"`, escapeQuotes(filepath.Base(file_name))))
+	h.Set("Content-Type", content_type)
+
+	_, err = writer.CreatePart(h)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	prefix := bytes.Clone(buf.Bytes())
+	buf.Reset()
+
 	// dump other params
 	for key, val := range *args {
 		_ = writer.WriteField(key, val[0])
 	}
 
 	// close the form writer
-	return writer.Close()
+	err = writer.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	b := &multipartBody{
+		boundary: boundary,
+		prefix:   prefix,
+		suffix:   buf.Bytes(),
+		size:     size,
+	}
+
+	return b, nil
+}
+
+// ContentLength returns the length of the encoded body, or -1 if it is not known.
+func (b *multipartBody) ContentLength() int64 {
+
+	if b.size < 0 {
+		return -1
+	}
+
+	return int64(len(b.prefix)) + b.size + int64(len(b.suffix))
+}
+
+// Stream the encoded body, reading the contents of the file from 'fh'.
+// File contents are streamed into the request using an io.Pipe in a separated goroutine
+// If an error occurs the pipe is closed with that error, rather than closed cleanly, so that a truncated body is never sent as if it were complete.
+func streamUploadBody(ctx context.Context, body *io.PipeWriter, b *multipartBody, fh io.Reader) error {
+
+	err := writeUploadBody(ctx, body, b, fh)
+
+	if err != nil {
+		body.CloseWithError(err)
+		return err
+	}
+
+	return body.Close()
+}
+
+// writeUploadBody writes the encoded body, reading the contents of the file from 'fh', to 'wr'.
+func writeUploadBody(ctx context.Context, wr io.Writer, b *multipartBody, fh io.Reader) error {
+
+	_, err := wr.Write(b.prefix)
+
+	if err != nil {
+		return err
+	}
+
+	// fill the photo field
+	err = copyUploadBody(ctx, wr, fh, b.size)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = wr.Write(b.suffix)
+	return err
+}
+
+// copyUploadBody copies 'fh', whose size is 'total' (or -1 if it is not known), to 'wr' in chunks, applying the UploadOptions
+// assigned to 'ctx' (if present) to limit the number of bytes sent per second and to report progress.
+func copyUploadBody(ctx context.Context, wr io.Writer, fh io.Reader, total int64) error {
+
+	opts, ok := UploadOptionsFromContext(ctx)
+
+	if !ok || (opts.Progress == nil && opts.Limiter == nil) {
+		_, err := io.Copy(wr, fh)
+		return err
+	}
+
+	chunk_size := UPLOAD_CHUNK_SIZE
+
+	if opts.Limiter != nil {
+		chunk_size = min(chunk_size, int(opts.Limiter.burst))
+	}
+
+	buf := make([]byte, chunk_size)
+	var sent int64
+
+	if opts.Progress != nil {
+		opts.Progress(sent, total)
+	}
+
+	for {
+
+		n, read_err := fh.Read(buf)
+
+		if n > 0 {
+
+			if opts.Limiter != nil {
+
+				err := opts.Limiter.WaitN(ctx, n)
+
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err := wr.Write(buf[:n])
+
+			if err != nil {
+				return err
+			}
+
+			sent += int64(n)
+
+			if opts.Progress != nil {
+				opts.Progress(sent, total)
+			}
+		}
+
+		if read_err == io.EOF {
+			return nil
+		}
+
+		if read_err != nil {
+			return read_err
+		}
+	}
+}
+
+// prepareUploadFile returns the reader to use for the body of an upload along with the file name (or
+// DEFAULT_UPLOAD_FILE_NAME if it is not known), size (or -1 if it is not known) and MIME type of 'fh'. If the MIME type
+// is not known it is derived from the file name's extension or else by sniffing the contents of the file.
+func prepareUploadFile(fh io.Reader) (io.Reader, string, int64, string, error) {
+
+	file_name, size, content_type := uploadFileInfo(fh)
+
+	if file_name == "" {
+		file_name = DEFAULT_UPLOAD_FILE_NAME
+	}
+
+	if content_type == "" || content_type == "application/octet-stream" {
+		content_type = mime.TypeByExtension(filepath.Ext(file_name))
+	}
+
+	if content_type == "" {
+
+		sniffed_type, sniffed_fh, err := sniffContentType(fh)
+
+		if err != nil {
+			return nil, "", 0, "", fmt.Errorf("Failed to detect content type, %w", err)
+		}
+
+		content_type = sniffed_type
+		fh = sniffed_fh
+	}
+
+	return fh, file_name, size, content_type, nil
+}
+
+// uploadFileInfo returns the file name, size (or -1 if it is not known) and MIME type (or "" if it is not known) of 'fh'.
+func uploadFileInfo(fh io.Reader) (string, int64, string) {
+
+	file_name := ""
+	size := int64(-1)
+	content_type := ""
+
+	if f, ok := fh.(*UploadFile); ok {
+
+		file_name, size, content_type = uploadFileInfo(f.Reader)
+
+		if f.FileName != "" {
+			file_name = f.FileName
+		}
+
+		if f.Size > 0 {
+			size = f.Size
+		}
+
+		if f.ContentType != "" {
+			content_type = f.ContentType
+		}
+
+		return file_name, size, content_type
+	}
+
+	if n, ok := fh.(interface{ Name() string }); ok {
+		file_name = filepath.Base(n.Name())
+	} else if st, ok := fh.(interface{ Stat() (fs.FileInfo, error) }); ok {
+
+		info, err := st.Stat()
+
+		if err == nil {
+			file_name = info.Name()
+		}
+	}
+
+	if _, ok := fh.(io.Seeker); ok {
+		size = uploadBodySize(fh)
+	} else if sz, ok := fh.(interface{ Size() int64 }); ok {
+		size = sz.Size()
+	}
+
+	if ct, ok := fh.(interface{ ContentType() string }); ok {
+		content_type = ct.ContentType()
+	}
+
+	return file_name, size, content_type
+}
+
+// sniffContentType returns the MIME type of 'fh' derived from its first UPLOAD_SNIFF_LENGTH bytes and a reader for the
+// entire contents of 'fh'. If 'fh' implements the io.Seeker interface it is rewound and returned as-is.
+func sniffContentType(fh io.Reader) (string, io.Reader, error) {
+
+	seeker, is_seeker := fh.(io.Seeker)
+	var pos int64
+
+	if is_seeker {
+
+		p, err := seeker.Seek(0, io.SeekCurrent)
+
+		if err != nil {
+			is_seeker = false
+		} else {
+			pos = p
+		}
+	}
+
+	head := make([]byte, UPLOAD_SNIFF_LENGTH)
+
+	n, err := io.ReadFull(fh, head)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+
+	head = head[:n]
+	content_type := http.DetectContentType(head)
+
+	if is_seeker {
+
+		_, err := seeker.Seek(pos, io.SeekStart)
+
+		if err == nil {
+			return content_type, fh, nil
+		}
+	}
+
+	return content_type, io.MultiReader(bytes.NewReader(head), fh), nil
+}
+
+// escapeQuotes escapes backslashes and double quotes in a multipart header value, as the mime/multipart package does.
+func escapeQuotes(s string) string {
+	return quote_escaper.Replace(s)
+}
+
+var quote_escaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// uploadBodySize returns the number of bytes remaining to be read from 'fh' if it implements the io.Seeker interface,
+// or -1 if the size is not known.
+func uploadBodySize(fh io.Reader) int64 {
+
+	seeker, ok := fh.(io.Seeker)
+
+	if !ok {
+		return -1
+	}
+
+	pos, err := seeker.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return -1
+	}
+
+	end, err := seeker.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return -1
+	}
+
+	_, err = seeker.Seek(pos, io.SeekStart)
+
+	if err != nil {
+		return -1
+	}
+
+	return end - pos
 }
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+func TestUploadOptions(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	body := bytes.Repeat([]byte("x"), 100*1024)
+
+	limiter, err := NewBandwidthLimiter(200 * 1024)
+
+	if err != nil {
+		t.Fatalf("Failed to create bandwidth limiter, %v", err)
+	}
+
+	var last_sent int64
+	var last_total int64
+	calls := 0
+
+	opts := &UploadOptions{
+		Progress: func(sent int64, total int64) {
+
+			if sent < last_sent {
+				t.Errorf("Bytes sent decreased from %d to %d", last_sent, sent)
+			}
+
+			last_sent = sent
+			last_total = total
+			calls += 1
+		},
+		Limiter: limiter,
+	}
+
+	// The limiter starts with a full bucket of 32KB so sending the remaining 68KB at 200KB/s should take at least 300ms.
+
+	upload_ctx := WithUploadOptions(ctx, opts)
+
+	started := time.Now()
+
+	fh, err := cl.Upload(upload_ctx, bytes.NewReader(body), &url.Values{})
+
+	if err != nil {
+		t.Fatalf("Failed to upload, %v", err)
+	}
+
+	defer fh.Close()
+
+	elapsed := time.Since(started)
+
+	up, err := response.UnmarshalUploadResponse(fh)
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal upload response, %v", err)
+	}
+
+	if up.Error != nil {
+		t.Fatalf("Upload failed, %v", up.Error)
+	}
+
+	if last_sent != int64(len(body)) || last_total != int64(len(body)) {
+		t.Fatalf("Unexpected progress, %d/%d", last_sent, last_total)
+	}
+
+	if calls < 2 {
+		t.Fatalf("Expected progress to be reported for each chunk, %d calls", calls)
+	}
+
+	if elapsed < 300*time.Millisecond {
+		t.Fatalf("Upload was not limited, took %v", elapsed)
+	}
+
+	_, ok := UploadOptionsFromContext(ctx)
+
+	if ok {
+		t.Fatalf("Expected no upload options for parent context")
+	}
+}
+
+func TestNewBandwidthLimiter(t *testing.T) {
+
+	_, err := NewBandwidthLimiter(0)
+
+	if err == nil {
+		t.Fatalf("Expected zero bandwidth to fail")
+	}
+
+	l, err := NewBandwidthLimiter(1024)
+
+	if err != nil {
+		t.Fatalf("Failed to create bandwidth limiter, %v", err)
+	}
+
+	if l.Stats().Burst != 1024 {
+		t.Fatalf("Unexpected burst, %d", l.Stats().Burst)
+	}
+
+	err = l.WaitN(context.Background(), 2048)
+
+	if err == nil {
+		t.Fatalf("Expected waiting for more than the burst to fail")
+	}
+}
+
+func TestUploadFile(t *testing.T) {
+
+	ctx := context.Background()
+
+	type upload struct {
+		content_length    int64
+		transfer_encoding []string
+		file_name         string
+		content_type      string
+		body              []byte
+	}
+
+	mu := new(sync.Mutex)
+	var last *upload
+
+	svr := httptest.NewServer(http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+
+		fh, hdr, err := req.FormFile("photo")
+
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		defer fh.Close()
+
+		body, _ := io.ReadAll(fh)
+
+		mu.Lock()
+
+		last = &upload{
+			content_length:    req.ContentLength,
+			transfer_encoding: req.TransferEncoding,
+			file_name:         hdr.Filename,
+			content_type:      hdr.Header.Get("Content-Type"),
+			body:              body,
+		}
+
+		mu.Unlock()
+
+		rsp.Write([]byte(`<rsp stat="ok"><photoid>1</photoid></rsp>`))
+	}))
+
+	defer svr.Close()
+
+	q := url.Values{}
+	q.Set("consumer_key", "key")
+	q.Set("consumer_secret", "secret")
+	q.Set("oauth_token", "token")
+	q.Set("oauth_token_secret", "token_secret")
+	q.Set("upload_endpoint", svr.URL)
+
+	cl, err := NewClient(ctx, "oauth1://?"+q.Encode())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte("x"), 1024)...)
+
+	tests := []struct {
+		label        string
+		fh           io.Reader
+		file_name    string
+		content_type string
+		chunked      bool
+	}{
+		{"upload file", &UploadFile{Reader: bytes.NewReader(png), FileName: "/path/to/cat.jpg"}, "cat.jpg", "image/jpeg", false},
+		{"explicit content type", &UploadFile{Reader: bytes.NewReader(png), FileName: "cat", ContentType: "image/png"}, "cat", "image/png", false},
+		{"seekable reader", bytes.NewReader(png), DEFAULT_UPLOAD_FILE_NAME, "image/png", false},
+		{"unknown size", io.MultiReader(bytes.NewReader(png)), DEFAULT_UPLOAD_FILE_NAME, "image/png", true},
+	}
+
+	for _, test := range tests {
+
+		rsp, err := cl.Upload(ctx, test.fh, &url.Values{})
+
+		if err != nil {
+			t.Fatalf("Failed to upload %s, %v", test.label, err)
+		}
+
+		rsp.Close()
+
+		mu.Lock()
+		up := last
+		mu.Unlock()
+
+		if up.file_name != test.file_name || up.content_type != test.content_type {
+			t.Fatalf("Unexpected file name or content type for %s, %s (%s)", test.label, up.file_name, up.content_type)
+		}
+
+		if !bytes.Equal(up.body, png) {
+			t.Fatalf("Unexpected body for %s", test.label)
+		}
+
+		is_chunked := len(up.transfer_encoding) > 0 && up.transfer_encoding[0] == "chunked"
+
+		if is_chunked != test.chunked {
+			t.Fatalf("Unexpected transfer encoding for %s, %v (%d)", test.label, up.transfer_encoding, up.content_length)
+		}
+
+		if !test.chunked && up.content_length <= int64(len(png)) {
+			t.Fatalf("Unexpected content length for %s, %d", test.label, up.content_length)
+		}
+	}
+}
+
+func TestStreamUploadBodyError(t *testing.T) {
+
+	ctx := context.Background()
+
+	b, err := newMultipartBody("boundary", "photo.jpg", "image/jpeg", -1, &url.Values{})
+
+	if err != nil {
+		t.Fatalf("Failed to create multipart body, %v", err)
+	}
+
+	read_err := errors.New("Read failed")
+	fh := io.MultiReader(bytes.NewReader([]byte("partial")), iotest.ErrReader(read_err))
+
+	r, w := io.Pipe()
+
+	go streamUploadBody(ctx, w, b, fh)
+
+	// The reader must see the error rather than a cleanly terminated (but truncated) body
+
+	body, err := io.ReadAll(r)
+
+	if !errors.Is(err, read_err) {
+		t.Fatalf("Expected read error, %v", err)
+	}
+
+	if bytes.Contains(body, b.suffix) {
+		t.Fatalf("Unexpected multipart suffix in truncated body")
+	}
+}
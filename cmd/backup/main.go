@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/runtimevar/constantvar"
+	_ "gocloud.dev/runtimevar/filevar"
+
+	"github.com/aaronland/go-flickr-api/application/backup"
+)
+
+func main() {
+
+	ctx := context.Background()
+
+	app := &backup.BackupApplication{}
+	_, err := app.Run(ctx)
+
+	if err != nil {
+		log.Fatalf("Failed to run backup application, %v", err)
+	}
+}
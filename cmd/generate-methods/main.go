@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	_ "gocloud.dev/runtimevar/constantvar"
+	_ "gocloud.dev/runtimevar/filevar"
+
+	"github.com/aaronland/go-flickr-api/application/generate"
+)
+
+func main() {
+
+	ctx := context.Background()
+
+	app := &generate.GenerateApplication{}
+	_, err := app.Run(ctx)
+
+	if err != nil {
+		log.Fatalf("Failed to run generate application, %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/runtimevar/constantvar"
+	_ "gocloud.dev/runtimevar/filevar"
+
+	"github.com/aaronland/go-flickr-api/application/sync"
+)
+
+func main() {
+
+	ctx := context.Background()
+
+	app := &sync.SyncApplication{}
+	_, err := app.Run(ctx)
+
+	if err != nil {
+		log.Fatalf("Failed to run sync application, %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+package flickrtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// Request is a struct containing the details of an API request passed to a MethodHandlerFunc.
+type Request struct {
+	// The context.Context instance associated with the HTTP request.
+	Context context.Context
+	// The name of the API method being called.
+	Method string
+	// The (signed) arguments passed to the API method.
+	Args url.Values
+	// The NSID of the user associated with the request's access token. This will be empty for unauthenticated requests.
+	UserId string
+	// The permissions granted to the request's access token. This will be "none" for unauthenticated requests.
+	Perms string
+	// The Server instance handling the request.
+	Server *Server
+}
+
+// MethodHandlerFunc is the signature for functions that implement a Flickr API method. The returned map is encoded as
+// JSON with a "stat" property of "ok". If the function returns a *response.Error instance it is encoded as a failed
+// API response. Any other error is returned as an HTTP 500 Internal Server Error response.
+type MethodHandlerFunc func(*Request) (map[string]any, error)
+
+type method struct {
+	perms   string
+	handler MethodHandlerFunc
+}
+
+// HandleMethod registers 'handler' to implement the API method 'name', replacing any existing handler. 'perms' are
+// the permissions ("none", "read", "write" or "delete") required to call the method.
+func (s *Server) HandleMethod(name string, perms string, handler MethodHandlerFunc) error {
+
+	if permsLevel(perms) == -1 {
+		return fmt.Errorf("Invalid permissions '%s'", perms)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.methods[name] = &method{
+		perms:   perms,
+		handler: handler,
+	}
+
+	return nil
+}
+
+// Calls returns the number of times the API method 'name' has been called.
+func (s *Server) Calls(name string) int {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[name]
+}
+
+// handleAPI implements the Flickr REST API endpoint. Only JSON responses are supported.
+func (s *Server) handleAPI(rsp http.ResponseWriter, req *http.Request) {
+
+	err := req.ParseForm()
+
+	if err != nil {
+		http.Error(rsp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	args := req.Form
+
+	format := args.Get("format")
+
+	if format != "json" {
+		writeAPIResponse(rsp, nil, &response.Error{Code: 111, Message: fmt.Sprintf("Format \"%s\" not found", format)})
+		return
+	}
+
+	user_id, perms, err := s.authorizeRequest(req, args)
+
+	if err != nil {
+		writeAPIResponse(rsp, nil, err)
+		return
+	}
+
+	name := args.Get("method")
+
+	s.mu.Lock()
+	m, exists := s.methods[name]
+
+	if exists {
+		s.calls[name] += 1
+	}
+
+	s.mu.Unlock()
+
+	if !exists {
+		writeAPIResponse(rsp, nil, &response.Error{Code: 112, Message: fmt.Sprintf("Method \"%s\" not found", name)})
+		return
+	}
+
+	if permsLevel(perms) < permsLevel(m.perms) {
+
+		if user_id == "" {
+			writeAPIResponse(rsp, nil, &response.Error{Code: 99, Message: "Insufficient permissions. Method requires " + m.perms + " privileges; none granted."})
+			return
+		}
+
+		writeAPIResponse(rsp, nil, &response.Error{Code: 99, Message: "Insufficient permissions. Method requires " + m.perms + " privileges; " + perms + " granted."})
+		return
+	}
+
+	api_req := &Request{
+		Context: req.Context(),
+		Method:  name,
+		Args:    args,
+		UserId:  user_id,
+		Perms:   perms,
+		Server:  s,
+	}
+
+	rsp_body, err := m.handler(api_req)
+
+	if err != nil {
+
+		_, ok := err.(*response.Error)
+
+		if !ok {
+			http.Error(rsp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	writeAPIResponse(rsp, rsp_body, err)
+}
+
+// authorizeRequest verifies the signature for 'req' returning the NSID of the user, and the permissions, associated
+// with the request's access token, if present.
+func (s *Server) authorizeRequest(req *http.Request, args url.Values) (string, string, error) {
+
+	user_id := ""
+	perms := "none"
+	token_secret := ""
+
+	if args.Has("oauth_token") {
+
+		t, exists := s.lookupToken(args.Get("oauth_token"))
+
+		if !exists || !t.access {
+			return "", "", &response.Error{Code: 98, Message: "Invalid auth token"}
+		}
+
+		user_id = t.user_id
+		perms = t.perms
+		token_secret = t.secret
+	}
+
+	err := s.verifySignature(req, args, token_secret)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return user_id, perms, nil
+}
+
+func writeAPIResponse(rsp http.ResponseWriter, body map[string]any, err error) {
+
+	if body == nil {
+		body = make(map[string]any)
+	}
+
+	if err != nil {
+
+		api_err := err.(*response.Error)
+
+		body = map[string]any{
+			"stat":    "fail",
+			"code":    api_err.Code,
+			"message": api_err.Message,
+		}
+
+	} else {
+		body["stat"] = "ok"
+	}
+
+	rsp.Header().Set("Content-Type", "application/json")
+
+	enc := json.NewEncoder(rsp)
+	enc.Encode(body)
+}
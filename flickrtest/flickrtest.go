@@ -0,0 +1,182 @@
+// package flickrtest provides a fake, in-memory, implementation of the Flickr API for testing code that uses the
+// client.Client interface without network access or API credentials. The fake server implements the REST, upload
+// and replace endpoints, the OAuth1 token endpoints, asynchronous upload tickets and static photo hosting. All
+// requests are expected to be signed and signatures are verified so that signing regressions are caught by tests.
+package flickrtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// The path for the fake Flickr REST API endpoint.
+const API_PATH string = "/services/rest"
+
+// The path for the fake Flickr upload endpoint.
+const UPLOAD_PATH string = "/services/upload/"
+
+// The path for the fake Flickr replace endpoint.
+const REPLACE_PATH string = "/services/replace/"
+
+// The path for the fake OAuth1 authorization endpoint.
+const AUTHORIZE_PATH string = "/services/oauth/authorize"
+
+// The path for the fake OAuth1 request token endpoint.
+const REQUEST_TOKEN_PATH string = "/services/oauth/request_token"
+
+// The path for the fake OAuth1 access token endpoint.
+const ACCESS_TOKEN_PATH string = "/services/oauth/access_token"
+
+// The path prefix for static photos hosted by the fake server.
+const STATIC_PATH string = "/static"
+
+// The default consumer key accepted by the fake server.
+const DEFAULT_CONSUMER_KEY string = "flickrtest-consumer-key"
+
+// The default consumer secret accepted by the fake server.
+const DEFAULT_CONSUMER_SECRET string = "flickrtest-consumer-secret"
+
+// The default OAuth1 access token accepted by the fake server. It is granted "delete" permissions.
+const DEFAULT_OAUTH_TOKEN string = "flickrtest-oauth-token"
+
+// The default OAuth1 access token secret accepted by the fake server.
+const DEFAULT_OAUTH_TOKEN_SECRET string = "flickrtest-oauth-token-secret"
+
+// The default NSID for the user associated with the fake server's access tokens.
+const DEFAULT_USER_ID string = "12345678@N00"
+
+// The default username for the user associated with the fake server's access tokens.
+const DEFAULT_USERNAME string = "flickrtest"
+
+// Server is a fake Flickr API server backed by an in-memory Store.
+type Server struct {
+	*httptest.Server
+	// The consumer key that API requests must be signed with.
+	ConsumerKey string
+	// The consumer secret that API requests must be signed with.
+	ConsumerSecret string
+	// The default access token (with "delete" permissions) for the server's user.
+	OAuthToken string
+	// The default access token secret for the server's user.
+	OAuthTokenSecret string
+	// The NSID of the server's user.
+	UserId string
+	// The username of the server's user.
+	Username string
+	// The Store containing the server's photos and photosets.
+	Store *Store
+	// The amount of time before asynchronous upload tickets are marked as complete.
+	TicketDelay time.Duration
+	// The maximum number of results the flickr.photos.search method will return, mimicking the (roughly 4000) result cap
+	// of the Flickr API. Requests for pages past the limit return the last page within the limit. If 0 there is no limit.
+	SearchLimit int
+	mu          sync.Mutex
+	methods     map[string]*method
+	tokens      map[string]*token
+	nonces      map[string]bool
+	tickets     map[string]*ticket
+	calls       map[string]int
+	counter     int64
+}
+
+// NewServer starts and returns a new Server instance with an empty Store. The caller should call Close when finished.
+func NewServer() *Server {
+
+	s := NewUnstartedServer()
+	s.Start()
+
+	return s
+}
+
+// NewUnstartedServer returns a new Server instance, with an empty Store, that has not been started. The caller should
+// call Start when ready and Close when finished.
+func NewUnstartedServer() *Server {
+
+	s := &Server{
+		ConsumerKey:      DEFAULT_CONSUMER_KEY,
+		ConsumerSecret:   DEFAULT_CONSUMER_SECRET,
+		OAuthToken:       DEFAULT_OAUTH_TOKEN,
+		OAuthTokenSecret: DEFAULT_OAUTH_TOKEN_SECRET,
+		UserId:           DEFAULT_USER_ID,
+		Username:         DEFAULT_USERNAME,
+		Store:            NewStore(),
+		methods:          make(map[string]*method),
+		tokens:           make(map[string]*token),
+		nonces:           make(map[string]bool),
+		tickets:          make(map[string]*ticket),
+		calls:            make(map[string]int),
+	}
+
+	s.registerMethods()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(API_PATH, s.handleAPI)
+	mux.HandleFunc(UPLOAD_PATH, s.handleUpload)
+	mux.HandleFunc(REPLACE_PATH, s.handleReplace)
+	mux.HandleFunc(AUTHORIZE_PATH, s.handleAuthorize)
+	mux.HandleFunc(REQUEST_TOKEN_PATH, s.handleRequestToken)
+	mux.HandleFunc(ACCESS_TOKEN_PATH, s.handleAccessToken)
+	mux.HandleFunc(STATIC_PATH+"/", s.handleStatic)
+
+	s.Server = httptest.NewUnstartedServer(mux)
+	return s
+}
+
+// ClientURI returns an "oauth1://" URI for creating a client.Client instance that calls the fake server using the
+// server's consumer key and secret and default access token. Additional query parameters may be passed in 'extra'.
+func (s *Server) ClientURI(extra ...url.Values) string {
+
+	q := s.clientQuery(extra...)
+	q.Set("oauth_token", s.OAuthToken)
+	q.Set("oauth_token_secret", s.OAuthTokenSecret)
+
+	return "oauth1://?" + q.Encode()
+}
+
+// ConsumerClientURI returns an "oauth1://" URI for creating a client.Client instance that calls the fake server
+// using only the server's consumer key and secret, for example to test the OAuth1 authorization flow. Additional
+// query parameters may be passed in 'extra'.
+func (s *Server) ConsumerClientURI(extra ...url.Values) string {
+	q := s.clientQuery(extra...)
+	return "oauth1://?" + q.Encode()
+}
+
+// StaticURL returns the root URL for static photos hosted by the fake server.
+func (s *Server) StaticURL() string {
+	return s.URL + STATIC_PATH
+}
+
+func (s *Server) clientQuery(extra ...url.Values) url.Values {
+
+	q := url.Values{}
+	q.Set("consumer_key", s.ConsumerKey)
+	q.Set("consumer_secret", s.ConsumerSecret)
+	q.Set("api_endpoint", s.URL+API_PATH)
+	q.Set("upload_endpoint", s.URL+UPLOAD_PATH)
+	q.Set("replace_endpoint", s.URL+REPLACE_PATH)
+	q.Set("authorize_endpoint", s.URL+AUTHORIZE_PATH)
+	q.Set("request_token_endpoint", s.URL+REQUEST_TOKEN_PATH)
+	q.Set("access_token_endpoint", s.URL+ACCESS_TOKEN_PATH)
+
+	for _, e := range extra {
+
+		for k, v := range e {
+			q[k] = v
+		}
+	}
+
+	return q
+}
+
+// nextCounter returns a unique, incrementing, value used to derive tokens and ticket IDs.
+func (s *Server) nextCounter() int64 {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.counter += 1
+	return s.counter
+}
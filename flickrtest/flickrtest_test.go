@@ -0,0 +1,546 @@
+package flickrtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/auth"
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+func testJPEG(t *testing.T, w int, h int) []byte {
+
+	var buf bytes.Buffer
+
+	err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, w, h)), nil)
+
+	if err != nil {
+		t.Fatalf("Failed to encode JPEG, %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func executeMethod(ctx context.Context, cl client.Client, args *url.Values) ([]byte, error) {
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	return io.ReadAll(fh)
+}
+
+func TestExecuteMethod(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI(url.Values{"check_status": []string{"true"}}))
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.login")
+
+	body, err := executeMethod(ctx, cl, args)
+
+	if err != nil {
+		t.Fatalf("Failed to execute method, %v", err)
+	}
+
+	if gjson.GetBytes(body, "user.id").String() != svr.UserId {
+		t.Fatalf("Unexpected user ID, %s", string(body))
+	}
+
+	args = &url.Values{}
+	args.Set("method", "flickr.photos.getInfo")
+	args.Set("photo_id", "1")
+
+	_, err = executeMethod(ctx, cl, args)
+
+	if !errors.Is(err, response.ErrNotFound) {
+		t.Fatalf("Expected not found error, got %v", err)
+	}
+
+	args = &url.Values{}
+	args.Set("method", "flickr.unknown.method")
+
+	_, err = executeMethod(ctx, cl, args)
+
+	var api_err *response.Error
+
+	if !errors.As(err, &api_err) || api_err.Code != 112 {
+		t.Fatalf("Expected method not found error, got %v", err)
+	}
+}
+
+func TestInvalidSignature(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	q := url.Values{}
+	q.Set("consumer_secret", "not-the-secret")
+	q.Set("check_status", "true")
+
+	cl, err := client.NewClient(ctx, svr.ClientURI(q))
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.echo")
+
+	_, err = executeMethod(ctx, cl, args)
+
+	if !errors.Is(err, response.ErrInvalidAuth) {
+		t.Fatalf("Expected invalid auth error, got %v", err)
+	}
+}
+
+func TestInsufficientPermissions(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	ph := svr.Store.AddPhoto(&Photo{Owner: svr.UserId})
+
+	cl, err := client.NewClient(ctx, svr.ConsumerClientURI(url.Values{"check_status": []string{"true"}}))
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	token, secret, err := svr.GrantAccessToken("read")
+
+	if err != nil {
+		t.Fatalf("Failed to grant access token, %v", err)
+	}
+
+	cl, err = cl.WithAccessToken(ctx, &auth.OAuth1AccessToken{OAuthToken: token, OAuthTokenSecret: secret})
+
+	if err != nil {
+		t.Fatalf("Failed to create client with access token, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.delete")
+	args.Set("photo_id", fmt.Sprintf("%d", ph.Id))
+
+	_, err = executeMethod(ctx, cl, args)
+
+	if !errors.Is(err, response.ErrPermissionDenied) {
+		t.Fatalf("Expected permission denied error, got %v", err)
+	}
+
+	_, exists := svr.Store.GetPhoto(ph.Id)
+
+	if !exists {
+		t.Fatalf("Photo should not have been deleted")
+	}
+}
+
+func TestAuthorizationFlow(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ConsumerClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	req_token, err := cl.GetRequestToken(ctx, "oob")
+
+	if err != nil {
+		t.Fatalf("Failed to get request token, %v", err)
+	}
+
+	auth_url, err := cl.GetAuthorizationURL(ctx, req_token, "write")
+
+	if err != nil {
+		t.Fatalf("Failed to get authorization URL, %v", err)
+	}
+
+	rsp, err := http.Get(auth_url)
+
+	if err != nil {
+		t.Fatalf("Failed to authorize request token, %v", err)
+	}
+
+	defer rsp.Body.Close()
+
+	body, _ := io.ReadAll(rsp.Body)
+
+	auth_token, err := auth.UnmarshalOAuth1AuthorizationToken(string(body))
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal authorization token, %v", err)
+	}
+
+	access_token, err := cl.GetAccessToken(ctx, req_token, auth_token)
+
+	if err != nil {
+		t.Fatalf("Failed to get access token, %v", err)
+	}
+
+	auth_cl, err := cl.WithAccessToken(ctx, access_token)
+
+	if err != nil {
+		t.Fatalf("Failed to create client with access token, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.login")
+
+	body, err = executeMethod(ctx, auth_cl, args)
+
+	if err != nil {
+		t.Fatalf("Failed to execute method, %v", err)
+	}
+
+	err = response.CheckStatusBytes(body)
+
+	if err != nil {
+		t.Fatalf("Failed to call method with new access token, %v", err)
+	}
+
+	// Request tokens can only be exchanged once
+
+	_, err = cl.GetAccessToken(ctx, req_token, auth_token)
+
+	if err == nil {
+		t.Fatalf("Expected second access token request to fail")
+	}
+}
+
+func TestUploadAndReplace(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	photo := testJPEG(t, 32, 16)
+
+	args := &url.Values{}
+	args.Set("title", "Test photo")
+	args.Set("tags", `hello "hello world" file:sha256=abc`)
+
+	fh, err := cl.Upload(ctx, bytes.NewReader(photo), args)
+
+	if err != nil {
+		t.Fatalf("Failed to upload photo, %v", err)
+	}
+
+	up, err := response.UnmarshalUploadResponse(fh)
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal upload response, %v", err)
+	}
+
+	if up.Error != nil {
+		t.Fatalf("Upload failed, %v", up.Error)
+	}
+
+	ph, exists := svr.Store.GetPhoto(up.Photo.Id)
+
+	if !exists {
+		t.Fatalf("Uploaded photo not found in store")
+	}
+
+	if ph.Title != "Test photo" || ph.Owner != svr.UserId || !bytes.Equal(ph.Body, photo) {
+		t.Fatalf("Unexpected photo properties, %s (%s)", ph.Title, ph.Owner)
+	}
+
+	if len(ph.Tags) != 3 || !ph.HasTag("helloworld") || !ph.HasTag("file:sha256=abc") {
+		t.Fatalf("Unexpected tags, %v", ph.Tags)
+	}
+
+	rsp, err := http.Get(svr.PhotoURL(ph, "o"))
+
+	if err != nil {
+		t.Fatalf("Failed to retrieve static photo, %v", err)
+	}
+
+	defer rsp.Body.Close()
+
+	body, _ := io.ReadAll(rsp.Body)
+
+	if rsp.StatusCode != http.StatusOK || !bytes.Equal(body, photo) {
+		t.Fatalf("Unexpected static photo response, %s", rsp.Status)
+	}
+
+	replacement := testJPEG(t, 64, 32)
+
+	args = &url.Values{}
+	args.Set("photo_id", fmt.Sprintf("%d", ph.Id))
+
+	fh, err = cl.Replace(ctx, bytes.NewReader(replacement), args)
+
+	if err != nil {
+		t.Fatalf("Failed to replace photo, %v", err)
+	}
+
+	up, err = response.UnmarshalUploadResponse(fh)
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal replace response, %v", err)
+	}
+
+	if up.Error != nil || up.Photo.Id != ph.Id {
+		t.Fatalf("Unexpected replace response, %v", up.Error)
+	}
+
+	new_ph, _ := svr.Store.GetPhoto(ph.Id)
+
+	if !bytes.Equal(new_ph.Body, replacement) || new_ph.OriginalSecret != up.Photo.OriginalSecret {
+		t.Fatalf("Photo was not replaced")
+	}
+
+	rsp, err = http.Get(svr.PhotoURL(ph, "o"))
+
+	if err != nil {
+		t.Fatalf("Failed to retrieve static photo, %v", err)
+	}
+
+	rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected static URL with old secret to fail, %s", rsp.Status)
+	}
+}
+
+func TestUploadAsync(t *testing.T) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	photo_id, err := client.UploadAsyncWithClient(ctx, cl, strings.NewReader("not really a photo"), &url.Values{})
+
+	if err != nil {
+		t.Fatalf("Failed to upload photo, %v", err)
+	}
+
+	_, exists := svr.Store.GetPhoto(photo_id)
+
+	if !exists {
+		t.Fatalf("Uploaded photo %d not found in store", photo_id)
+	}
+
+	if svr.Calls("flickr.photos.upload.checkTickets") == 0 {
+		t.Fatalf("Expected calls to check tickets to be counted")
+	}
+
+	if svr.FailTicket("invalid") {
+		t.Fatalf("Did not expect to be able to fail an invalid ticket")
+	}
+}
+
+func TestPeopleGetPhotosPaginated(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	now := time.Now()
+
+	for i := 0; i < 25; i++ {
+		svr.Store.AddPhoto(&Photo{
+			Owner:      svr.UserId,
+			Title:      fmt.Sprintf("Photo %d", i),
+			DateUpload: now.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	// Someone else's private photo
+	svr.Store.AddPhoto(&Photo{Owner: "999@N00", IsPublic: 0})
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.people.getPhotos")
+	args.Set("user_id", "me")
+	args.Set("per_page", "10")
+	args.Set("extras", "url_o,lastupdate")
+
+	titles := make([]string, 0)
+
+	cb := func(ctx context.Context, fh io.ReadSeekCloser, err error) error {
+
+		if err != nil {
+			return err
+		}
+
+		body, err := io.ReadAll(fh)
+
+		if err != nil {
+			return err
+		}
+
+		for _, ph := range gjson.GetBytes(body, "photos.photo").Array() {
+
+			if !strings.HasPrefix(ph.Get("url_o").String(), svr.StaticURL()) {
+				return fmt.Errorf("Unexpected url_o, %s", ph.Get("url_o").String())
+			}
+
+			titles = append(titles, ph.Get("title").String())
+		}
+
+		return nil
+	}
+
+	err = client.ExecuteMethodPaginatedWithClient(ctx, cl, args, cb)
+
+	if err != nil {
+		t.Fatalf("Failed to execute paginated method, %v", err)
+	}
+
+	if len(titles) != 25 {
+		t.Fatalf("Unexpected number of photos, %d", len(titles))
+	}
+
+	// Photos are sorted by date uploaded, most recent first
+
+	if titles[0] != "Photo 24" || titles[24] != "Photo 0" {
+		t.Fatalf("Unexpected sort order, %s ... %s", titles[0], titles[24])
+	}
+}
+
+func TestPhotosets(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI(url.Values{"check_status": []string{"true"}}))
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	ids := make([]string, 3)
+
+	for i := range ids {
+		ph := svr.Store.AddPhoto(&Photo{Owner: svr.UserId})
+		ids[i] = fmt.Sprintf("%d", ph.Id)
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photosets.create")
+	args.Set("title", "Test set")
+	args.Set("primary_photo_id", ids[0])
+
+	body, err := executeMethod(ctx, cl, args)
+
+	if err != nil {
+		t.Fatalf("Failed to create photoset, %v", err)
+	}
+
+	set_id := gjson.GetBytes(body, "photoset.id").String()
+
+	for _, id := range ids[1:] {
+
+		args := &url.Values{}
+		args.Set("method", "flickr.photosets.addPhoto")
+		args.Set("photoset_id", set_id)
+		args.Set("photo_id", id)
+
+		_, err := executeMethod(ctx, cl, args)
+
+		if err != nil {
+			t.Fatalf("Failed to add photo to photoset, %v", err)
+		}
+	}
+
+	args = &url.Values{}
+	args.Set("method", "flickr.photosets.reorderPhotos")
+	args.Set("photoset_id", set_id)
+	args.Set("photo_ids", strings.Join([]string{ids[2], ids[0]}, ","))
+
+	_, err = executeMethod(ctx, cl, args)
+
+	if err != nil {
+		t.Fatalf("Failed to reorder photoset, %v", err)
+	}
+
+	args = &url.Values{}
+	args.Set("method", "flickr.photosets.getPhotos")
+	args.Set("photoset_id", set_id)
+
+	body, err = executeMethod(ctx, cl, args)
+
+	if err != nil {
+		t.Fatalf("Failed to get photoset photos, %v", err)
+	}
+
+	order := make([]string, 0)
+
+	for _, ph := range gjson.GetBytes(body, "photoset.photo.#.id").Array() {
+		order = append(order, ph.String())
+	}
+
+	expected := []string{ids[2], ids[0], ids[1]}
+
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Fatalf("Unexpected photoset order, %v", order)
+	}
+}
+
+func TestParseTags(t *testing.T) {
+
+	tags := ParseTags(`one "two three"  four:five=six`)
+
+	if len(tags) != 3 || tags[0] != "one" || tags[1] != "two three" || tags[2] != "four:five=six" {
+		t.Fatalf("Unexpected tags, %v", tags)
+	}
+}
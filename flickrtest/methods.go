@@ -0,0 +1,1159 @@
+package flickrtest
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// The default number of photos per page in list responses.
+const DEFAULT_PER_PAGE int = 100
+
+// The maximum number of photos per page in list responses.
+const MAX_PER_PAGE int = 500
+
+var errPhotoNotFound = &response.Error{Code: 1, Message: "Photo not found"}
+
+var errPhotosetNotFound = &response.Error{Code: 1, Message: "Photoset not found"}
+
+// registerMethods registers the built-in API method handlers.
+func (s *Server) registerMethods() {
+
+	handlers := []struct {
+		name    string
+		perms   string
+		handler MethodHandlerFunc
+	}{
+		{"flickr.test.echo", "none", testEcho},
+		{"flickr.test.login", "read", testLogin},
+		{"flickr.photos.getInfo", "none", photosGetInfo},
+		{"flickr.photos.getSizes", "none", photosGetSizes},
+		{"flickr.photos.getExif", "none", photosGetExif},
+		{"flickr.photos.comments.getList", "none", photosCommentsGetList},
+		{"flickr.photos.search", "none", photosSearch},
+		{"flickr.people.getPhotos", "none", peopleGetPhotos},
+		{"flickr.photos.delete", "delete", photosDelete},
+		{"flickr.photos.setMeta", "write", photosSetMeta},
+		{"flickr.photos.setTags", "write", photosSetTags},
+		{"flickr.photos.addTags", "write", photosAddTags},
+		{"flickr.photos.removeTag", "write", photosRemoveTag},
+		{"flickr.photos.setDates", "write", photosSetDates},
+		{"flickr.photos.setPerms", "write", photosSetPerms},
+		{"flickr.photos.geo.setLocation", "write", photosGeoSetLocation},
+		{"flickr.photos.licenses.setLicense", "write", photosLicensesSetLicense},
+		{"flickr.photos.upload.checkTickets", "none", photosUploadCheckTickets},
+		{"flickr.photosets.create", "write", photosetsCreate},
+		{"flickr.photosets.delete", "write", photosetsDelete},
+		{"flickr.photosets.getList", "none", photosetsGetList},
+		{"flickr.photosets.getPhotos", "none", photosetsGetPhotos},
+		{"flickr.photosets.addPhoto", "write", photosetsAddPhoto},
+		{"flickr.photosets.removePhoto", "write", photosetsRemovePhoto},
+		{"flickr.photosets.reorderPhotos", "write", photosetsReorderPhotos},
+	}
+
+	for _, h := range handlers {
+		s.methods[h.name] = &method{
+			perms:   h.perms,
+			handler: h.handler,
+		}
+	}
+}
+
+func testEcho(req *Request) (map[string]any, error) {
+
+	rsp := make(map[string]any)
+
+	for k, v := range req.Args {
+
+		if strings.HasPrefix(k, "oauth_") {
+			continue
+		}
+
+		rsp[k] = map[string]any{"_content": v[0]}
+	}
+
+	return rsp, nil
+}
+
+func testLogin(req *Request) (map[string]any, error) {
+
+	rsp := map[string]any{
+		"user": map[string]any{
+			"id": req.UserId,
+			"username": map[string]any{
+				"_content": req.Server.Username,
+			},
+			"path_alias": "",
+		},
+	}
+
+	return rsp, nil
+}
+
+func photosGetInfo(req *Request) (map[string]any, error) {
+
+	ph, err := viewablePhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := map[string]any{
+		"photo": req.Server.photoInfo(ph),
+	}
+
+	return rsp, nil
+}
+
+func photosGetSizes(req *Request) (map[string]any, error) {
+
+	ph, err := viewablePhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := map[string]any{
+		"sizes": req.Server.photoSizes(ph),
+	}
+
+	return rsp, nil
+}
+
+func photosGetExif(req *Request) (map[string]any, error) {
+
+	ph, err := viewablePhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]string, 0)
+
+	for k := range ph.Exif {
+		tags = append(tags, k)
+	}
+
+	sort.Strings(tags)
+
+	exif := make([]map[string]any, len(tags))
+
+	for i, k := range tags {
+
+		exif[i] = map[string]any{
+			"tagspace": "EXIF",
+			"tag":      k,
+			"label":    k,
+			"raw": map[string]any{
+				"_content": ph.Exif[k],
+			},
+		}
+	}
+
+	rsp := map[string]any{
+		"photo": map[string]any{
+			"id":     strconv.FormatInt(ph.Id, 10),
+			"secret": ph.Secret,
+			"server": ph.Server,
+			"exif":   exif,
+		},
+	}
+
+	return rsp, nil
+}
+
+func photosCommentsGetList(req *Request) (map[string]any, error) {
+
+	ph, err := viewablePhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]map[string]any, len(ph.Comments))
+
+	for i, c := range ph.Comments {
+
+		comments[i] = map[string]any{
+			"id":         c.Id,
+			"author":     c.Author,
+			"datecreate": strconv.FormatInt(c.DateCreate.Unix(), 10),
+			"_content":   c.Body,
+		}
+	}
+
+	comments_rsp := map[string]any{
+		"photo_id": strconv.FormatInt(ph.Id, 10),
+	}
+
+	// Like the Flickr API the "comment" element is omitted for photos without any comments
+
+	if len(comments) > 0 {
+		comments_rsp["comment"] = comments
+	}
+
+	rsp := map[string]any{
+		"comments": comments_rsp,
+	}
+
+	return rsp, nil
+}
+
+func photosSearch(req *Request) (map[string]any, error) {
+
+	user_id := req.Args.Get("user_id")
+
+	if user_id == "me" {
+		user_id = req.UserId
+	}
+
+	return listPhotos(req, user_id, req.Server.SearchLimit)
+}
+
+func peopleGetPhotos(req *Request) (map[string]any, error) {
+
+	user_id := req.Args.Get("user_id")
+
+	if user_id == "me" {
+		user_id = req.UserId
+	}
+
+	if user_id == "" {
+		return nil, &response.Error{Code: 2, Message: "Unknown user"}
+	}
+
+	return listPhotos(req, user_id, 0)
+}
+
+func photosDelete(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Server.Store.DeletePhoto(ph.Id)
+	return map[string]any{}, nil
+}
+
+func photosSetMeta(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+
+		if req.Args.Has("title") {
+			ph.Title = req.Args.Get("title")
+		}
+
+		if req.Args.Has("description") {
+			ph.Description = req.Args.Get("description")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosSetTags(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := ParseTags(req.Args.Get("tags"))
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+		ph.Tags = tags
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosAddTags(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := ParseTags(req.Args.Get("tags"))
+
+	if len(tags) == 0 {
+		return nil, &response.Error{Code: 2, Message: "Maximum number of tags reached"}
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+
+		for _, t := range tags {
+
+			if !ph.HasTag(t) {
+				ph.Tags = append(ph.Tags, t)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosRemoveTag(req *Request) (map[string]any, error) {
+
+	// Tag IDs are prefixed with the ID of the photo they are assigned to.
+
+	tag_id := req.Args.Get("tag_id")
+	str_id, _, _ := strings.Cut(tag_id, "-")
+
+	photo_id, err := strconv.ParseInt(str_id, 10, 64)
+
+	if err != nil {
+		return nil, errPhotoNotFound
+	}
+
+	ph, exists := req.Server.Store.GetPhoto(photo_id)
+
+	if !exists || ph.Owner != req.UserId {
+		return nil, errPhotoNotFound
+	}
+
+	idx := -1
+
+	for i := range ph.Tags {
+
+		if tagId(ph, i) == tag_id {
+			idx = i
+			break
+		}
+	}
+
+	if idx == -1 {
+		return nil, &response.Error{Code: 2, Message: "Tag not found"}
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+		ph.Tags = slices.Delete(ph.Tags, idx, idx+1)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosSetDates(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var posted time.Time
+	var taken time.Time
+
+	if req.Args.Has("date_posted") {
+
+		ts, err := strconv.ParseInt(req.Args.Get("date_posted"), 10, 64)
+
+		if err != nil {
+			return nil, &response.Error{Code: 2, Message: "Invalid posted date"}
+		}
+
+		posted = time.Unix(ts, 0)
+	}
+
+	if req.Args.Has("date_taken") {
+
+		t, err := parseDate(req.Args.Get("date_taken"))
+
+		if err != nil {
+			return nil, &response.Error{Code: 3, Message: "Invalid taken date"}
+		}
+
+		taken = t
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+
+		if !posted.IsZero() {
+			ph.DateUpload = posted
+		}
+
+		if !taken.IsZero() {
+			ph.DateTaken = taken
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosSetPerms(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make(map[string]int)
+
+	for _, k := range []string{"is_public", "is_friend", "is_family"} {
+
+		v, err := strconv.Atoi(req.Args.Get(k))
+
+		if err != nil || (v != 0 && v != 1) {
+			return nil, &response.Error{Code: 2, Message: fmt.Sprintf("Invalid %s value", k)}
+		}
+
+		flags[k] = v
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+		ph.IsPublic = flags["is_public"]
+		ph.IsFriend = flags["is_friend"]
+		ph.IsFamily = flags["is_family"]
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosGeoSetLocation(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lat, err := strconv.ParseFloat(req.Args.Get("lat"), 64)
+
+	if err != nil || lat < -90 || lat > 90 {
+		return nil, &response.Error{Code: 3, Message: "Invalid latitude"}
+	}
+
+	lon, err := strconv.ParseFloat(req.Args.Get("lon"), 64)
+
+	if err != nil || lon < -180 || lon > 180 {
+		return nil, &response.Error{Code: 4, Message: "Invalid longitude"}
+	}
+
+	accuracy := 16
+
+	if req.Args.Has("accuracy") {
+
+		v, err := strconv.Atoi(req.Args.Get("accuracy"))
+
+		if err != nil || v < 1 || v > 16 {
+			return nil, &response.Error{Code: 5, Message: "Invalid accuracy"}
+		}
+
+		accuracy = v
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+		ph.Latitude = lat
+		ph.Longitude = lon
+		ph.Accuracy = accuracy
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosLicensesSetLicense(req *Request) (map[string]any, error) {
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	license_id := req.Args.Get("license_id")
+
+	_, err = strconv.Atoi(license_id)
+
+	if err != nil {
+		return nil, &response.Error{Code: 2, Message: "License not found"}
+	}
+
+	err = req.Server.Store.UpdatePhoto(ph.Id, func(ph *Photo) error {
+		ph.License = license_id
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosUploadCheckTickets(req *Request) (map[string]any, error) {
+
+	tickets := make([]map[string]any, 0)
+
+	for _, id := range strings.Split(req.Args.Get("tickets"), ",") {
+
+		id = strings.TrimSpace(id)
+
+		if id == "" {
+			continue
+		}
+
+		tickets = append(tickets, req.Server.checkTicket(id))
+	}
+
+	rsp := map[string]any{
+		"uploader": map[string]any{
+			"ticket": tickets,
+		},
+	}
+
+	return rsp, nil
+}
+
+func photosetsCreate(req *Request) (map[string]any, error) {
+
+	title := req.Args.Get("title")
+
+	if title == "" {
+		return nil, &response.Error{Code: 1, Message: "No title specified"}
+	}
+
+	primary_id, err := strconv.ParseInt(req.Args.Get("primary_photo_id"), 10, 64)
+
+	if err != nil {
+		return nil, &response.Error{Code: 2, Message: "Photo not found"}
+	}
+
+	ph, exists := req.Server.Store.GetPhoto(primary_id)
+
+	if !exists || ph.Owner != req.UserId {
+		return nil, &response.Error{Code: 2, Message: "Photo not found"}
+	}
+
+	set := &Photoset{
+		Owner:       req.UserId,
+		Title:       title,
+		Description: req.Args.Get("description"),
+		Primary:     primary_id,
+		Photos:      []int64{primary_id},
+	}
+
+	set = req.Server.Store.AddPhotoset(set)
+
+	rsp := map[string]any{
+		"photoset": map[string]any{
+			"id":  strconv.FormatInt(set.Id, 10),
+			"url": fmt.Sprintf("%s/photos/%s/sets/%d/", req.Server.URL, set.Owner, set.Id),
+		},
+	}
+
+	return rsp, nil
+}
+
+func photosetsDelete(req *Request) (map[string]any, error) {
+
+	set, err := ownedPhotoset(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Server.Store.DeletePhotoset(set.Id)
+	return map[string]any{}, nil
+}
+
+func photosetsGetList(req *Request) (map[string]any, error) {
+
+	user_id := req.Args.Get("user_id")
+
+	if user_id == "" {
+		user_id = req.UserId
+	}
+
+	sets := make([]map[string]any, 0)
+
+	for _, set := range req.Server.Store.Photosets() {
+
+		if set.Owner != user_id {
+			continue
+		}
+
+		sets = append(sets, map[string]any{
+			"id":          strconv.FormatInt(set.Id, 10),
+			"owner":       set.Owner,
+			"primary":     strconv.FormatInt(set.Primary, 10),
+			"photos":      len(set.Photos),
+			"title":       map[string]any{"_content": set.Title},
+			"description": map[string]any{"_content": set.Description},
+			"date_create": strconv.FormatInt(set.DateCreate.Unix(), 10),
+			"date_update": strconv.FormatInt(set.DateUpdate.Unix(), 10),
+		})
+	}
+
+	page, per_page := pagination(req)
+	page_sets, pages := paginate(sets, page, per_page)
+
+	rsp := map[string]any{
+		"photosets": map[string]any{
+			"page":     page,
+			"pages":    pages,
+			"perpage":  per_page,
+			"total":    len(sets),
+			"photoset": page_sets,
+		},
+	}
+
+	return rsp, nil
+}
+
+func photosetsGetPhotos(req *Request) (map[string]any, error) {
+
+	set, err := lookupPhotoset(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	photos := make([]map[string]any, 0)
+
+	for _, id := range set.Photos {
+
+		ph, exists := req.Server.Store.GetPhoto(id)
+
+		if !exists || !canView(ph, req.UserId) {
+			continue
+		}
+
+		summary := req.Server.photoSummary(ph, req.Args.Get("extras"))
+
+		is_primary := "0"
+
+		if id == set.Primary {
+			is_primary = "1"
+		}
+
+		summary["isprimary"] = is_primary
+		photos = append(photos, summary)
+	}
+
+	page, per_page := pagination(req)
+	page_photos, pages := paginate(photos, page, per_page)
+
+	rsp := map[string]any{
+		"photoset": map[string]any{
+			"id":        strconv.FormatInt(set.Id, 10),
+			"primary":   strconv.FormatInt(set.Primary, 10),
+			"owner":     set.Owner,
+			"ownername": req.Server.Username,
+			"title":     set.Title,
+			"page":      page,
+			"pages":     pages,
+			"perpage":   per_page,
+			"per_page":  per_page,
+			"total":     len(photos),
+			"photo":     page_photos,
+		},
+	}
+
+	return rsp, nil
+}
+
+func photosetsAddPhoto(req *Request) (map[string]any, error) {
+
+	set, err := ownedPhotoset(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ph, err := ownedPhoto(req)
+
+	if err != nil {
+		return nil, &response.Error{Code: 2, Message: "Photo not found"}
+	}
+
+	if slices.Contains(set.Photos, ph.Id) {
+		return nil, &response.Error{Code: 3, Message: "Photo already in set"}
+	}
+
+	err = req.Server.Store.UpdatePhotoset(set.Id, func(set *Photoset) error {
+		set.Photos = append(set.Photos, ph.Id)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosetsRemovePhoto(req *Request) (map[string]any, error) {
+
+	set, err := ownedPhotoset(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	photo_id, err := strconv.ParseInt(req.Args.Get("photo_id"), 10, 64)
+
+	if err != nil || !slices.Contains(set.Photos, photo_id) {
+		return nil, &response.Error{Code: 2, Message: "Photo not found"}
+	}
+
+	err = req.Server.Store.UpdatePhotoset(set.Id, func(set *Photoset) error {
+
+		idx := slices.Index(set.Photos, photo_id)
+		set.Photos = slices.Delete(set.Photos, idx, idx+1)
+
+		if set.Primary == photo_id {
+			set.Primary = 0
+
+			if len(set.Photos) > 0 {
+				set.Primary = set.Photos[0]
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+func photosetsReorderPhotos(req *Request) (map[string]any, error) {
+
+	set, err := ownedPhotoset(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := make([]int64, 0)
+
+	for _, str_id := range strings.Split(req.Args.Get("photo_ids"), ",") {
+
+		id, err := strconv.ParseInt(strings.TrimSpace(str_id), 10, 64)
+
+		if err != nil || !slices.Contains(set.Photos, id) {
+			return nil, &response.Error{Code: 2, Message: "Photo not found"}
+		}
+
+		if !slices.Contains(ordered, id) {
+			ordered = append(ordered, id)
+		}
+	}
+
+	err = req.Server.Store.UpdatePhotoset(set.Id, func(set *Photoset) error {
+
+		// Photos not included in the list are appended, in their current order, after those that are.
+
+		for _, id := range set.Photos {
+
+			if !slices.Contains(ordered, id) {
+				ordered = append(ordered, id)
+			}
+		}
+
+		set.Photos = ordered
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{}, nil
+}
+
+// listPhotos returns a "standard photos response" for the photos, owned by 'user_id' if not empty, matching the
+// flickr.photos.search style arguments in 'req'. If 'limit' is greater than 0 only the first 'limit' results can be paginated.
+func listPhotos(req *Request, user_id string, limit int) (map[string]any, error) {
+
+	filters := make([]func(*Photo) bool, 0)
+
+	if user_id != "" {
+		filters = append(filters, func(ph *Photo) bool {
+			return ph.Owner == user_id
+		})
+	}
+
+	if req.Args.Get("tags") != "" {
+
+		tags := strings.Split(req.Args.Get("tags"), ",")
+		match_all := req.Args.Get("tag_mode") == "all"
+
+		filters = append(filters, func(ph *Photo) bool {
+
+			for _, t := range tags {
+
+				has_tag := ph.HasTag(strings.TrimSpace(t))
+
+				if has_tag && !match_all {
+					return true
+				}
+
+				if !has_tag && match_all {
+					return false
+				}
+			}
+
+			return match_all
+		})
+	}
+
+	if req.Args.Get("machine_tags") != "" {
+
+		tags := strings.Split(req.Args.Get("machine_tags"), ",")
+
+		filters = append(filters, func(ph *Photo) bool {
+
+			for _, t := range tags {
+
+				if ph.HasTag(strings.TrimSpace(t)) {
+					return true
+				}
+			}
+
+			return false
+		})
+	}
+
+	if req.Args.Get("text") != "" {
+
+		text := strings.ToLower(req.Args.Get("text"))
+
+		filters = append(filters, func(ph *Photo) bool {
+			return strings.Contains(strings.ToLower(ph.Title), text) || strings.Contains(strings.ToLower(ph.Description), text)
+		})
+	}
+
+	date_filters := []struct {
+		arg   string
+		date  func(*Photo) time.Time
+		after bool
+	}{
+		{"min_upload_date", func(ph *Photo) time.Time { return ph.DateUpload }, true},
+		{"max_upload_date", func(ph *Photo) time.Time { return ph.DateUpload }, false},
+		{"min_taken_date", func(ph *Photo) time.Time { return ph.DateTaken }, true},
+		{"max_taken_date", func(ph *Photo) time.Time { return ph.DateTaken }, false},
+	}
+
+	for _, f := range date_filters {
+
+		if req.Args.Get(f.arg) == "" {
+			continue
+		}
+
+		t, err := parseDate(req.Args.Get(f.arg))
+
+		if err != nil {
+			return nil, &response.Error{Code: 3, Message: fmt.Sprintf("Invalid %s parameter", f.arg)}
+		}
+
+		date_func := f.date
+		after := f.after
+
+		filters = append(filters, func(ph *Photo) bool {
+
+			d := date_func(ph).Truncate(time.Second)
+
+			if after {
+				return !d.Before(t)
+			}
+
+			return !d.After(t)
+		})
+	}
+
+	matches := make([]*Photo, 0)
+
+	for _, ph := range req.Server.Store.Photos() {
+
+		if !canView(ph, req.UserId) {
+			continue
+		}
+
+		ok := true
+
+		for _, f := range filters {
+
+			if !f(ph) {
+				ok = false
+				break
+			}
+		}
+
+		if ok {
+			matches = append(matches, ph)
+		}
+	}
+
+	sort_by := req.Args.Get("sort")
+
+	if sort_by == "" {
+		sort_by = "date-posted-desc"
+	}
+
+	var less func(a *Photo, b *Photo) bool
+
+	switch sort_by {
+	case "date-posted-asc":
+		less = func(a *Photo, b *Photo) bool { return a.DateUpload.Before(b.DateUpload) }
+	case "date-posted-desc":
+		less = func(a *Photo, b *Photo) bool { return a.DateUpload.After(b.DateUpload) }
+	case "date-taken-asc":
+		less = func(a *Photo, b *Photo) bool { return a.DateTaken.Before(b.DateTaken) }
+	case "date-taken-desc":
+		less = func(a *Photo, b *Photo) bool { return a.DateTaken.After(b.DateTaken) }
+	default:
+		return nil, &response.Error{Code: 3, Message: fmt.Sprintf("Invalid sort parameter '%s'", sort_by)}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+
+		if less(matches[i], matches[j]) {
+			return true
+		}
+
+		if less(matches[j], matches[i]) {
+			return false
+		}
+
+		return matches[i].Id < matches[j].Id
+	})
+
+	photos := make([]map[string]any, len(matches))
+
+	for i, ph := range matches {
+		photos[i] = req.Server.photoSummary(ph, req.Args.Get("extras"))
+	}
+
+	page, per_page := pagination(req)
+	page_photos, pages := paginate(photos, page, per_page)
+
+	if limit > 0 && len(photos) > limit {
+
+		_, limit_pages := paginate(photos[:limit], page, per_page)
+		page_photos, _ = paginate(photos[:limit], min(page, limit_pages), per_page)
+	}
+
+	rsp := map[string]any{
+		"photos": map[string]any{
+			"page":    page,
+			"pages":   pages,
+			"perpage": per_page,
+			"total":   len(photos),
+			"photo":   page_photos,
+		},
+	}
+
+	return rsp, nil
+}
+
+// pagination returns the page number and number of results per page derived from 'req'.
+func pagination(req *Request) (int, int) {
+
+	page := 1
+	per_page := DEFAULT_PER_PAGE
+
+	v, err := strconv.Atoi(req.Args.Get("page"))
+
+	if err == nil && v > 0 {
+		page = v
+	}
+
+	v, err = strconv.Atoi(req.Args.Get("per_page"))
+
+	if err == nil && v > 0 {
+		per_page = min(v, MAX_PER_PAGE)
+	}
+
+	return page, per_page
+}
+
+// paginate returns the items on 'page' and the total number of pages.
+func paginate(items []map[string]any, page int, per_page int) ([]map[string]any, int) {
+
+	pages := (len(items) + per_page - 1) / per_page
+
+	start := (page - 1) * per_page
+
+	if start >= len(items) {
+		return []map[string]any{}, pages
+	}
+
+	end := min(start+per_page, len(items))
+	return items[start:end], pages
+}
+
+// lookupPhoto returns the photo matching the "photo_id" argument in 'req'.
+func lookupPhoto(req *Request) (*Photo, error) {
+
+	id, err := strconv.ParseInt(req.Args.Get("photo_id"), 10, 64)
+
+	if err != nil {
+		return nil, errPhotoNotFound
+	}
+
+	ph, exists := req.Server.Store.GetPhoto(id)
+
+	if !exists {
+		return nil, errPhotoNotFound
+	}
+
+	return ph, nil
+}
+
+// viewablePhoto returns the photo matching the "photo_id" argument in 'req' if the caller is allowed to view it.
+func viewablePhoto(req *Request) (*Photo, error) {
+
+	ph, err := lookupPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !canView(ph, req.UserId) {
+		return nil, errPhotoNotFound
+	}
+
+	return ph, nil
+}
+
+// ownedPhoto returns the photo matching the "photo_id" argument in 'req' if it is owned by the caller.
+func ownedPhoto(req *Request) (*Photo, error) {
+
+	ph, err := lookupPhoto(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ph.Owner != req.UserId {
+		return nil, errPhotoNotFound
+	}
+
+	return ph, nil
+}
+
+// lookupPhotoset returns the photoset matching the "photoset_id" argument in 'req'.
+func lookupPhotoset(req *Request) (*Photoset, error) {
+
+	id, err := strconv.ParseInt(req.Args.Get("photoset_id"), 10, 64)
+
+	if err != nil {
+		return nil, errPhotosetNotFound
+	}
+
+	set, exists := req.Server.Store.GetPhotoset(id)
+
+	if !exists {
+		return nil, errPhotosetNotFound
+	}
+
+	return set, nil
+}
+
+// ownedPhotoset returns the photoset matching the "photoset_id" argument in 'req' if it is owned by the caller.
+func ownedPhotoset(req *Request) (*Photoset, error) {
+
+	set, err := lookupPhotoset(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if set.Owner != req.UserId {
+		return nil, errPhotosetNotFound
+	}
+
+	return set, nil
+}
+
+// ParseTags parses a space-separated list of tags, where tags containing spaces are enclosed in double quotes,
+// as used by the Flickr API.
+func ParseTags(str string) []string {
+
+	tags := make([]string, 0)
+
+	var buf strings.Builder
+	quoted := false
+
+	flush := func() {
+
+		t := strings.TrimSpace(buf.String())
+
+		if t != "" {
+			tags = append(tags, t)
+		}
+
+		buf.Reset()
+	}
+
+	for _, r := range str {
+
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ' ' && !quoted:
+			flush()
+		default:
+			buf.WriteRune(r)
+		}
+	}
+
+	flush()
+	return tags
+}
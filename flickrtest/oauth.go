@@ -0,0 +1,324 @@
+package flickrtest
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aaronland/go-flickr-api/auth"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// The permissions that can be granted to an access token, in ascending order.
+var perms_levels = []string{
+	"none",
+	"read",
+	"write",
+	"delete",
+}
+
+type token struct {
+	token    string
+	secret   string
+	user_id  string
+	perms    string
+	verifier string
+	callback string
+	access   bool
+}
+
+// GrantAccessToken creates a new access token, and secret, for the server's user with 'perms' permissions
+// ("read", "write" or "delete").
+func (s *Server) GrantAccessToken(perms string) (string, string, error) {
+
+	if permsLevel(perms) < 1 {
+		return "", "", fmt.Errorf("Invalid permissions '%s'", perms)
+	}
+
+	i := s.nextCounter()
+
+	t := &token{
+		token:   fmt.Sprintf("%d-access-%s", i, randomSecret()),
+		secret:  randomSecret(),
+		user_id: s.UserId,
+		perms:   perms,
+		access:  true,
+	}
+
+	s.mu.Lock()
+	s.tokens[t.token] = t
+	s.mu.Unlock()
+
+	return t.token, t.secret, nil
+}
+
+// lookupToken returns the token matching 'v' and a boolean value indicating whether it exists.
+func (s *Server) lookupToken(v string) (*token, bool) {
+
+	if v == s.OAuthToken {
+
+		t := &token{
+			token:   s.OAuthToken,
+			secret:  s.OAuthTokenSecret,
+			user_id: s.UserId,
+			perms:   "delete",
+			access:  true,
+		}
+
+		return t, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, exists := s.tokens[v]
+	return t, exists
+}
+
+// verifySignature ensures that 'args' were signed, for 'req', using the server's consumer secret and 'token_secret'.
+// Nonces may only be used once.
+func (s *Server) verifySignature(req *http.Request, args url.Values, token_secret string) error {
+
+	if args.Get("oauth_consumer_key") != s.ConsumerKey {
+		return &response.Error{Code: 100, Message: "Invalid API Key (Key not found)"}
+	}
+
+	sig := args.Get("oauth_signature")
+
+	if sig == "" {
+		return &response.Error{Code: 97, Message: "Missing signature"}
+	}
+
+	if args.Get("oauth_signature_method") != "HMAC-SHA1" {
+		return &response.Error{Code: 96, Message: "Invalid signature (unsupported signature method)"}
+	}
+
+	nonce := args.Get("oauth_nonce")
+
+	if nonce == "" || args.Get("oauth_timestamp") == "" {
+		return &response.Error{Code: 96, Message: "Invalid signature (missing nonce or timestamp)"}
+	}
+
+	unsigned := url.Values{}
+
+	for k, v := range args {
+
+		if k == "oauth_signature" {
+			continue
+		}
+
+		unsigned[k] = v
+	}
+
+	scheme := "http"
+
+	if req.TLS != nil {
+		scheme = "https"
+	}
+
+	endpoint := &url.URL{
+		Scheme: scheme,
+		Host:   req.Host,
+		Path:   req.URL.Path,
+	}
+
+	key := fmt.Sprintf("%s&%s", url.QueryEscape(s.ConsumerSecret), url.QueryEscape(token_secret))
+	base_string := auth.GenerateOAuth1SigningBaseString(req.Method, endpoint, &unsigned)
+	expected := auth.GenerateOAuth1Signature(key, base_string)
+
+	if !hmac.Equal([]byte(sig), []byte(expected)) {
+		return &response.Error{Code: 96, Message: "Invalid signature"}
+	}
+
+	nonce_key := strings.Join([]string{args.Get("oauth_consumer_key"), args.Get("oauth_timestamp"), nonce}, "#")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nonces[nonce_key] {
+		return &response.Error{Code: 96, Message: "Invalid signature (nonce has already been used)"}
+	}
+
+	s.nonces[nonce_key] = true
+	return nil
+}
+
+// handleRequestToken implements the OAuth1 request token endpoint.
+func (s *Server) handleRequestToken(rsp http.ResponseWriter, req *http.Request) {
+
+	args := req.URL.Query()
+
+	err := s.verifySignature(req, args, "")
+
+	if err != nil {
+		writeOAuthError(rsp, err)
+		return
+	}
+
+	cb := args.Get("oauth_callback")
+
+	if cb == "" {
+		http.Error(rsp, "oauth_problem=parameter_absent&oauth_parameters_absent=oauth_callback", http.StatusBadRequest)
+		return
+	}
+
+	i := s.nextCounter()
+
+	t := &token{
+		token:    fmt.Sprintf("%d-request-%s", i, randomSecret()),
+		secret:   randomSecret(),
+		callback: cb,
+	}
+
+	s.mu.Lock()
+	s.tokens[t.token] = t
+	s.mu.Unlock()
+
+	q := url.Values{}
+	q.Set("oauth_callback_confirmed", "true")
+	q.Set("oauth_token", t.token)
+	q.Set("oauth_token_secret", t.secret)
+
+	rsp.Write([]byte(q.Encode()))
+}
+
+// handleAuthorize implements the OAuth1 authorization endpoint. Requests are automatically approved, on behalf of the
+// server's user, and redirected to the callback URL associated with the request token. If the callback URL is "oob"
+// the authorization token is written to the response body as a query string.
+func (s *Server) handleAuthorize(rsp http.ResponseWriter, req *http.Request) {
+
+	q := req.URL.Query()
+
+	perms := q.Get("perms")
+
+	if perms == "" {
+		perms = "read"
+	}
+
+	if permsLevel(perms) < 1 {
+		http.Error(rsp, "Invalid permissions", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+
+	t, exists := s.tokens[q.Get("oauth_token")]
+
+	if exists && !t.access {
+		t.verifier = randomSecret()
+		t.perms = perms
+		t.user_id = s.UserId
+	}
+
+	s.mu.Unlock()
+
+	if !exists || t.access {
+		http.Error(rsp, "Invalid request token", http.StatusBadRequest)
+		return
+	}
+
+	auth_q := url.Values{}
+	auth_q.Set("oauth_token", t.token)
+	auth_q.Set("oauth_verifier", t.verifier)
+
+	if t.callback == "oob" {
+		rsp.Write([]byte(auth_q.Encode()))
+		return
+	}
+
+	cb_u, err := url.Parse(t.callback)
+
+	if err != nil {
+		http.Error(rsp, "Invalid callback URL", http.StatusBadRequest)
+		return
+	}
+
+	cb_q := cb_u.Query()
+
+	for k, v := range auth_q {
+		cb_q[k] = v
+	}
+
+	cb_u.RawQuery = cb_q.Encode()
+
+	http.Redirect(rsp, req, cb_u.String(), http.StatusFound)
+}
+
+// handleAccessToken implements the OAuth1 access token endpoint, exchanging an authorized request token for a new access token.
+func (s *Server) handleAccessToken(rsp http.ResponseWriter, req *http.Request) {
+
+	args := req.URL.Query()
+
+	s.mu.Lock()
+	req_token, exists := s.tokens[args.Get("oauth_token")]
+	s.mu.Unlock()
+
+	if !exists || req_token.access {
+		http.Error(rsp, "oauth_problem=token_rejected", http.StatusUnauthorized)
+		return
+	}
+
+	err := s.verifySignature(req, args, req_token.secret)
+
+	if err != nil {
+		writeOAuthError(rsp, err)
+		return
+	}
+
+	if req_token.verifier == "" || args.Get("oauth_verifier") != req_token.verifier {
+		http.Error(rsp, "oauth_problem=verifier_invalid", http.StatusUnauthorized)
+		return
+	}
+
+	i := s.nextCounter()
+
+	t := &token{
+		token:   fmt.Sprintf("%d-access-%s", i, randomSecret()),
+		secret:  randomSecret(),
+		user_id: req_token.user_id,
+		perms:   req_token.perms,
+		access:  true,
+	}
+
+	s.mu.Lock()
+	delete(s.tokens, req_token.token)
+	s.tokens[t.token] = t
+	s.mu.Unlock()
+
+	q := url.Values{}
+	q.Set("fullname", s.Username)
+	q.Set("oauth_token", t.token)
+	q.Set("oauth_token_secret", t.secret)
+	q.Set("user_nsid", t.user_id)
+	q.Set("username", s.Username)
+
+	rsp.Write([]byte(q.Encode()))
+}
+
+func writeOAuthError(rsp http.ResponseWriter, err error) {
+
+	problem := "signature_invalid"
+
+	api_err, ok := err.(*response.Error)
+
+	if ok && api_err.Code == 100 {
+		problem = "consumer_key_unknown"
+	}
+
+	http.Error(rsp, "oauth_problem="+problem, http.StatusUnauthorized)
+}
+
+// permsLevel returns the numeric value for the 'perms' permissions string or -1 if invalid.
+func permsLevel(perms string) int {
+
+	for i, p := range perms_levels {
+
+		if p == perms {
+			return i
+		}
+	}
+
+	return -1
+}
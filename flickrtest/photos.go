@@ -0,0 +1,255 @@
+package flickrtest
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The format used by the Flickr API for "date taken" values.
+const DATE_TAKEN_FORMAT string = "2006-01-02 15:04:05"
+
+// The labels and suffixes for the photo sizes returned by the flickr.photos.getSizes method.
+var photo_sizes = [][2]string{
+	{"Square", "s"},
+	{"Large Square", "q"},
+	{"Thumbnail", "t"},
+	{"Small", "m"},
+	{"Medium", ""},
+	{"Medium 640", "z"},
+	{"Large", "b"},
+	{"Large 2048", "k"},
+	{"Original", "o"},
+}
+
+// PhotoURL returns the URL of the static photo for 'ph' hosted by the fake server. 'size' is a Flickr size suffix,
+// for example "b" or "o" (original). If empty the default ("Medium") size is returned.
+func (s *Server) PhotoURL(ph *Photo, size string) string {
+
+	switch size {
+	case "o":
+		return fmt.Sprintf("%s/%s/%d_%s_o.%s", s.StaticURL(), ph.Server, ph.Id, ph.OriginalSecret, ph.OriginalFormat)
+	case "":
+		return fmt.Sprintf("%s/%s/%d_%s.jpg", s.StaticURL(), ph.Server, ph.Id, ph.Secret)
+	default:
+		return fmt.Sprintf("%s/%s/%d_%s_%s.jpg", s.StaticURL(), ph.Server, ph.Id, ph.Secret, size)
+	}
+}
+
+// photoInfo returns a representation of 'ph' matching the "photo" element of a flickr.photos.getInfo API response.
+func (s *Server) photoInfo(ph *Photo) map[string]any {
+
+	tags := make([]map[string]any, 0)
+
+	for i, t := range ph.Tags {
+
+		machine_tag := 0
+
+		if IsMachineTag(t) {
+			machine_tag = 1
+		}
+
+		tags = append(tags, map[string]any{
+			"id":          tagId(ph, i),
+			"author":      ph.Owner,
+			"raw":         t,
+			"_content":    NormalizeTag(t),
+			"machine_tag": machine_tag,
+		})
+	}
+
+	info := map[string]any{
+		"id":             strconv.FormatInt(ph.Id, 10),
+		"secret":         ph.Secret,
+		"server":         ph.Server,
+		"farm":           66,
+		"dateuploaded":   strconv.FormatInt(ph.DateUpload.Unix(), 10),
+		"license":        ph.License,
+		"originalsecret": ph.OriginalSecret,
+		"originalformat": ph.OriginalFormat,
+		"media":          "photo",
+		"owner": map[string]any{
+			"nsid":     ph.Owner,
+			"username": s.Username,
+		},
+		"title": map[string]any{
+			"_content": ph.Title,
+		},
+		"description": map[string]any{
+			"_content": ph.Description,
+		},
+		"visibility": map[string]any{
+			"ispublic": ph.IsPublic,
+			"isfriend": ph.IsFriend,
+			"isfamily": ph.IsFamily,
+		},
+		"dates": map[string]any{
+			"posted":           strconv.FormatInt(ph.DateUpload.Unix(), 10),
+			"taken":            ph.DateTaken.Format(DATE_TAKEN_FORMAT),
+			"takengranularity": 0,
+			"lastupdate":       strconv.FormatInt(ph.LastUpdate.Unix(), 10),
+		},
+		"tags": map[string]any{
+			"tag": tags,
+		},
+		"urls": map[string]any{
+			"url": []map[string]any{
+				{
+					"type":     "photopage",
+					"_content": fmt.Sprintf("%s/photos/%s/%d/", s.URL, ph.Owner, ph.Id),
+				},
+			},
+		},
+	}
+
+	if ph.Accuracy != 0 {
+
+		info["location"] = map[string]any{
+			"latitude":  strconv.FormatFloat(ph.Latitude, 'f', -1, 64),
+			"longitude": strconv.FormatFloat(ph.Longitude, 'f', -1, 64),
+			"accuracy":  strconv.Itoa(ph.Accuracy),
+		}
+	}
+
+	return info
+}
+
+// tagId returns the ID of the tag at index 'i' of the tags assigned to 'ph'.
+func tagId(ph *Photo, i int) string {
+	return fmt.Sprintf("%d-%d-%d", ph.Id, i, len(ph.Tags[i]))
+}
+
+// photoSummary returns a representation of 'ph' matching the elements of a "standard photos response", including
+// any of the (comma-separated) 'extras' that are supported.
+// https://code.flickr.net/2008/08/19/standard-photos-response-apis-for-civilized-age/
+func (s *Server) photoSummary(ph *Photo, extras string) map[string]any {
+
+	summary := map[string]any{
+		"id":       strconv.FormatInt(ph.Id, 10),
+		"owner":    ph.Owner,
+		"secret":   ph.Secret,
+		"server":   ph.Server,
+		"farm":     66,
+		"title":    ph.Title,
+		"ispublic": ph.IsPublic,
+		"isfriend": ph.IsFriend,
+		"isfamily": ph.IsFamily,
+	}
+
+	for _, e := range strings.Split(extras, ",") {
+
+		e = strings.TrimSpace(e)
+
+		switch {
+		case e == "":
+			// pass
+		case strings.HasPrefix(e, "url_"):
+			size := strings.TrimPrefix(e, "url_")
+			summary[e] = s.PhotoURL(ph, size)
+		case e == "lastupdate":
+			summary["lastupdate"] = strconv.FormatInt(ph.LastUpdate.Unix(), 10)
+		case e == "date_upload":
+			summary["dateupload"] = strconv.FormatInt(ph.DateUpload.Unix(), 10)
+		case e == "date_taken":
+			summary["datetaken"] = ph.DateTaken.Format(DATE_TAKEN_FORMAT)
+			summary["datetakengranularity"] = 0
+		case e == "description":
+			summary["description"] = map[string]any{"_content": ph.Description}
+		case e == "license":
+			summary["license"] = ph.License
+		case e == "owner_name":
+			summary["ownername"] = s.Username
+		case e == "original_format":
+			summary["originalsecret"] = ph.OriginalSecret
+			summary["originalformat"] = ph.OriginalFormat
+		case e == "media":
+			summary["media"] = "photo"
+		case e == "geo":
+			summary["latitude"] = ph.Latitude
+			summary["longitude"] = ph.Longitude
+			summary["accuracy"] = ph.Accuracy
+		case e == "tags" || e == "machine_tags":
+
+			tags := make([]string, 0)
+
+			for _, t := range ph.Tags {
+
+				if IsMachineTag(t) == (e == "machine_tags") {
+					tags = append(tags, NormalizeTag(t))
+				}
+			}
+
+			summary[e] = strings.Join(tags, " ")
+		}
+	}
+
+	return summary
+}
+
+// photoSizes returns a representation of 'ph' matching the "sizes" element of a flickr.photos.getSizes API response.
+// The fake server does not resize photos so all sizes have the dimensions of the original photo.
+func (s *Server) photoSizes(ph *Photo) map[string]any {
+
+	width := 0
+	height := 0
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(ph.Body))
+
+	if err == nil {
+		width = cfg.Width
+		height = cfg.Height
+	}
+
+	sizes := make([]map[string]any, 0)
+
+	for _, sz := range photo_sizes {
+
+		sizes = append(sizes, map[string]any{
+			"label":  sz[0],
+			"width":  width,
+			"height": height,
+			"source": s.PhotoURL(ph, sz[1]),
+			"url":    fmt.Sprintf("%s/photos/%s/%d/sizes/%s/", s.URL, ph.Owner, ph.Id, sz[1]),
+			"media":  "photo",
+		})
+	}
+
+	return map[string]any{
+		"canblog":     0,
+		"canprint":    0,
+		"candownload": 1,
+		"size":        sizes,
+	}
+}
+
+// canView returns a boolean value indicating whether 'user_id' is allowed to view 'ph'.
+func canView(ph *Photo, user_id string) bool {
+	return ph.IsPublic == 1 || (user_id != "" && ph.Owner == user_id)
+}
+
+// parseDate parses 'v' as either a Unix timestamp or a MySQL-style datetime string, as used by the Flickr API.
+func parseDate(v string) (time.Time, error) {
+
+	ts, err := strconv.ParseInt(v, 10, 64)
+
+	if err == nil {
+		return time.Unix(ts, 0), nil
+	}
+
+	for _, layout := range []string{DATE_TAKEN_FORMAT, "2006-01-02"} {
+
+		t, err := time.ParseInLocation(layout, v, time.Local)
+
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("Invalid date '%s'", v)
+}
@@ -0,0 +1,463 @@
+package flickrtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The first photo (and photoset) ID assigned by a Store.
+const FIRST_ID int64 = 10000000000
+
+// The "server" value assigned to photos by a Store.
+const PHOTO_SERVER string = "65535"
+
+// Photo is a struct containing the details of a photo in a Store.
+type Photo struct {
+	// The unique ID of the photo.
+	Id int64
+	// The NSID of the user who owns the photo.
+	Owner string
+	// The secret used to derive static URLs for (non-original) sizes of the photo.
+	Secret string
+	// The secret used to derive the static URL for the original photo.
+	OriginalSecret string
+	// The server used to derive static URLs for the photo.
+	Server string
+	// The file extension of the original photo, for example "jpg".
+	OriginalFormat string
+	// The title of the photo.
+	Title string
+	// The description of the photo.
+	Description string
+	// The (raw) tags, including machine tags, assigned to the photo.
+	Tags []string
+	// A numeric flag (1 or 0) indicating whether the photo is public.
+	IsPublic int
+	// A numeric flag (1 or 0) indicating whether the photo is visible to friends.
+	IsFriend int
+	// A numeric flag (1 or 0) indicating whether the photo is visible to family.
+	IsFamily int
+	// The date the photo was taken.
+	DateTaken time.Time
+	// The date the photo was uploaded.
+	DateUpload time.Time
+	// The date the photo was last updated.
+	LastUpdate time.Time
+	// The latitude of the photo, if geotagged.
+	Latitude float64
+	// The longitude of the photo, if geotagged.
+	Longitude float64
+	// The accuracy of the photo's location. If 0 the photo is not geotagged.
+	Accuracy int
+	// The license ID for the photo.
+	License string
+	// The contents of the original photo.
+	Body []byte
+	// The content type of the original photo.
+	ContentType string
+	// The EXIF tags, and their raw values, returned by the flickr.photos.getExif method.
+	Exif map[string]string
+	// The comments on the photo.
+	Comments []*Comment
+}
+
+// Comment is a struct containing the details of a comment on a photo in a Store.
+type Comment struct {
+	// The unique ID of the comment.
+	Id string
+	// The NSID of the user who wrote the comment.
+	Author string
+	// The body of the comment.
+	Body string
+	// The date the comment was created.
+	DateCreate time.Time
+}
+
+// Photoset is a struct containing the details of a photoset in a Store.
+type Photoset struct {
+	// The unique ID of the photoset.
+	Id int64
+	// The NSID of the user who owns the photoset.
+	Owner string
+	// The title of the photoset.
+	Title string
+	// The description of the photoset.
+	Description string
+	// The ID of the primary photo for the photoset.
+	Primary int64
+	// The ordered list of photo IDs in the photoset.
+	Photos []int64
+	// The date the photoset was created.
+	DateCreate time.Time
+	// The date the photoset was last updated.
+	DateUpdate time.Time
+}
+
+// Store is an in-memory store of photos and photosets that is safe to use across goroutines. Photo and Photoset
+// instances returned by a Store are copies so changes must be applied using the UpdatePhoto and UpdatePhotoset methods.
+type Store struct {
+	mu        sync.RWMutex
+	last_id   int64
+	photos    map[int64]*Photo
+	photosets map[int64]*Photoset
+}
+
+// NewStore returns a new, empty, Store instance.
+func NewStore() *Store {
+
+	s := &Store{
+		last_id:   FIRST_ID - 1,
+		photos:    make(map[int64]*Photo),
+		photosets: make(map[int64]*Photoset),
+	}
+
+	return s
+}
+
+// AddPhoto adds a copy of 'ph' to the store, returning the copy. If 'ph' does not have an ID, secrets, server or dates
+// they will be assigned.
+func (s *Store) AddPhoto(ph *Photo) *Photo {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ph = ph.clone()
+
+	if ph.Id == 0 {
+		ph.Id = s.nextId()
+	} else if ph.Id > s.last_id {
+		s.last_id = ph.Id
+	}
+
+	if ph.Secret == "" {
+		ph.Secret = randomSecret()
+	}
+
+	if ph.OriginalSecret == "" {
+		ph.OriginalSecret = randomSecret()
+	}
+
+	if ph.Server == "" {
+		ph.Server = PHOTO_SERVER
+	}
+
+	if ph.OriginalFormat == "" {
+		ph.OriginalFormat = "jpg"
+	}
+
+	now := time.Now()
+
+	if ph.DateUpload.IsZero() {
+		ph.DateUpload = now
+	}
+
+	if ph.DateTaken.IsZero() {
+		ph.DateTaken = ph.DateUpload
+	}
+
+	if ph.LastUpdate.IsZero() {
+		ph.LastUpdate = ph.DateUpload
+	}
+
+	s.photos[ph.Id] = ph
+	return ph.clone()
+}
+
+// GetPhoto returns a copy of the photo with ID 'id' and a boolean value indicating whether it exists.
+func (s *Store) GetPhoto(id int64) (*Photo, bool) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ph, exists := s.photos[id]
+
+	if !exists {
+		return nil, false
+	}
+
+	return ph.clone(), true
+}
+
+// UpdatePhoto applies 'update_func' to the photo with ID 'id'. If 'update_func' returns an error then no changes are
+// applied. The photo's LastUpdate date is set to the current time.
+func (s *Store) UpdatePhoto(id int64, update_func func(*Photo) error) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ph, exists := s.photos[id]
+
+	if !exists {
+		return errPhotoNotFound
+	}
+
+	ph = ph.clone()
+
+	err := update_func(ph)
+
+	if err != nil {
+		return err
+	}
+
+	ph.Id = id
+	ph.LastUpdate = time.Now()
+
+	s.photos[id] = ph
+	return nil
+}
+
+// DeletePhoto removes the photo with ID 'id' from the store and from any photosets that contain it, returning a
+// boolean value indicating whether the photo existed.
+func (s *Store) DeletePhoto(id int64) bool {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, exists := s.photos[id]
+
+	if !exists {
+		return false
+	}
+
+	delete(s.photos, id)
+
+	for _, set := range s.photosets {
+
+		idx := slices.Index(set.Photos, id)
+
+		if idx == -1 {
+			continue
+		}
+
+		set.Photos = slices.Delete(set.Photos, idx, idx+1)
+
+		if set.Primary == id {
+			set.Primary = 0
+
+			if len(set.Photos) > 0 {
+				set.Primary = set.Photos[0]
+			}
+		}
+	}
+
+	return true
+}
+
+// Photos returns copies of all the photos in the store sorted by ID.
+func (s *Store) Photos() []*Photo {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	photos := make([]*Photo, 0, len(s.photos))
+
+	for _, ph := range s.photos {
+		photos = append(photos, ph.clone())
+	}
+
+	sort.Slice(photos, func(i, j int) bool {
+		return photos[i].Id < photos[j].Id
+	})
+
+	return photos
+}
+
+// AddPhotoset adds a copy of 'set' to the store, returning the copy. If 'set' does not have an ID or dates
+// they will be assigned.
+func (s *Store) AddPhotoset(set *Photoset) *Photoset {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set = set.clone()
+
+	if set.Id == 0 {
+		set.Id = s.nextId()
+	} else if set.Id > s.last_id {
+		s.last_id = set.Id
+	}
+
+	now := time.Now()
+
+	if set.DateCreate.IsZero() {
+		set.DateCreate = now
+	}
+
+	if set.DateUpdate.IsZero() {
+		set.DateUpdate = set.DateCreate
+	}
+
+	if set.Primary == 0 && len(set.Photos) > 0 {
+		set.Primary = set.Photos[0]
+	}
+
+	s.photosets[set.Id] = set
+	return set.clone()
+}
+
+// GetPhotoset returns a copy of the photoset with ID 'id' and a boolean value indicating whether it exists.
+func (s *Store) GetPhotoset(id int64) (*Photoset, bool) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	set, exists := s.photosets[id]
+
+	if !exists {
+		return nil, false
+	}
+
+	return set.clone(), true
+}
+
+// UpdatePhotoset applies 'update_func' to the photoset with ID 'id'. If 'update_func' returns an error then no changes
+// are applied. The photoset's DateUpdate date is set to the current time.
+func (s *Store) UpdatePhotoset(id int64, update_func func(*Photoset) error) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, exists := s.photosets[id]
+
+	if !exists {
+		return errPhotosetNotFound
+	}
+
+	set = set.clone()
+
+	err := update_func(set)
+
+	if err != nil {
+		return err
+	}
+
+	set.Id = id
+	set.DateUpdate = time.Now()
+
+	s.photosets[id] = set
+	return nil
+}
+
+// DeletePhotoset removes the photoset with ID 'id' from the store, returning a boolean value indicating whether the photoset existed.
+func (s *Store) DeletePhotoset(id int64) bool {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, exists := s.photosets[id]
+
+	if !exists {
+		return false
+	}
+
+	delete(s.photosets, id)
+	return true
+}
+
+// Photosets returns copies of all the photosets in the store sorted by ID.
+func (s *Store) Photosets() []*Photoset {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sets := make([]*Photoset, 0, len(s.photosets))
+
+	for _, set := range s.photosets {
+		sets = append(sets, set.clone())
+	}
+
+	sort.Slice(sets, func(i, j int) bool {
+		return sets[i].Id < sets[j].Id
+	})
+
+	return sets
+}
+
+// nextId returns the next available ID. It is assumed that the caller holds the lock.
+func (s *Store) nextId() int64 {
+	s.last_id += 1
+	return s.last_id
+}
+
+// IsMachineTag returns a boolean value indicating whether 'tag' is a machine tag, for example "file:sha256=abc".
+func IsMachineTag(tag string) bool {
+
+	ns, rest, ok := strings.Cut(tag, ":")
+
+	if !ok || ns == "" {
+		return false
+	}
+
+	pred, value, ok := strings.Cut(rest, "=")
+	return ok && pred != "" && value != ""
+}
+
+// NormalizeTag returns the normalized form of 'tag' in the same manner as the Flickr API. Regular tags are lower-cased
+// with anything that isn't a letter or a number removed. Machine tags have their namespace and predicate lower-cased.
+func NormalizeTag(tag string) string {
+
+	if IsMachineTag(tag) {
+		ns, rest, _ := strings.Cut(tag, ":")
+		pred, value, _ := strings.Cut(rest, "=")
+		return strings.ToLower(ns) + ":" + strings.ToLower(pred) + "=" + value
+	}
+
+	var buf strings.Builder
+
+	for _, r := range strings.ToLower(tag) {
+
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r > 127 {
+			buf.WriteRune(r)
+		}
+	}
+
+	return buf.String()
+}
+
+// HasTag returns a boolean value indicating whether the photo has a tag whose normalized form matches the normalized form of 'tag'.
+func (ph *Photo) HasTag(tag string) bool {
+
+	tag = NormalizeTag(tag)
+
+	for _, t := range ph.Tags {
+
+		if NormalizeTag(t) == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ph *Photo) clone() *Photo {
+
+	c := *ph
+	c.Tags = slices.Clone(ph.Tags)
+	c.Body = slices.Clone(ph.Body)
+	c.Exif = maps.Clone(ph.Exif)
+	c.Comments = slices.Clone(ph.Comments)
+
+	return &c
+}
+
+func (set *Photoset) clone() *Photoset {
+
+	c := *set
+	c.Photos = slices.Clone(set.Photos)
+
+	return &c
+}
+
+func randomSecret() string {
+
+	var buf [5]byte
+	rand.Read(buf[:])
+
+	return hex.EncodeToString(buf[:])
+}
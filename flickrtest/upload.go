@@ -0,0 +1,390 @@
+package flickrtest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// The maximum size, in bytes, of uploaded photos held in memory while parsing multipart requests.
+const MAX_UPLOAD_MEMORY int64 = 32 << 20
+
+var re_static = regexp.MustCompile(`^/(\d+)/(\d+)_([0-9a-f]+)(?:_([a-z0-9]+))?\.(\w+)$`)
+
+type ticket struct {
+	id          string
+	photo_id    int64
+	complete_at time.Time
+	failed      bool
+}
+
+// uploadResponse is a struct used to encode the XML responses for the upload and replace endpoints.
+type uploadResponse struct {
+	XMLName  xml.Name             `xml:"rsp"`
+	Status   string               `xml:"stat,attr"`
+	Error    *uploadResponseError `xml:"err,omitempty"`
+	PhotoId  *uploadResponsePhoto `xml:"photoid,omitempty"`
+	TicketId string               `xml:"ticketid,omitempty"`
+}
+
+type uploadResponseError struct {
+	Code    int    `xml:"code,attr"`
+	Message string `xml:"msg,attr"`
+}
+
+type uploadResponsePhoto struct {
+	Id             int64  `xml:",chardata"`
+	Secret         string `xml:"secret,attr,omitempty"`
+	OriginalSecret string `xml:"originalsecret,attr,omitempty"`
+}
+
+// handleUpload implements the Flickr upload endpoint. Uploads are added to the server's Store immediately but if the
+// "async" argument is "1" the upload ticket is only marked as complete after the server's TicketDelay.
+func (s *Server) handleUpload(rsp http.ResponseWriter, req *http.Request) {
+
+	args, body, fname, err := s.parseUpload(req)
+
+	if err != nil {
+		writeUploadResponse(rsp, nil, err)
+		return
+	}
+
+	content_type, format := deriveContentType(body, fname)
+
+	ph := &Photo{
+		Owner:          args.Get("user_id"),
+		Title:          args.Get("title"),
+		Description:    args.Get("description"),
+		Tags:           ParseTags(args.Get("tags")),
+		IsPublic:       flagValue(args, "is_public", 1),
+		IsFriend:       flagValue(args, "is_friend", 0),
+		IsFamily:       flagValue(args, "is_family", 0),
+		Body:           body,
+		ContentType:    content_type,
+		OriginalFormat: format,
+	}
+
+	if ph.Title == "" {
+		ph.Title = strings.TrimSuffix(filepath.Base(fname), filepath.Ext(fname))
+	}
+
+	ph = s.Store.AddPhoto(ph)
+
+	if args.Get("async") == "1" {
+		writeUploadResponse(rsp, &uploadResponse{TicketId: s.newTicket(ph.Id)}, nil)
+		return
+	}
+
+	writeUploadResponse(rsp, &uploadResponse{PhotoId: &uploadResponsePhoto{Id: ph.Id}}, nil)
+}
+
+// handleReplace implements the Flickr replace endpoint. Replacements are applied to the server's Store immediately but
+// if the "async" argument is "1" the upload ticket is only marked as complete after the server's TicketDelay.
+func (s *Server) handleReplace(rsp http.ResponseWriter, req *http.Request) {
+
+	args, body, fname, err := s.parseUpload(req)
+
+	if err != nil {
+		writeUploadResponse(rsp, nil, err)
+		return
+	}
+
+	photo_id, err := strconv.ParseInt(args.Get("photo_id"), 10, 64)
+
+	if err != nil {
+		writeUploadResponse(rsp, nil, &response.Error{Code: 2, Message: "No photo specified"})
+		return
+	}
+
+	ph, exists := s.Store.GetPhoto(photo_id)
+
+	if !exists || ph.Owner != args.Get("user_id") {
+		writeUploadResponse(rsp, nil, &response.Error{Code: 7, Message: "Photo not found"})
+		return
+	}
+
+	content_type, format := deriveContentType(body, fname)
+
+	err = s.Store.UpdatePhoto(photo_id, func(ph *Photo) error {
+		ph.Body = body
+		ph.ContentType = content_type
+		ph.OriginalFormat = format
+		ph.Secret = randomSecret()
+		ph.OriginalSecret = randomSecret()
+		return nil
+	})
+
+	if err != nil {
+		writeUploadResponse(rsp, nil, err)
+		return
+	}
+
+	ph, _ = s.Store.GetPhoto(photo_id)
+
+	if args.Get("async") == "1" {
+		writeUploadResponse(rsp, &uploadResponse{TicketId: s.newTicket(ph.Id)}, nil)
+		return
+	}
+
+	photo := &uploadResponsePhoto{
+		Id:             ph.Id,
+		Secret:         ph.Secret,
+		OriginalSecret: ph.OriginalSecret,
+	}
+
+	writeUploadResponse(rsp, &uploadResponse{PhotoId: photo}, nil)
+}
+
+// parseUpload parses and verifies the multipart request for the upload and replace endpoints returning the (signed)
+// arguments, the body of the uploaded photo and its filename. The NSID of the user associated with the request's
+// access token is assigned to the "user_id" key of the returned arguments.
+func (s *Server) parseUpload(req *http.Request) (url.Values, []byte, string, error) {
+
+	if req.Method != http.MethodPost {
+		return nil, nil, "", &response.Error{Code: 98, Message: "Uploads must be POST requests"}
+	}
+
+	err := req.ParseMultipartForm(MAX_UPLOAD_MEMORY)
+
+	if err != nil {
+		return nil, nil, "", &response.Error{Code: 2, Message: "No photo specified"}
+	}
+
+	args := url.Values{}
+
+	for k, v := range req.MultipartForm.Value {
+		args[k] = v
+	}
+
+	user_id, perms, err := s.authorizeRequest(req, args)
+
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	if permsLevel(perms) < permsLevel("write") {
+		return nil, nil, "", &response.Error{Code: 99, Message: "Insufficient permissions. Method requires write privileges; " + perms + " granted."}
+	}
+
+	fh, hdr, err := req.FormFile("photo")
+
+	if err != nil {
+		return nil, nil, "", &response.Error{Code: 2, Message: "No photo specified"}
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, nil, "", &response.Error{Code: 4, Message: "Filesize was zero"}
+	}
+
+	if len(body) == 0 {
+		return nil, nil, "", &response.Error{Code: 4, Message: "Filesize was zero"}
+	}
+
+	args.Set("user_id", user_id)
+	return args, body, hdr.Filename, nil
+}
+
+// newTicket creates a new upload ticket for 'photo_id' that will be marked as complete after the server's TicketDelay.
+func (s *Server) newTicket(photo_id int64) string {
+
+	i := s.nextCounter()
+
+	t := &ticket{
+		id:          strconv.FormatInt(i, 10),
+		photo_id:    photo_id,
+		complete_at: time.Now().Add(s.TicketDelay),
+	}
+
+	s.mu.Lock()
+	s.tickets[t.id] = t
+	s.mu.Unlock()
+
+	return t.id
+}
+
+// FailTicket marks the upload ticket with ID 'id' as failed, returning false if the ticket does not exist. Failed
+// tickets are reported with a "complete" value of 2 by the flickr.photos.upload.checkTickets method.
+func (s *Server) FailTicket(id string) bool {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, exists := s.tickets[id]
+
+	if !exists {
+		return false
+	}
+
+	t.failed = true
+	return true
+}
+
+// checkTicket returns a representation of the ticket with ID 'id' matching the "ticket" elements of a
+// flickr.photos.upload.checkTickets API response.
+func (s *Server) checkTicket(id string) map[string]any {
+
+	s.mu.Lock()
+	t, exists := s.tickets[id]
+	s.mu.Unlock()
+
+	if !exists {
+		return map[string]any{
+			"id":      id,
+			"invalid": 1,
+		}
+	}
+
+	if t.failed {
+		return map[string]any{
+			"id":       t.id,
+			"complete": 2,
+		}
+	}
+
+	if time.Now().Before(t.complete_at) {
+		return map[string]any{
+			"id":       t.id,
+			"complete": 0,
+		}
+	}
+
+	return map[string]any{
+		"id":       t.id,
+		"complete": 1,
+		"photoid":  strconv.FormatInt(t.photo_id, 10),
+		"imported": strconv.FormatInt(t.complete_at.Unix(), 10),
+	}
+}
+
+// handleStatic serves the static photos in the server's Store. The fake server does not resize photos so the
+// original photo is returned for all sizes.
+func (s *Server) handleStatic(rsp http.ResponseWriter, req *http.Request) {
+
+	m := re_static.FindStringSubmatch(strings.TrimPrefix(req.URL.Path, STATIC_PATH))
+
+	if m == nil {
+		http.NotFound(rsp, req)
+		return
+	}
+
+	id, err := strconv.ParseInt(m[2], 10, 64)
+
+	if err != nil {
+		http.NotFound(rsp, req)
+		return
+	}
+
+	ph, exists := s.Store.GetPhoto(id)
+
+	if !exists || ph.Server != m[1] {
+		http.NotFound(rsp, req)
+		return
+	}
+
+	secret := ph.Secret
+
+	if m[4] == "o" {
+		secret = ph.OriginalSecret
+	}
+
+	if m[3] != secret {
+		http.NotFound(rsp, req)
+		return
+	}
+
+	content_type := ph.ContentType
+
+	if content_type == "" {
+		content_type = http.DetectContentType(ph.Body)
+	}
+
+	rsp.Header().Set("Content-Type", content_type)
+	http.ServeContent(rsp, req, "", ph.LastUpdate, bytes.NewReader(ph.Body))
+}
+
+func writeUploadResponse(rsp http.ResponseWriter, up *uploadResponse, err error) {
+
+	if err != nil {
+
+		up = &uploadResponse{
+			Status: "fail",
+		}
+
+		api_err, ok := err.(*response.Error)
+
+		if ok {
+			up.Error = &uploadResponseError{Code: api_err.Code, Message: api_err.Message}
+		} else {
+			up.Error = &uploadResponseError{Code: 105, Message: err.Error()}
+		}
+
+	} else {
+		up.Status = "ok"
+	}
+
+	body, err := xml.Marshal(up)
+
+	if err != nil {
+		http.Error(rsp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rsp.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	rsp.Write([]byte(xml.Header))
+	rsp.Write(body)
+}
+
+// deriveContentType returns the content type and file extension for an uploaded photo, preferring the type
+// derived from the photo's contents over 'fname'.
+func deriveContentType(body []byte, fname string) (string, string) {
+
+	content_type := http.DetectContentType(body)
+
+	switch content_type {
+	case "image/jpeg":
+		return content_type, "jpg"
+	case "image/png":
+		return content_type, "png"
+	case "image/gif":
+		return content_type, "gif"
+	}
+
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(fname)), ".")
+
+	if ext != "" {
+
+		t := mime.TypeByExtension("." + ext)
+
+		if t != "" {
+			return t, ext
+		}
+	}
+
+	return content_type, "jpg"
+}
+
+// flagValue returns the numeric (1 or 0) flag for the argument 'k' in 'args' or 'default_value' if absent or invalid.
+func flagValue(args url.Values, k string, default_value int) int {
+
+	v, err := strconv.Atoi(args.Get(k))
+
+	if err != nil || (v != 0 && v != 1) {
+		return default_value
+	}
+
+	return v
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	io_fs "io/fs"
+	"iter"
 	"log/slog"
 	"net/http"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aaronland/go-flickr-api/client"
@@ -21,10 +23,36 @@ import (
 var re_photo = regexp.MustCompile(`^(?:\d+|(?:.*?\#)?\/?\d+\/\d+_\w+_[a-z]\.\w+)$`)
 var re_url = regexp.MustCompile(`\#?(\/?\d+\/\d+_\w+_[a-z]\.\w+)$`)
 
+// The default root URL for static photo assets hosted by the Flickr webservers.
+const STATIC_URL string = "https://live.staticflickr.com"
+
+// The default number of pages of API results to fetch concurrently when reading directories.
+const DEFAULT_WORKERS int = 4
+
+// Options is a struct containing configuration details for a new FileSystem.
+type Options struct {
+	// The root URL for static photo assets. If empty STATIC_URL is used.
+	StaticURL string
+	// The number of pages of API results to fetch concurrently when reading directories. If 0 DEFAULT_WORKERS is used.
+	Workers int
+	// Zero or more Flickr API parameters to include with each file uploaded by a WriteFS.
+	UploadArgs *url.Values
+	// If true the Remove method of a WriteFS deletes photos. Otherwise it returns fs.ErrPermission.
+	AllowRemove bool
+}
+
 type apiFS struct {
 	io_fs.FS
-	http_client *http.Client
-	client      client.Client
+	ctx          context.Context
+	http_client  *http.Client
+	client       client.Client
+	static_url   string
+	workers      int
+	writable     bool
+	upload_args  *url.Values
+	allow_remove bool
+	user_mu      *sync.Mutex
+	user_id      string
 }
 
 // MatchesPhotoId returns a boolean value indicating whether 'v' should be treated as a known Flickr photo ID (or URL)
@@ -57,12 +85,57 @@ func DerivePhotoURL(v string) (string, error) {
 
 // New creates a new FileSystem that reads files from the Flickr API.
 func New(ctx context.Context, cl client.Client) io_fs.FS {
+	return NewWithStaticURL(ctx, cl, STATIC_URL)
+}
+
+// NewWithStaticURL creates a new FileSystem that reads files from the Flickr API and fetches static photo assets
+// relative to 'static_url' rather than the default Flickr webservers, for example when testing against a fake server.
+func NewWithStaticURL(ctx context.Context, cl client.Client, static_url string) io_fs.FS {
+
+	opts := &Options{
+		StaticURL: static_url,
+	}
+
+	return NewWithOptions(ctx, cl, opts)
+}
+
+// NewWithOptions creates a new FileSystem that reads files from the Flickr API configured using 'opts'.
+func NewWithOptions(ctx context.Context, cl client.Client, opts *Options) io_fs.FS {
+	return newAPIFS(ctx, cl, opts)
+}
+
+// newAPIFS returns a new (read-only) apiFS instance configured using 'opts'.
+func newAPIFS(ctx context.Context, cl client.Client, opts *Options) *apiFS {
+
+	static_url := opts.StaticURL
+
+	if static_url == "" {
+		static_url = STATIC_URL
+	}
+
+	workers := opts.Workers
+
+	if workers == 0 {
+		workers = DEFAULT_WORKERS
+	}
 
 	http_cl := &http.Client{}
 
+	upload_args := opts.UploadArgs
+
+	if upload_args == nil {
+		upload_args = &url.Values{}
+	}
+
 	fs := &apiFS{
-		http_client: http_cl,
-		client:      cl,
+		ctx:          ctx,
+		http_client:  http_cl,
+		client:       cl,
+		static_url:   static_url,
+		workers:      workers,
+		upload_args:  upload_args,
+		allow_remove: opts.AllowRemove,
+		user_mu:      new(sync.Mutex),
 	}
 
 	return fs
@@ -170,13 +243,13 @@ func (f *apiFS) Open(name string) (io_fs.File, error) {
 		}
 	}
 
-	u, err := url.Parse("https://live.staticflickr.com")
+	u, err := url.Parse(f.static_url)
 
 	if err != nil {
-		return nil, fmt.Errorf("Failed to parse base URL (which is weird), %w", err)
+		return nil, fmt.Errorf("Failed to parse base URL, %w", err)
 	}
 
-	u.Path = path
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
 	url := u.String()
 
 	logger = logger.With("url", url)
@@ -218,13 +291,13 @@ func (f *apiFS) Open(name string) (io_fs.File, error) {
 	// "visibility":{"ispublic":0,"isfriend":0,"isfamily":0}
 
 	fl := &apiFile{
-		name:           u.Path,
+		name:           path,
 		content:        rsp.Body,
 		content_length: int_len,
 		modTime:        t,
 	}
 
-	logger.Debug("Return file", "file name", u.Path, "len", int_len)
+	logger.Debug("Return file", "file name", path, "len", int_len)
 	return fl, nil
 }
 
@@ -301,85 +374,83 @@ func (f *apiFS) ReadDir(name string) ([]io_fs.DirEntry, error) {
 
 	entries := []io_fs.DirEntry{}
 
-	cb := func(ctx context.Context, r io.ReadSeekCloser, err error) error {
-
-		if err != nil {
-			return err
-		}
+	// Remaining pages are fetched concurrently but still returned in page order. Searches are sliced in to date
+	// windows in order to work around the Flickr API's (roughly) 4000 result limit.
 
-		defer r.Close()
+	var photos iter.Seq2[gjson.Result, error]
 
-		body, err := io.ReadAll(r)
+	if args.Get("method") == "flickr.photos.search" {
 
-		if err != nil {
-			return fmt.Errorf("Failed to read API response body, %w", err)
+		opts := &client.SearchOptions{
+			Workers: f.workers,
 		}
 
-		// https://code.flickr.net/2008/08/19/standard-photos-response-apis-for-civilized-age/
-		rsp := gjson.GetBytes(body, "*.photo")
-
-		if !rsp.Exists() {
-			return fmt.Errorf("Failed to derive photos from response")
-		}
+		photos = client.SearchPhotosWithClient(ctx, f.client, &args, opts)
 
-		for _, ph := range rsp.Array() {
+	} else {
 
-			var ph_url *url.URL
+		opts := &client.PaginateOptions{
+			Workers: f.workers,
+		}
 
-			for _, path := range urls {
+		photos = client.ExecuteMethodItemsWithClient(ctx, f.client, &args, opts)
+	}
 
-				url_rsp := ph.Get(path)
+	// https://code.flickr.net/2008/08/19/standard-photos-response-apis-for-civilized-age/
 
-				if !url_rsp.Exists() {
-					logger.Warn("Response is missing extra, skipping", "path", path)
-					continue
-				}
+	for ph, err := range photos {
 
-				url_str := url_rsp.String()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to execute query, %w", err)
+		}
 
-				if url_str == "" {
-					logger.Warn("Response has empty extra property, skipping", "path", path)
-				}
+		var ph_url *url.URL
 
-				v, err := url.Parse(url_str)
+		for _, path := range urls {
 
-				if err != nil {
-					return fmt.Errorf("Failed to parse url_o value (%s), %w", url_rsp.String(), err)
-				}
+			url_rsp := ph.Get(path)
 
-				ph_url = v
-				break
+			if !url_rsp.Exists() {
+				logger.Warn("Response is missing extra, skipping", "path", path)
+				continue
 			}
 
-			if ph_url == nil {
-				return fmt.Errorf("Failed to derive photo URL")
+			url_str := url_rsp.String()
+
+			if url_str == "" {
+				logger.Warn("Response has empty extra property, skipping", "path", path)
 			}
 
-			lastmod_rsp := ph.Get("lastupdate")
-			lastmod := time.Unix(lastmod_rsp.Int(), 0)
+			v, err := url.Parse(url_str)
 
-			fi := &apiFileInfo{
-				name:    fmt.Sprintf("#%s", ph_url.Path),
-				size:    -1,
-				is_spr:  false,
-				modTime: lastmod,
+			if err != nil {
+				return nil, fmt.Errorf("Failed to parse url_o value (%s), %w", url_rsp.String(), err)
 			}
 
-			ent := &apiDirEntry{
-				info: fi,
-			}
+			ph_url = v
+			break
+		}
 
-			logger.Debug("Add entry", "path", fi.name)
-			entries = append(entries, ent)
+		if ph_url == nil {
+			return nil, fmt.Errorf("Failed to derive photo URL")
 		}
 
-		return nil
-	}
+		lastmod_rsp := ph.Get("lastupdate")
+		lastmod := time.Unix(lastmod_rsp.Int(), 0)
 
-	err = client.ExecuteMethodPaginatedWithClient(ctx, f.client, &args, cb)
+		fi := &apiFileInfo{
+			name:    fmt.Sprintf("#%s", f.relativePath(ph_url)),
+			size:    -1,
+			is_spr:  false,
+			modTime: lastmod,
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("Failed to execute query, %w", err)
+		ent := &apiDirEntry{
+			info: fi,
+		}
+
+		logger.Debug("Add entry", "path", fi.name)
+		entries = append(entries, ent)
 	}
 
 	return entries, nil
@@ -389,3 +460,16 @@ func (f *apiFS) ReadDir(name string) ([]io_fs.DirEntry, error) {
 func (f *apiFS) Sub(path string) (io_fs.FS, error) {
 	return nil, fmt.Errorf("Not supported")
 }
+
+// relativePath returns the path of 'u' relative to the path of the filesystem's static URL.
+func (f *apiFS) relativePath(u *url.URL) string {
+
+	static_u, err := url.Parse(f.static_url)
+
+	if err != nil {
+		return u.Path
+	}
+
+	prefix := strings.TrimSuffix(static_u.Path, "/")
+	return strings.TrimPrefix(u.Path, prefix)
+}
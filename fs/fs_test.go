@@ -1,17 +1,23 @@
 package fs
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"image"
-	_ "image/jpeg"
+	"image/jpeg"
 	io_fs "io/fs"
 	"log/slog"
 	"net/url"
+	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
 )
 
 type apiTest struct {
@@ -151,3 +157,350 @@ func TestFS(t *testing.T) {
 		}
 	}
 }
+
+func TestFSWithFakeServer(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	var buf bytes.Buffer
+
+	err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 8)), nil)
+
+	if err != nil {
+		t.Fatalf("Failed to encode image, %v", err)
+	}
+
+	photos := make([]int64, 0)
+
+	for i := 0; i < 3; i++ {
+		ph := svr.Store.AddPhoto(&flickrtest.Photo{Owner: svr.UserId, IsPublic: 1, Body: buf.Bytes()})
+		photos = append(photos, ph.Id)
+	}
+
+	set := svr.Store.AddPhotoset(&flickrtest.Photoset{Owner: svr.UserId, Title: "Test", Photos: photos})
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create new client, %v", err)
+	}
+
+	fs := NewWithStaticURL(ctx, cl, svr.StaticURL())
+
+	fl, err := fs.Open(strconv.FormatInt(photos[0], 10))
+
+	if err != nil {
+		t.Fatalf("Failed to open photo, %v", err)
+	}
+
+	_, _, err = image.Decode(fl)
+
+	if err != nil {
+		t.Fatalf("Failed to decode image, %v", err)
+	}
+
+	fl.Close()
+
+	u := url.Values{}
+	u.Set("method", "flickr.photosets.getPhotos")
+	u.Set("photoset_id", strconv.FormatInt(set.Id, 10))
+	u.Set("user_id", svr.UserId)
+	q := u.Encode()
+
+	count := 0
+
+	walk_func := func(path string, d io_fs.DirEntry, err error) error {
+
+		if err != nil {
+			return err
+		}
+
+		if path == q {
+			return nil
+		}
+
+		r, err := fs.Open(path)
+
+		if err != nil {
+			return fmt.Errorf("Failed to open '%s', %w", path, err)
+		}
+
+		defer r.Close()
+
+		count += 1
+		return nil
+	}
+
+	err = io_fs.WalkDir(fs, q, walk_func)
+
+	if err != nil {
+		t.Fatalf("Failed to walk '%s', %v", q, err)
+	}
+
+	if count != len(photos) {
+		t.Fatalf("Unexpected number of photos, %d", count)
+	}
+}
+
+func TestReadDirSearchWithFakeServer(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	svr.SearchLimit = client.SEARCH_RESULTS_CAP
+
+	count := client.SEARCH_RESULTS_CAP + 12
+
+	for i := 0; i < count; i++ {
+		svr.Store.AddPhoto(&flickrtest.Photo{Owner: svr.UserId, IsPublic: 1, DateUpload: time.Unix(int64(1600000000+i*3600), 0)})
+	}
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create new client, %v", err)
+	}
+
+	fs := NewWithOptions(ctx, cl, &Options{StaticURL: svr.StaticURL(), Workers: 2})
+
+	u := url.Values{}
+	u.Set("method", "flickr.photos.search")
+	u.Set("user_id", svr.UserId)
+
+	entries, err := io_fs.ReadDir(fs, u.Encode())
+
+	if err != nil {
+		t.Fatalf("Failed to read dir, %v", err)
+	}
+
+	if len(entries) != count {
+		t.Fatalf("Unexpected number of entries, %d", len(entries))
+	}
+}
+
+func TestWriteFSWithFakeServer(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create new client, %v", err)
+	}
+
+	upload_args := &url.Values{}
+	upload_args.Set("is_public", "1")
+
+	fs := NewWriteFS(ctx, cl, &Options{StaticURL: svr.StaticURL(), UploadArgs: upload_args})
+
+	// Writing a new file uploads it
+
+	wr, err := fs.Create("cats/kitty.jpg")
+
+	if err != nil {
+		t.Fatalf("Failed to create file, %v", err)
+	}
+
+	_, err = wr.Write([]byte("kitty"))
+
+	if err != nil {
+		t.Fatalf("Failed to write file, %v", err)
+	}
+
+	info, err := wr.Stat()
+
+	if err != nil || info.Size() != 5 {
+		t.Fatalf("Unexpected file info, %v (%v)", info, err)
+	}
+
+	spool := wr.(*apiWritableFile).spool.Name()
+
+	err = wr.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to close file, %v", err)
+	}
+
+	_, err = os.Stat(spool)
+
+	if !errors.Is(err, io_fs.ErrNotExist) {
+		t.Fatalf("Expected temporary file %s to be removed, %v", spool, err)
+	}
+
+	photo_id := wr.PhotoId()
+	str_id := strconv.FormatInt(photo_id, 10)
+
+	ph, exists := svr.Store.GetPhoto(photo_id)
+
+	if !exists || ph.Title != "kitty" || ph.IsPublic != 1 || string(ph.Body) != "kitty" {
+		t.Fatalf("Unexpected uploaded photo, %v", ph)
+	}
+
+	r, err := fs.Open(str_id)
+
+	if err != nil {
+		t.Fatalf("Failed to open photo, %v", err)
+	}
+
+	r.Close()
+
+	// Writing to an existing photo replaces it
+
+	fl, err := fs.OpenFile(str_id, os.O_WRONLY|os.O_TRUNC, 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to open photo for writing, %v", err)
+	}
+
+	_, err = fl.(WritableFile).Write([]byte("kitty, again"))
+
+	if err != nil {
+		t.Fatalf("Failed to write photo, %v", err)
+	}
+
+	err = fl.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to close photo, %v", err)
+	}
+
+	ph, _ = svr.Store.GetPhoto(photo_id)
+
+	if string(ph.Body) != "kitty, again" || len(svr.Store.Photos()) != 1 {
+		t.Fatalf("Photo was not replaced")
+	}
+
+	err = fs.Rename(str_id, "Mittens.jpg")
+
+	if err != nil {
+		t.Fatalf("Failed to rename photo, %v", err)
+	}
+
+	ph, _ = svr.Store.GetPhoto(photo_id)
+
+	if ph.Title != "Mittens" {
+		t.Fatalf("Unexpected title, %s", ph.Title)
+	}
+
+	_, err = fs.OpenFile(str_id, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+
+	if !errors.Is(err, io_fs.ErrExist) {
+		t.Fatalf("Expected exclusive open of existing photo to fail, %v", err)
+	}
+
+	_, err = fs.OpenFile("dog.jpg", os.O_WRONLY, 0644)
+
+	if !errors.Is(err, io_fs.ErrNotExist) {
+		t.Fatalf("Expected open of new file without O_CREATE to fail, %v", err)
+	}
+
+	_, err = fs.OpenFile("dog.jpg", os.O_RDWR|os.O_CREATE, 0644)
+
+	if !errors.Is(err, io_fs.ErrInvalid) {
+		t.Fatalf("Expected read-write open to fail, %v", err)
+	}
+
+	// Numeric file names are only treated as existing photos if they belong to the authenticated user
+
+	wr, err = fs.Create("20240101")
+
+	if err != nil {
+		t.Fatalf("Failed to create numeric file, %v", err)
+	}
+
+	_, err = wr.Write([]byte("new year"))
+
+	if err != nil {
+		t.Fatalf("Failed to write numeric file, %v", err)
+	}
+
+	err = wr.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to close numeric file, %v", err)
+	}
+
+	ph, exists = svr.Store.GetPhoto(wr.PhotoId())
+
+	if !exists || wr.PhotoId() == 20240101 || ph.Title != "20240101" {
+		t.Fatalf("Expected numeric file to be uploaded as a new photo, %v", ph)
+	}
+
+	other := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner:    "12345@N00",
+		Title:    "Not mine",
+		Body:     []byte("not mine"),
+		IsPublic: 1,
+	})
+
+	other_id := strconv.FormatInt(other.Id, 10)
+
+	_, err = fs.OpenFile(other_id, os.O_WRONLY, 0644)
+
+	if !errors.Is(err, io_fs.ErrNotExist) {
+		t.Fatalf("Expected open of another user's photo to be treated as a new file, %v", err)
+	}
+
+	err = fs.Rename(other_id, "Mine.jpg")
+
+	if !errors.Is(err, io_fs.ErrNotExist) {
+		t.Fatalf("Expected rename of another user's photo to fail, %v", err)
+	}
+
+	// Photos are only removed if the AllowRemove option is set
+
+	err = fs.Remove(str_id)
+
+	if !errors.Is(err, io_fs.ErrPermission) {
+		t.Fatalf("Expected remove without AllowRemove to fail, %v", err)
+	}
+
+	fs = NewWriteFS(ctx, cl, &Options{StaticURL: svr.StaticURL(), AllowRemove: true})
+
+	err = fs.Remove(str_id)
+
+	if err != nil {
+		t.Fatalf("Failed to remove photo, %v", err)
+	}
+
+	_, exists = svr.Store.GetPhoto(photo_id)
+
+	if exists {
+		t.Fatalf("Photo was not removed")
+	}
+
+	// Uploads use the context the filesystem was created with
+
+	cancel_ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	wr, err = NewWriteFS(cancel_ctx, cl, &Options{StaticURL: svr.StaticURL()}).Create("cancelled.jpg")
+
+	if err != nil {
+		t.Fatalf("Failed to create file, %v", err)
+	}
+
+	err = wr.Close()
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected close with cancelled context to fail, %v", err)
+	}
+
+	// Filesystems created with New are read-only
+
+	read_only := NewWithStaticURL(ctx, cl, svr.StaticURL()).(WriteFS)
+
+	_, err = read_only.Create("dog.jpg")
+
+	if !errors.Is(err, io_fs.ErrPermission) {
+		t.Fatalf("Expected create on read-only filesystem to fail, %v", err)
+	}
+}
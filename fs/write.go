@@ -0,0 +1,426 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	io_fs "io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+// WritableFile is a file opened for writing by a WriteFS. Data written to the file is uploaded, or replaces an
+// existing photo, when the file is closed.
+type WritableFile interface {
+	io_fs.File
+	io.Writer
+	// PhotoId returns the ID of the photo the file was written to. For new files this is 0 until the file has been closed.
+	PhotoId() int64
+}
+
+// WriteFS is a FileSystem that reads files from, and writes files to, the Flickr API. File names for existing photos
+// take the same form as those passed to the Open method, although numeric file names are only treated as existing
+// photos if there is a photo with that ID belonging to the authenticated user. All other file names are treated as new
+// files and used to derive the default title of the photo when it is uploaded.
+type WriteFS interface {
+	io_fs.FS
+	// Create creates the named file for writing. If the name is an existing photo the photo is replaced when the
+	// file is closed, otherwise a new photo is uploaded.
+	Create(name string) (WritableFile, error)
+	// OpenFile opens the named file with the specified flags (os.O_RDONLY etc.). Files opened for reading are opened
+	// using the Open method. Files opened for writing, which must be write-only, return a WritableFile instance. Existing
+	// photos may only be opened for writing if os.O_EXCL is not set and new files only if os.O_CREATE is set.
+	OpenFile(name string, flag int, perm io_fs.FileMode) (io_fs.File, error)
+	// Remove deletes the named photo.
+	Remove(name string) error
+	// Rename assigns the file name, minus its extension, of 'newname' as the title of the photo 'oldname'.
+	Rename(oldname string, newname string) error
+}
+
+// NewWriteFS creates a new FileSystem that reads files from, and writes files to, the Flickr API configured using 'opts'.
+// 'ctx' is used for all the API calls and uploads made by the FileSystem.
+func NewWriteFS(ctx context.Context, cl client.Client, opts *Options) WriteFS {
+
+	fs := newAPIFS(ctx, cl, opts)
+	fs.writable = true
+
+	return fs
+}
+
+// Create creates the named file for writing. If the name is an existing photo the photo is replaced when the file is
+// closed, otherwise a new photo is uploaded.
+func (f *apiFS) Create(name string) (WritableFile, error) {
+
+	fl, err := f.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fl.(WritableFile), nil
+}
+
+// OpenFile opens the named file with the specified flags (os.O_RDONLY etc.). Files opened for reading are opened
+// using the Open method. Files opened for writing, which must be write-only, return a WritableFile instance. Existing
+// photos may only be opened for writing if os.O_EXCL is not set and new files only if os.O_CREATE is set.
+func (f *apiFS) OpenFile(name string, flag int, perm io_fs.FileMode) (io_fs.File, error) {
+
+	logger := slog.Default()
+	logger = logger.With("name", name)
+
+	switch {
+	case flag&(os.O_WRONLY|os.O_RDWR) == 0:
+		return f.Open(name)
+	case flag&os.O_RDWR != 0, flag&os.O_APPEND != 0:
+		// Photos can only be uploaded or replaced in their entirety
+		return nil, &io_fs.PathError{Op: "open", Path: name, Err: io_fs.ErrInvalid}
+	case !f.writable:
+		return nil, &io_fs.PathError{Op: "open", Path: name, Err: io_fs.ErrPermission}
+	}
+
+	photo_id, is_photo, err := f.photoIdFromName(name)
+
+	if err != nil {
+		return nil, &io_fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	if is_photo && flag&os.O_EXCL != 0 {
+		return nil, &io_fs.PathError{Op: "open", Path: name, Err: io_fs.ErrExist}
+	}
+
+	if !is_photo && flag&os.O_CREATE == 0 {
+		return nil, &io_fs.PathError{Op: "open", Path: name, Err: io_fs.ErrNotExist}
+	}
+
+	// Data is spooled to a temporary file, rather than buffered in memory, so that large files can be written and
+	// then uploaded with a known size when the file is closed.
+
+	tmp, err := os.CreateTemp("", "flickr-fs-*")
+
+	if err != nil {
+		return nil, &io_fs.PathError{Op: "open", Path: name, Err: fmt.Errorf("Failed to create temporary file, %w", err)}
+	}
+
+	logger.Debug("Open file for writing", "photo id", photo_id, "spool", tmp.Name())
+
+	fl := &apiWritableFile{
+		fs:       f,
+		name:     name,
+		photo_id: photo_id,
+		spool:    tmp,
+		perm:     perm,
+		modTime:  time.Now(),
+	}
+
+	return fl, nil
+}
+
+// Remove deletes the named photo, if the FileSystem was created with the AllowRemove option.
+func (f *apiFS) Remove(name string) error {
+
+	if !f.writable || !f.allow_remove {
+		return &io_fs.PathError{Op: "remove", Path: name, Err: io_fs.ErrPermission}
+	}
+
+	photo_id, is_photo, err := f.photoIdFromName(name)
+
+	if err != nil {
+		return &io_fs.PathError{Op: "remove", Path: name, Err: err}
+	}
+
+	if !is_photo {
+		return &io_fs.PathError{Op: "remove", Path: name, Err: io_fs.ErrNotExist}
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.delete")
+	args.Set("photo_id", strconv.FormatInt(photo_id, 10))
+
+	_, err = f.executeMethod(args)
+
+	if err != nil {
+		return &io_fs.PathError{Op: "remove", Path: name, Err: err}
+	}
+
+	return nil
+}
+
+// Rename assigns the file name, minus its extension, of 'newname' as the title of the photo 'oldname'. Photos are
+// not otherwise moved since they have no location other than their ID.
+func (f *apiFS) Rename(oldname string, newname string) error {
+
+	if !f.writable {
+		return &io_fs.PathError{Op: "rename", Path: oldname, Err: io_fs.ErrPermission}
+	}
+
+	photo_id, is_photo, err := f.photoIdFromName(oldname)
+
+	if err != nil {
+		return &io_fs.PathError{Op: "rename", Path: oldname, Err: err}
+	}
+
+	if !is_photo {
+		return &io_fs.PathError{Op: "rename", Path: oldname, Err: io_fs.ErrNotExist}
+	}
+
+	fname := path.Base(newname)
+	title := strings.TrimSuffix(fname, path.Ext(fname))
+
+	if title == "" || title == "." || title == "/" {
+		return &io_fs.PathError{Op: "rename", Path: newname, Err: io_fs.ErrInvalid}
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.setMeta")
+	args.Set("photo_id", strconv.FormatInt(photo_id, 10))
+	args.Set("title", title)
+
+	_, err = f.executeMethod(args)
+
+	if err != nil {
+		return &io_fs.PathError{Op: "rename", Path: oldname, Err: err}
+	}
+
+	return nil
+}
+
+// executeMethod calls the API method defined by 'args' and returns the body of the response if it was successful.
+func (f *apiFS) executeMethod(args *url.Values) ([]byte, error) {
+
+	r, err := f.client.ExecuteMethod(f.ctx, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to execute API method, %w", err)
+	}
+
+	defer r.Close()
+
+	body, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response, %w", err)
+	}
+
+	err = response.CheckMethodStatusBytes(body, args.Get("method"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// photoIdFromName returns the ID of the photo for 'name' and a boolean flag indicating whether 'name' is an existing
+// photo, rather than a new file. Photo URLs are always treated as existing photos but since numeric file names (for
+// example "20240101.jpg" minus its extension) are also valid names for new files they are only treated as existing
+// photos if there is a photo with that ID belonging to the authenticated user. See also MatchesPhotoId.
+func (f *apiFS) photoIdFromName(name string) (int64, bool, error) {
+
+	if !MatchesPhotoId(name) {
+		return 0, false, nil
+	}
+
+	if MatchesPhotoURL(name) {
+
+		photo_url, err := DerivePhotoURL(name)
+
+		if err != nil {
+			return 0, false, nil
+		}
+
+		str_id, _, _ := strings.Cut(path.Base(photo_url), "_")
+
+		photo_id, err := strconv.ParseInt(str_id, 10, 64)
+
+		if err != nil {
+			return 0, false, nil
+		}
+
+		return photo_id, true, nil
+	}
+
+	photo_id, err := strconv.ParseInt(name, 10, 64)
+
+	if err != nil {
+		return 0, false, nil
+	}
+
+	user_id, err := f.userId()
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.getInfo")
+	args.Set("photo_id", name)
+
+	body, err := f.executeMethod(args)
+
+	if errors.Is(err, response.ErrNotFound) {
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, fmt.Errorf("Failed to retrieve info for photo %d, %w", photo_id, err)
+	}
+
+	if gjson.GetBytes(body, "photo.owner.nsid").String() != user_id {
+		return 0, false, nil
+	}
+
+	return photo_id, true, nil
+}
+
+// userId returns the NSID of the user associated with the FileSystem's client, using the flickr.test.login method.
+func (f *apiFS) userId() (string, error) {
+
+	f.user_mu.Lock()
+	defer f.user_mu.Unlock()
+
+	if f.user_id != "" {
+		return f.user_id, nil
+	}
+
+	args := &url.Values{}
+	args.Set("method", "flickr.test.login")
+
+	body, err := f.executeMethod(args)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to determine authenticated user, %w", err)
+	}
+
+	user_id := gjson.GetBytes(body, "user.id").String()
+
+	if user_id == "" {
+		return "", fmt.Errorf("Failed to derive user ID from response")
+	}
+
+	f.user_id = user_id
+	return user_id, nil
+}
+
+type apiWritableFile struct {
+	fs       *apiFS
+	name     string
+	photo_id int64
+	spool    *os.File
+	size     int64
+	perm     io_fs.FileMode
+	modTime  time.Time
+	closed   bool
+}
+
+func (f *apiWritableFile) Stat() (io_fs.FileInfo, error) {
+
+	if f.closed {
+		return nil, io_fs.ErrClosed
+	}
+
+	fi := apiFileInfo{
+		name:    f.name,
+		size:    f.size,
+		modTime: f.modTime,
+		mode:    f.perm,
+	}
+
+	return &fi, nil
+}
+
+func (f *apiWritableFile) Read(b []byte) (int, error) {
+	return 0, &io_fs.PathError{Op: "read", Path: f.name, Err: io_fs.ErrInvalid}
+}
+
+func (f *apiWritableFile) Write(b []byte) (int, error) {
+
+	if f.closed {
+		return 0, io_fs.ErrClosed
+	}
+
+	f.modTime = time.Now()
+
+	n, err := f.spool.Write(b)
+	f.size += int64(n)
+
+	return n, err
+}
+
+func (f *apiWritableFile) PhotoId() int64 {
+	return f.photo_id
+}
+
+// Close uploads the data written to the file as a new photo, or replaces the existing photo, waiting for the
+// upload to complete.
+func (f *apiWritableFile) Close() error {
+
+	if f.closed {
+		return io_fs.ErrClosed
+	}
+
+	f.closed = true
+
+	defer func() {
+		f.spool.Close()
+		os.Remove(f.spool.Name())
+	}()
+
+	ctx := f.fs.ctx
+
+	logger := slog.Default()
+	logger = logger.With("name", f.name, "photo id", f.photo_id)
+
+	_, err := f.spool.Seek(0, io.SeekStart)
+
+	if err != nil {
+		return &io_fs.PathError{Op: "close", Path: f.name, Err: fmt.Errorf("Failed to rewind temporary file, %w", err)}
+	}
+
+	args := &url.Values{}
+
+	body := &client.UploadFile{
+		Reader:   f.spool,
+		FileName: path.Base(f.name),
+		Size:     f.size,
+	}
+
+	if f.photo_id != 0 {
+
+		args.Set("photo_id", strconv.FormatInt(f.photo_id, 10))
+
+		logger.Debug("Replace photo", "size", f.size)
+
+		_, err := client.ReplaceAsyncWithClient(ctx, f.fs.client, body, args)
+
+		if err != nil {
+			return &io_fs.PathError{Op: "close", Path: f.name, Err: fmt.Errorf("Failed to replace photo, %w", err)}
+		}
+
+		return nil
+	}
+
+	for k, v := range *f.fs.upload_args {
+		(*args)[k] = v
+	}
+
+	logger.Debug("Upload photo", "size", f.size)
+
+	photo_id, err := client.UploadAsyncWithClient(ctx, f.fs.client, body, args)
+
+	if err != nil {
+		return &io_fs.PathError{Op: "close", Path: f.name, Err: fmt.Errorf("Failed to upload photo, %w", err)}
+	}
+
+	f.photo_id = photo_id
+	return nil
+}
@@ -0,0 +1,127 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"gocloud.dev/docstore"
+	_ "gocloud.dev/docstore/memdocstore"
+	"gocloud.dev/gcerrors"
+)
+
+func init() {
+
+	ctx := context.Background()
+
+	for _, scheme := range docstore.DefaultURLMux().CollectionSchemes() {
+
+		err := RegisterLedger(ctx, scheme, NewDocstoreLedger)
+
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// DocstoreLedger implements the Ledger interface by storing entries in a gocloud.dev/docstore collection.
+type DocstoreLedger struct {
+	collection *docstore.Collection
+}
+
+// Create a new DocstoreLedger instance conforming to the Ledger interface. DocstoreLedger instances are created by passing
+// in a context.Context instance and a valid gocloud.dev/docstore URI whose primary key is the "path" field. For example:
+// mem://ledger/path?filename=/path/to/ledger.db
+// Only the docstore drivers that have been imported when this package is initialized (by default only "mem://") are
+// registered automatically. Others can be registered using the RegisterLedger method. For example:
+// ledger.RegisterLedger(ctx, "awsdynamodb", ledger.NewDocstoreLedger)
+func NewDocstoreLedger(ctx context.Context, uri string) (Ledger, error) {
+
+	col, err := docstore.OpenCollection(ctx, uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open collection, %w", err)
+	}
+
+	return NewDocstoreLedgerWithCollection(col), nil
+}
+
+// NewDocstoreLedgerWithCollection returns a new DocstoreLedger instance that stores entries in 'col'.
+func NewDocstoreLedgerWithCollection(col *docstore.Collection) *DocstoreLedger {
+
+	l := &DocstoreLedger{
+		collection: col,
+	}
+
+	return l
+}
+
+// Return the Entry for 'path', or ErrNotFound.
+func (l *DocstoreLedger) Get(ctx context.Context, path string) (*Entry, error) {
+
+	e := &Entry{
+		Path: path,
+	}
+
+	err := l.collection.Get(ctx, e)
+
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve entry, %w", err)
+	}
+
+	return e, nil
+}
+
+// Return a completed Entry for 'hash', regardless of its path, or ErrNotFound.
+func (l *DocstoreLedger) GetByHash(ctx context.Context, hash string) (*Entry, error) {
+
+	iter := l.collection.Query().Where("sha256", "=", hash).Get(ctx)
+	defer iter.Stop()
+
+	for {
+
+		var e Entry
+
+		err := iter.Next(ctx, &e)
+
+		if err == io.EOF {
+			return nil, ErrNotFound
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to query entries, %w", err)
+		}
+
+		if e.Status == STATUS_COMPLETE {
+			return &e, nil
+		}
+	}
+}
+
+// Store 'e' in the collection, replacing any previous Entry for the same path.
+func (l *DocstoreLedger) Put(ctx context.Context, e *Entry) error {
+
+	copy_e := *e
+
+	if copy_e.LastModified == 0 {
+		copy_e.LastModified = time.Now().Unix()
+	}
+
+	err := l.collection.Put(ctx, &copy_e)
+
+	if err != nil {
+		return fmt.Errorf("Failed to store entry, %w", err)
+	}
+
+	return nil
+}
+
+// Close the underlying collection.
+func (l *DocstoreLedger) Close() error {
+	return l.collection.Close()
+}
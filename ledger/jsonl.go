@@ -0,0 +1,281 @@
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+func init() {
+
+	ctx := context.Background()
+	err := RegisterLedger(ctx, "jsonl", NewJSONLLedger)
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// JSONLLedger implements the Ledger interface by appending entries, one per line, to a local JSONL file.
+// When a file is (re)opened the last entry for each path wins.
+type JSONLLedger struct {
+	mu      sync.Mutex
+	fh      *os.File
+	entries map[string]*Entry
+	hashes  map[string]*Entry
+}
+
+// Create a new JSONLLedger instance conforming to the Ledger interface. JSONLLedger instances are created by passing
+// in a context.Context instance and a URI string in the form of:
+// jsonl://{PATH}
+// Where {PATH} is the path to the JSONL file to read and append entries to, which will be created if it does not exist.
+// Relative paths are resolved against the current working directory, for example "jsonl://ledger.jsonl".
+func NewJSONLLedger(ctx context.Context, uri string) (Ledger, error) {
+
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	path := u.Host + u.Path
+
+	if path == "" {
+		return nil, fmt.Errorf("Missing ledger path")
+	}
+
+	entries := make(map[string]*Entry)
+
+	size, err := readJSONL(path, entries)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fh, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0644)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open ledger, %w", err)
+	}
+
+	err = ensureValidSize(fh, size)
+
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+
+	hashes := make(map[string]*Entry)
+
+	for _, e := range entries {
+		indexHash(hashes, e)
+	}
+
+	l := &JSONLLedger{
+		fh:      fh,
+		entries: entries,
+		hashes:  hashes,
+	}
+
+	return l, nil
+}
+
+// Return the most recent Entry for 'path', or ErrNotFound.
+func (l *JSONLLedger) Get(ctx context.Context, path string) (*Entry, error) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, exists := l.entries[path]
+
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	copy_e := *e
+	return &copy_e, nil
+}
+
+// Return a completed Entry for 'hash', regardless of its path, or ErrNotFound.
+func (l *JSONLLedger) GetByHash(ctx context.Context, hash string) (*Entry, error) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, exists := l.hashes[hash]
+
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	copy_e := *e
+	return &copy_e, nil
+}
+
+// Append 'e' to the ledger file.
+func (l *JSONLLedger) Put(ctx context.Context, e *Entry) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	copy_e := *e
+
+	if copy_e.LastModified == 0 {
+		copy_e.LastModified = time.Now().Unix()
+	}
+
+	body, err := json.Marshal(copy_e)
+
+	if err != nil {
+		return fmt.Errorf("Failed to marshal entry, %w", err)
+	}
+
+	body = append(body, '\n')
+
+	_, err = l.fh.Write(body)
+
+	if err != nil {
+		return fmt.Errorf("Failed to write entry, %w", err)
+	}
+
+	l.entries[copy_e.Path] = &copy_e
+	indexHash(l.hashes, &copy_e)
+
+	return nil
+}
+
+// Close the ledger file.
+func (l *JSONLLedger) Close() error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.fh.Close()
+}
+
+// readJSONL reads the entries in 'path', if it exists, in to 'entries' and returns the size, in bytes, of the
+// complete entries in the file. A crash while writing an entry may leave a truncated final line which is ignored.
+// Invalid lines anywhere else are treated as errors.
+func readJSONL(path string, entries map[string]*Entry) (int64, error) {
+
+	fh, err := os.Open(path)
+
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("Failed to open %s, %w", path, err)
+	}
+
+	defer fh.Close()
+
+	br := bufio.NewReader(fh)
+
+	size := int64(0)
+	lineno := 0
+
+	for {
+
+		line, read_err := br.ReadBytes('\n')
+
+		if read_err != nil && read_err != io.EOF {
+			return 0, fmt.Errorf("Failed to read %s, %w", path, read_err)
+		}
+
+		if len(line) == 0 {
+			break
+		}
+
+		lineno += 1
+
+		is_final := read_err == io.EOF
+
+		if len(bytes.TrimSpace(line)) > 0 {
+
+			var e *Entry
+
+			err := json.Unmarshal(line, &e)
+
+			if err != nil {
+
+				if is_final {
+					break
+				}
+
+				return 0, fmt.Errorf("Failed to unmarshal %s at line %d, %w", path, lineno, err)
+			}
+
+			entries[e.Path] = e
+		}
+
+		size += int64(len(line))
+
+		if is_final {
+			break
+		}
+	}
+
+	return size, nil
+}
+
+// ensureValidSize truncates 'fh' to 'size' bytes, removing any incomplete final entry, and ensures that the
+// file ends in a newline so that new entries are written on their own line.
+func ensureValidSize(fh *os.File, size int64) error {
+
+	info, err := fh.Stat()
+
+	if err != nil {
+		return fmt.Errorf("Failed to stat ledger, %w", err)
+	}
+
+	if info.Size() > size {
+
+		err := fh.Truncate(size)
+
+		if err != nil {
+			return fmt.Errorf("Failed to truncate ledger, %w", err)
+		}
+	}
+
+	if size == 0 {
+		return nil
+	}
+
+	last := make([]byte, 1)
+
+	_, err = fh.ReadAt(last, size-1)
+
+	if err != nil {
+		return fmt.Errorf("Failed to read ledger, %w", err)
+	}
+
+	if last[0] == '\n' {
+		return nil
+	}
+
+	_, err = fh.Write([]byte("\n"))
+
+	if err != nil {
+		return fmt.Errorf("Failed to write ledger, %w", err)
+	}
+
+	return nil
+}
+
+// indexHash adds 'e' to 'hashes' if it has completed successfully.
+func indexHash(hashes map[string]*Entry, e *Entry) {
+
+	if e.Status != STATUS_COMPLETE || e.Hash == "" {
+		return
+	}
+
+	hashes[e.Hash] = e
+}
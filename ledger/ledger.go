@@ -0,0 +1,145 @@
+// package ledger provides interfaces for persistently recording the status of uploads so that batch uploads can be
+// resumed, skipping files that have already been uploaded and waiting on outstanding asynchronous upload tickets,
+// rather than uploading them a second time.
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aaronland/go-roster"
+)
+
+// The status of an upload that has been sent to the Flickr API but whose (asynchronous upload) ticket has not completed yet.
+const STATUS_PENDING string = "pending"
+
+// The status of an upload that has completed successfully.
+const STATUS_COMPLETE string = "complete"
+
+// The status of an upload that failed.
+const STATUS_FAILED string = "failed"
+
+// ErrNotFound is returned by the Ledger.Get and Ledger.GetByHash methods if there is no matching Entry.
+var ErrNotFound = errors.New("Entry not found")
+
+// Entry is a struct containing the details of a single upload.
+type Entry struct {
+	// The URI of the file that was uploaded.
+	Path string `json:"path" docstore:"path"`
+	// The (hex-encoded) SHA-256 hash of the body of the file that was uploaded.
+	Hash string `json:"sha256" docstore:"sha256"`
+	// The ID of the asynchronous upload ticket assigned to the upload.
+	TicketId string `json:"ticketid,omitempty" docstore:"ticketid"`
+	// The photo ID assigned to a successful upload.
+	PhotoId int64 `json:"photoid,omitempty" docstore:"photoid"`
+	// The status of the upload. One of STATUS_PENDING, STATUS_COMPLETE or STATUS_FAILED.
+	Status string `json:"status" docstore:"status"`
+	// The error message for failed uploads.
+	Error string `json:"error,omitempty" docstore:"error"`
+	// The Unix timestamp when the entry was last updated.
+	LastModified int64 `json:"lastmodified" docstore:"lastmodified"`
+}
+
+// Ledger is the interface that defines common methods for persistently recording the status of uploads.
+// Implementations must be safe to use from multiple goroutines.
+type Ledger interface {
+	// Return the most recent Entry for a path, or ErrNotFound.
+	Get(context.Context, string) (*Entry, error)
+	// Return an Entry with a STATUS_COMPLETE status for a (hex-encoded) SHA-256 hash, regardless of its path, or ErrNotFound.
+	GetByHash(context.Context, string) (*Entry, error)
+	// Record an Entry, replacing any previous Entry for the same path.
+	Put(context.Context, *Entry) error
+	// Close the Ledger.
+	Close() error
+}
+
+var ledgers roster.Roster
+
+// The initialization function signature for implementation of the Ledger interface.
+type LedgerInitializeFunc func(context.Context, string) (Ledger, error)
+
+// Ensure that the internal roster.Roster instance has been created successfully.
+func ensureLedgerRoster() error {
+
+	if ledgers == nil {
+
+		r, err := roster.NewDefaultRoster()
+
+		if err != nil {
+			return err
+		}
+
+		ledgers = r
+	}
+
+	return nil
+}
+
+// Register a new URI scheme and LedgerInitializeFunc function for a implementation of the Ledger interface.
+func RegisterLedger(ctx context.Context, scheme string, f LedgerInitializeFunc) error {
+
+	err := ensureLedgerRoster()
+
+	if err != nil {
+		return err
+	}
+
+	return ledgers.Register(ctx, scheme, f)
+}
+
+// Return a list of URI schemes for registered implementations of the Ledger interface.
+func Schemes() []string {
+
+	ctx := context.Background()
+	schemes := []string{}
+
+	err := ensureLedgerRoster()
+
+	if err != nil {
+		return schemes
+	}
+
+	for _, dr := range ledgers.Drivers(ctx) {
+		scheme := fmt.Sprintf("%s://", strings.ToLower(dr))
+		schemes = append(schemes, scheme)
+	}
+
+	sort.Strings(schemes)
+	return schemes
+}
+
+// Create a new instance of the Ledger interface. Ledger instances are created by passing in a context.Context
+// instance and a URI string. The form and substance of URI strings are specific to their implementations. For
+// example to create a JSONLLedger you would write:
+// l, err := ledger.NewLedger(ctx, "jsonl:///path/to/ledger.jsonl")
+func NewLedger(ctx context.Context, uri string) (Ledger, error) {
+
+	uri = strings.TrimSpace(uri)
+
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := u.Scheme
+
+	err = ensureLedgerRoster()
+
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := ledgers.Driver(ctx, scheme)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f := i.(LedgerInitializeFunc)
+	return f(ctx, uri)
+}
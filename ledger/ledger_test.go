@@ -0,0 +1,163 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJSONLLedger(t *testing.T) {
+
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "ledger.jsonl")
+
+	l, err := NewLedger(ctx, "jsonl://"+path)
+
+	if err != nil {
+		t.Fatalf("Failed to create ledger, %v", err)
+	}
+
+	err = testLedger(ctx, l)
+
+	if err != nil {
+		t.Fatalf("Ledger test failed, %v", err)
+	}
+
+	err = l.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to close ledger, %v", err)
+	}
+
+	// Simulate a crash while writing an entry.
+
+	fh, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to open ledger, %v", err)
+	}
+
+	fh.Write([]byte(`{"path":"b.jpg","sha2`))
+	fh.Close()
+
+	l, err = NewLedger(ctx, "jsonl://"+path)
+
+	if err != nil {
+		t.Fatalf("Failed to reopen ledger, %v", err)
+	}
+
+	defer l.Close()
+
+	e, err := l.Get(ctx, "a.jpg")
+
+	if err != nil {
+		t.Fatalf("Failed to get entry after reopening ledger, %v", err)
+	}
+
+	if e.Status != STATUS_COMPLETE || e.PhotoId != 123 {
+		t.Fatalf("Unexpected entry after reopening ledger, %v", e)
+	}
+
+	err = l.Put(ctx, &Entry{Path: "b.jpg", Hash: "def", Status: STATUS_FAILED})
+
+	if err != nil {
+		t.Fatalf("Failed to put entry after reopening ledger, %v", err)
+	}
+
+	l.Close()
+
+	l, err = NewLedger(ctx, "jsonl://"+path)
+
+	if err != nil {
+		t.Fatalf("Failed to reopen ledger a second time, %v", err)
+	}
+
+	defer l.Close()
+
+	e, err = l.Get(ctx, "b.jpg")
+
+	if err != nil || e.Status != STATUS_FAILED {
+		t.Fatalf("Unexpected entry appended after truncated line, %v %v", e, err)
+	}
+}
+
+func TestDocstoreLedger(t *testing.T) {
+
+	ctx := context.Background()
+
+	l, err := NewLedger(ctx, "mem://ledger/path")
+
+	if err != nil {
+		t.Fatalf("Failed to create ledger, %v", err)
+	}
+
+	defer l.Close()
+
+	err = testLedger(ctx, l)
+
+	if err != nil {
+		t.Fatalf("Ledger test failed, %v", err)
+	}
+}
+
+func testLedger(ctx context.Context, l Ledger) error {
+
+	_, err := l.Get(ctx, "a.jpg")
+
+	if !errors.Is(err, ErrNotFound) {
+		return errors.New("Expected not found error")
+	}
+
+	err = l.Put(ctx, &Entry{Path: "a.jpg", Hash: "abc", TicketId: "1", Status: STATUS_PENDING})
+
+	if err != nil {
+		return err
+	}
+
+	err = l.Put(ctx, &Entry{Path: "a.jpg", Hash: "abc", TicketId: "1", PhotoId: 123, Status: STATUS_COMPLETE})
+
+	if err != nil {
+		return err
+	}
+
+	e, err := l.Get(ctx, "a.jpg")
+
+	if err != nil {
+		return err
+	}
+
+	if e.Status != STATUS_COMPLETE || e.PhotoId != 123 || e.TicketId != "1" {
+		return errors.New("Unexpected entry")
+	}
+
+	if e.LastModified == 0 {
+		return errors.New("Expected last modified time to be assigned")
+	}
+
+	err = l.Put(ctx, &Entry{Path: "b.jpg", Hash: "def", Status: STATUS_FAILED})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = l.GetByHash(ctx, "def")
+
+	if !errors.Is(err, ErrNotFound) {
+		return errors.New("Expected not found error for failed upload")
+	}
+
+	e, err = l.GetByHash(ctx, "abc")
+
+	if err != nil {
+		return err
+	}
+
+	if e.Path != "a.jpg" || e.PhotoId != 123 {
+		return errors.New("Unexpected entry for hash")
+	}
+
+	return nil
+}
@@ -0,0 +1,365 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// EXIF (TIFF) tags used to derive metadata.
+const (
+	exif_tag_image_description    uint16 = 0x010E
+	exif_tag_make                 uint16 = 0x010F
+	exif_tag_model                uint16 = 0x0110
+	exif_tag_datetime             uint16 = 0x0132
+	exif_tag_artist               uint16 = 0x013B
+	exif_tag_copyright            uint16 = 0x8298
+	exif_tag_exif_ifd             uint16 = 0x8769
+	exif_tag_gps_ifd              uint16 = 0x8825
+	exif_tag_xp_title             uint16 = 0x9C9B
+	exif_tag_xp_keywords          uint16 = 0x9C9E
+	exif_tag_datetime_original    uint16 = 0x9003
+	exif_tag_datetime_digitized   uint16 = 0x9004
+	exif_tag_offset_time_original uint16 = 0x9011
+	exif_tag_gps_latitude_ref     uint16 = 0x0001
+	exif_tag_gps_latitude         uint16 = 0x0002
+	exif_tag_gps_longitude_ref    uint16 = 0x0003
+	exif_tag_gps_longitude        uint16 = 0x0004
+)
+
+// The size, in bytes, of each of the TIFF field types.
+var tiff_type_sizes = map[uint16]int{
+	1:  1, // BYTE
+	2:  1, // ASCII
+	3:  2, // SHORT
+	4:  4, // LONG
+	5:  8, // RATIONAL
+	6:  1, // SBYTE
+	7:  1, // UNDEFINED
+	8:  2, // SSHORT
+	9:  4, // SLONG
+	10: 8, // SRATIONAL
+	11: 4, // FLOAT
+	12: 8, // DOUBLE
+}
+
+// The layout of EXIF date strings.
+const exif_date_layout string = "2006:01:02 15:04:05"
+
+// tiffEntry is a single entry in a TIFF image file directory.
+type tiffEntry struct {
+	order binary.ByteOrder
+	type_ uint16
+	count int
+	value []byte
+}
+
+// parseEXIF returns the metadata derived from the EXIF (TIFF) data read from 'r' whose size is 'size'.
+func parseEXIF(r io.ReaderAt, size int64) (*Metadata, error) {
+
+	if size < 8 {
+		return nil, fmt.Errorf("Invalid TIFF header")
+	}
+
+	header := make([]byte, 8)
+
+	_, err := r.ReadAt(header, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read TIFF header, %w", err)
+	}
+
+	var order binary.ByteOrder
+
+	switch string(header[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("Invalid TIFF byte order")
+	}
+
+	ifd0, err := readIFD(r, size, order, order.Uint32(header[4:8]))
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read IFD0, %w", err)
+	}
+
+	md := &Metadata{
+		Description: ifd0.string(exif_tag_image_description),
+		Make:        ifd0.string(exif_tag_make),
+		Model:       ifd0.string(exif_tag_model),
+		Artist:      ifd0.string(exif_tag_artist),
+		Copyright:   ifd0.string(exif_tag_copyright),
+		Title:       ifd0.ucs2(exif_tag_xp_title),
+	}
+
+	xp_keywords := ifd0.ucs2(exif_tag_xp_keywords)
+
+	if xp_keywords != "" {
+		md.Keywords = splitKeywords(xp_keywords, ";")
+	}
+
+	date_taken := ifd0.string(exif_tag_datetime)
+	offset_taken := ""
+
+	if e, exists := ifd0[exif_tag_exif_ifd]; exists {
+
+		exif_ifd, err := readIFD(r, size, order, e.uint())
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read EXIF IFD, %w", err)
+		}
+
+		for _, tag := range []uint16{exif_tag_datetime_digitized, exif_tag_datetime_original} {
+
+			if v := exif_ifd.string(tag); v != "" {
+				date_taken = v
+			}
+		}
+
+		offset_taken = exif_ifd.string(exif_tag_offset_time_original)
+	}
+
+	if date_taken != "" {
+
+		t, err := parseEXIFDate(date_taken, offset_taken)
+
+		// Cameras frequently write empty or placeholder dates (for example "0000:00:00 00:00:00") which are ignored
+
+		if err == nil {
+			md.DateTaken = t
+		}
+	}
+
+	if e, exists := ifd0[exif_tag_gps_ifd]; exists {
+
+		gps_ifd, err := readIFD(r, size, order, e.uint())
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read GPS IFD, %w", err)
+		}
+
+		lat, lat_ok := gps_ifd.coordinate(exif_tag_gps_latitude, exif_tag_gps_latitude_ref, "S")
+		lon, lon_ok := gps_ifd.coordinate(exif_tag_gps_longitude, exif_tag_gps_longitude_ref, "W")
+
+		if lat_ok && lon_ok && isValidLocation(lat, lon) {
+			md.HasLocation = true
+			md.Latitude = lat
+			md.Longitude = lon
+		}
+	}
+
+	return md, nil
+}
+
+// tiffIFD is a TIFF image file directory, keyed by tag.
+type tiffIFD map[uint16]*tiffEntry
+
+// readIFD reads the image file directory at 'offset' in the TIFF data read from 'r' whose size is 'size'.
+func readIFD(r io.ReaderAt, size int64, order binary.ByteOrder, offset uint32) (tiffIFD, error) {
+
+	if int64(offset)+2 > size {
+		return nil, fmt.Errorf("Invalid IFD offset %d", offset)
+	}
+
+	count_b := make([]byte, 2)
+
+	_, err := r.ReadAt(count_b, int64(offset))
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read IFD entry count, %w", err)
+	}
+
+	count := int(order.Uint16(count_b))
+	start := int64(offset) + 2
+
+	if start+int64(count)*12 > size {
+		return nil, fmt.Errorf("Invalid IFD entry count %d", count)
+	}
+
+	entries := make([]byte, count*12)
+
+	_, err = r.ReadAt(entries, start)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read IFD entries, %w", err)
+	}
+
+	ifd := make(tiffIFD)
+
+	for i := 0; i < count; i++ {
+
+		raw := entries[i*12 : (i+1)*12]
+
+		tag := order.Uint16(raw[0:2])
+		type_ := order.Uint16(raw[2:4])
+		n := int(order.Uint32(raw[4:8]))
+
+		type_size, known := tiff_type_sizes[type_]
+
+		if !known {
+			continue
+		}
+
+		length := type_size * n
+
+		var value []byte
+
+		if length <= 4 {
+			value = raw[8 : 8+length]
+		} else {
+
+			value_offset := int64(order.Uint32(raw[8:12]))
+
+			if value_offset+int64(length) > size {
+				return nil, fmt.Errorf("Invalid offset for tag %#04x", tag)
+			}
+
+			value = make([]byte, length)
+
+			_, err := r.ReadAt(value, value_offset)
+
+			if err != nil {
+				return nil, fmt.Errorf("Failed to read value for tag %#04x, %w", tag, err)
+			}
+		}
+
+		ifd[tag] = &tiffEntry{
+			order: order,
+			type_: type_,
+			count: n,
+			value: value,
+		}
+	}
+
+	return ifd, nil
+}
+
+// string returns the trimmed ASCII value for 'tag', or an empty string.
+func (ifd tiffIFD) string(tag uint16) string {
+
+	e, exists := ifd[tag]
+
+	if !exists || e.type_ != 2 {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimRight(string(e.value), "\x00"))
+}
+
+// ucs2 returns the value for the Windows "XP" tag 'tag', which is encoded as little-endian UCS-2, or an empty string.
+func (ifd tiffIFD) ucs2(tag uint16) string {
+
+	e, exists := ifd[tag]
+
+	if !exists {
+		return ""
+	}
+
+	units := make([]uint16, 0, len(e.value)/2)
+
+	for i := 0; i+1 < len(e.value); i += 2 {
+
+		u := binary.LittleEndian.Uint16(e.value[i:])
+
+		if u == 0 {
+			break
+		}
+
+		units = append(units, u)
+	}
+
+	return strings.TrimSpace(string(utf16.Decode(units)))
+}
+
+// coordinate returns the decimal degrees for the GPS degrees/minutes/seconds tag 'tag', negated if the value of the
+// reference tag 'ref_tag' is 'negative_ref'.
+func (ifd tiffIFD) coordinate(tag uint16, ref_tag uint16, negative_ref string) (float64, bool) {
+
+	e, exists := ifd[tag]
+
+	if !exists {
+		return 0, false
+	}
+
+	dms := e.rationals()
+
+	if len(dms) != 3 {
+		return 0, false
+	}
+
+	deg := dms[0] + dms[1]/60 + dms[2]/3600
+
+	if strings.EqualFold(ifd.string(ref_tag), negative_ref) {
+		deg = -deg
+	}
+
+	return deg, true
+}
+
+// uint returns the first value for an entry of type SHORT or LONG.
+func (e *tiffEntry) uint() uint32 {
+
+	switch {
+	case e.type_ == 3 && len(e.value) >= 2:
+		return uint32(e.order.Uint16(e.value))
+	case e.type_ == 4 && len(e.value) >= 4:
+		return e.order.Uint32(e.value)
+	default:
+		return 0
+	}
+}
+
+// rationals returns the values for an entry of type RATIONAL.
+func (e *tiffEntry) rationals() []float64 {
+
+	if e.type_ != 5 {
+		return nil
+	}
+
+	values := make([]float64, e.count)
+
+	for i := 0; i < e.count; i++ {
+
+		num := e.order.Uint32(e.value[i*8:])
+		denom := e.order.Uint32(e.value[i*8+4:])
+
+		if denom == 0 {
+			return nil
+		}
+
+		values[i] = float64(num) / float64(denom)
+	}
+
+	return values
+}
+
+// parseEXIFDate parses the EXIF date string 'date' and the optional time zone offset (for example "-07:00") 'offset'.
+func parseEXIFDate(date string, offset string) (time.Time, error) {
+
+	if offset == "" {
+		return time.Parse(exif_date_layout, date)
+	}
+
+	return time.Parse(exif_date_layout+"-07:00", date+offset)
+}
+
+// isValidLocation returns a boolean value indicating whether 'lat' and 'lon' are valid (and not null island) coordinates.
+func isValidLocation(lat float64, lon float64) bool {
+
+	if math.IsNaN(lat) || math.IsNaN(lon) {
+		return false
+	}
+
+	if lat == 0 && lon == 0 {
+		return false
+	}
+
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
@@ -0,0 +1,173 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IPTC-IIM (record 2) datasets used to derive metadata.
+const (
+	iptc_object_name  byte = 5
+	iptc_keywords     byte = 25
+	iptc_date_created byte = 55
+	iptc_time_created byte = 60
+	iptc_byline       byte = 80
+	iptc_headline     byte = 105
+	iptc_caption      byte = 120
+	iptc_copyright    byte = 116
+)
+
+// The ID of the Photoshop image resource containing IPTC-IIM data.
+const photoshop_iptc_resource uint16 = 0x0404
+
+// readIPTCResource returns the IPTC-IIM data in the Photoshop image resources 'data', or nil if there is none.
+func readIPTCResource(data []byte) ([]byte, error) {
+
+	offset := 0
+
+	for offset+12 <= len(data) {
+
+		if !bytes.Equal(data[offset:offset+4], []byte("8BIM")) {
+			return nil, fmt.Errorf("Invalid image resource at offset %d", offset)
+		}
+
+		id := binary.BigEndian.Uint16(data[offset+4:])
+
+		// The resource name is a Pascal string padded to an even length
+
+		name_length := int(data[offset+6]) + 1
+
+		if name_length%2 != 0 {
+			name_length += 1
+		}
+
+		size_offset := offset + 6 + name_length
+
+		if size_offset+4 > len(data) {
+			return nil, fmt.Errorf("Invalid image resource name at offset %d", offset)
+		}
+
+		size := int(binary.BigEndian.Uint32(data[size_offset:]))
+		start := size_offset + 4
+
+		if start+size > len(data) {
+			return nil, fmt.Errorf("Invalid image resource size at offset %d", offset)
+		}
+
+		if id == photoshop_iptc_resource {
+			return data[start : start+size], nil
+		}
+
+		offset = start + size
+
+		if size%2 != 0 {
+			offset += 1
+		}
+	}
+
+	return nil, nil
+}
+
+// parseIPTC returns the metadata derived from the IPTC-IIM data in 'data'. Values are assumed to be encoded as UTF-8.
+func parseIPTC(data []byte) (*Metadata, error) {
+
+	md := &Metadata{}
+
+	var headline string
+	var date_created string
+	var time_created string
+
+	offset := 0
+
+	for offset+5 <= len(data) {
+
+		if data[offset] != 0x1C {
+			return nil, fmt.Errorf("Invalid dataset marker at offset %d", offset)
+		}
+
+		record := data[offset+1]
+		dataset := data[offset+2]
+		length := int(binary.BigEndian.Uint16(data[offset+3:]))
+		start := offset + 5
+
+		// Extended datasets store the number of bytes used to encode their length in the lower 15 bits
+
+		if length&0x8000 != 0 {
+
+			n := length & 0x7FFF
+
+			if n > 4 || start+n > len(data) {
+				return nil, fmt.Errorf("Invalid extended dataset length at offset %d", offset)
+			}
+
+			length = 0
+
+			for _, b := range data[start : start+n] {
+				length = length<<8 | int(b)
+			}
+
+			start += n
+		}
+
+		if start+length > len(data) {
+			return nil, fmt.Errorf("Invalid dataset length at offset %d", offset)
+		}
+
+		value := strings.TrimSpace(strings.TrimRight(string(data[start:start+length]), "\x00"))
+		offset = start + length
+
+		if record != 2 || value == "" {
+			continue
+		}
+
+		switch dataset {
+		case iptc_object_name:
+			md.Title = value
+		case iptc_headline:
+			headline = value
+		case iptc_caption:
+			md.Description = value
+		case iptc_keywords:
+			md.Keywords = append(md.Keywords, value)
+		case iptc_byline:
+			md.Artist = value
+		case iptc_copyright:
+			md.Copyright = value
+		case iptc_date_created:
+			date_created = value
+		case iptc_time_created:
+			time_created = value
+		}
+	}
+
+	if md.Title == "" {
+		md.Title = headline
+	}
+
+	if date_created != "" {
+
+		t, err := parseIPTCDate(date_created, time_created)
+
+		if err == nil {
+			md.DateTaken = t
+		}
+	}
+
+	return md, nil
+}
+
+// parseIPTCDate parses the IPTC-IIM date (CCYYMMDD) 'date' and optional time (HHMMSS±HHMM) 'tm'.
+func parseIPTCDate(date string, tm string) (time.Time, error) {
+
+	switch len(tm) {
+	case 0:
+		return time.Parse("20060102", date)
+	case 6:
+		return time.Parse("20060102150405", date+tm)
+	default:
+		return time.Parse("20060102150405-0700", date+tm)
+	}
+}
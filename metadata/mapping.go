@@ -0,0 +1,226 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// The layout used to format dates passed to the Flickr API.
+const FLICKR_DATE_LAYOUT string = "2006-01-02 15:04:05"
+
+// Mapping is a struct containing the rules for mapping Metadata to Flickr API parameters. Each rule is a Go
+// text/template string that is executed against a Metadata instance. In addition to the builtin template functions
+// the following functions are available:
+// `tags` formats a list of strings as a space-separated list of Flickr tags, quoting tags that contain spaces;
+// `date` formats a time.Time using a Go time layout, returning an empty string if the time is zero;
+// `join` joins a list of strings with a separator.
+type Mapping struct {
+	// A dictionary of rules for deriving upload parameters, keyed by parameter name. Parameters whose rule yields an
+	// empty string are omitted.
+	Params map[string]string `json:"params"`
+	// A dictionary of API methods to call once a file has been uploaded, keyed by method name, where each value is a
+	// dictionary of rules for deriving that method's parameters. The "photo_id" parameter is assigned automatically.
+	// Methods are only called if every one of their rules yields a non-empty string.
+	Methods map[string]map[string]string `json:"methods"`
+}
+
+// DefaultMapping returns a Mapping that assigns the title, description and tags of an upload from the Title, Description
+// and Keywords properties of a Metadata instance and then calls the flickr.photos.geo.setLocation and flickr.photos.setDates
+// methods to assign the location and date taken, which the upload API does not accept.
+func DefaultMapping() *Mapping {
+
+	m := &Mapping{
+		Params: map[string]string{
+			"title":       "{{ .Title }}",
+			"description": "{{ .Description }}",
+			"tags":        "{{ tags .Keywords }}",
+		},
+		Methods: map[string]map[string]string{
+			"flickr.photos.geo.setLocation": {
+				"lat": `{{ if .HasLocation }}{{ printf "%.6f" .Latitude }}{{ end }}`,
+				"lon": `{{ if .HasLocation }}{{ printf "%.6f" .Longitude }}{{ end }}`,
+			},
+			"flickr.photos.setDates": {
+				"date_taken": `{{ date .DateTaken "` + FLICKR_DATE_LAYOUT + `" }}`,
+			},
+		},
+	}
+
+	return m
+}
+
+// ReadMapping returns a new Mapping instance derived from the JSON-encoded body of 'r'.
+func ReadMapping(r io.Reader) (*Mapping, error) {
+
+	var m *Mapping
+
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&m)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode mapping, %w", err)
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("Empty mapping")
+	}
+
+	// Parse all the rules up front so that errors are reported before any files are uploaded
+
+	_, err = m.UploadArgs(&Metadata{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.MethodArgs(&Metadata{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UploadArgs returns the upload parameters derived from 'md'.
+func (m *Mapping) UploadArgs(md *Metadata) (*url.Values, error) {
+
+	args, err := renderRules(m.Params, md)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := &url.Values{}
+
+	for k, v := range args {
+
+		if v != "" {
+			params.Set(k, v)
+		}
+	}
+
+	return params, nil
+}
+
+// MethodArgs returns the parameters, including "method", for each of the API methods to call once a file has been
+// uploaded, sorted by method name. Methods with rules that yield an empty string are excluded. It is the caller's
+// responsibility to assign the "photo_id" parameter.
+func (m *Mapping) MethodArgs(md *Metadata) ([]*url.Values, error) {
+
+	methods := make([]string, 0, len(m.Methods))
+
+	for method := range m.Methods {
+		methods = append(methods, method)
+	}
+
+	sort.Strings(methods)
+
+	method_args := make([]*url.Values, 0)
+
+	for _, method := range methods {
+
+		args, err := renderRules(m.Methods[method], md)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to derive parameters for %s, %w", method, err)
+		}
+
+		params := &url.Values{}
+		params.Set("method", method)
+
+		complete := true
+
+		for k, v := range args {
+
+			if v == "" {
+				complete = false
+				break
+			}
+
+			params.Set(k, v)
+		}
+
+		if complete {
+			method_args = append(method_args, params)
+		}
+	}
+
+	return method_args, nil
+}
+
+// renderRules executes each of the templates in 'rules' against 'md' and returns the (trimmed) results.
+func renderRules(rules map[string]string, md *Metadata) (map[string]string, error) {
+
+	results := make(map[string]string)
+
+	for k, rule := range rules {
+
+		t, err := template.New(k).Funcs(template_funcs).Parse(rule)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse rule for '%s', %w", k, err)
+		}
+
+		var buf bytes.Buffer
+
+		err = t.Execute(&buf, md)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to execute rule for '%s', %w", k, err)
+		}
+
+		results[k] = strings.TrimSpace(buf.String())
+	}
+
+	return results, nil
+}
+
+// The functions available to mapping rules.
+var template_funcs = template.FuncMap{
+	"tags": formatTags,
+	"date": formatDate,
+	"join": strings.Join,
+}
+
+// formatTags returns 'tags' as a space-separated list of Flickr tags, quoting tags that contain spaces.
+func formatTags(tags []string) string {
+
+	formatted := make([]string, 0, len(tags))
+
+	for _, t := range tags {
+
+		t = strings.TrimSpace(strings.ReplaceAll(t, `"`, ""))
+
+		if t == "" {
+			continue
+		}
+
+		if strings.Contains(t, " ") {
+			t = `"` + t + `"`
+		}
+
+		formatted = append(formatted, t)
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+// formatDate returns 't' formatted using 'layout', or an empty string if 't' is zero.
+func formatDate(t time.Time, layout string) string {
+
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(layout)
+}
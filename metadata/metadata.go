@@ -0,0 +1,502 @@
+// package metadata provides methods for extracting the title, description, keywords, location and date taken embedded
+// in image files as EXIF, XMP or IPTC data and for mapping that metadata to Flickr API parameters.
+package metadata
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Metadata is a struct containing the metadata embedded in an image file.
+type Metadata struct {
+	// The title of the image.
+	Title string
+	// The description (or caption) of the image.
+	Description string
+	// Zero or more keywords associated with the image.
+	Keywords []string
+	// A boolean flag indicating whether the Latitude and Longitude properties are set.
+	HasLocation bool
+	// The latitude where the image was taken.
+	Latitude float64
+	// The longitude where the image was taken.
+	Longitude float64
+	// The date the image was taken. If the image does not specify a time zone the date is in UTC.
+	DateTaken time.Time
+	// The name of the person who created the image.
+	Artist string
+	// The copyright notice for the image.
+	Copyright string
+	// The manufacturer of the camera used to create the image.
+	Make string
+	// The model of the camera used to create the image.
+	Model string
+}
+
+// The maximum size, in bytes, of the XMP packets read from files other than JPEG files. Larger packets are ignored.
+const MAX_XMP_PACKET_SIZE int = 16 << 20
+
+// Extract returns the metadata embedded in 'body'. EXIF, XMP and IPTC data are read from JPEG files, EXIF data from TIFF
+// files and XMP data (if present) from all other files. When the same property is defined more than once XMP values take
+// precedence over IPTC values which take precedence over EXIF values. If 'body' does not contain any metadata an empty
+// Metadata instance is returned. See also ExtractReader.
+func Extract(body []byte) (*Metadata, error) {
+	return ExtractReader(bytes.NewReader(body), int64(len(body)))
+}
+
+// ExtractReader returns the metadata embedded in the file read from 'r', whose size is 'size', in the same way as Extract
+// but without reading the entire file in to memory. Only the segments preceding the image data are read from JPEG files
+// and only the image file directories are read from TIFF files. All other files are scanned for an XMP packet.
+func ExtractReader(r io.ReadSeeker, size int64) (*Metadata, error) {
+
+	head := make([]byte, 4)
+
+	n, err := io.ReadFull(r, head)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, fmt.Errorf("Failed to read file, %w", err)
+	}
+
+	head = head[:n]
+
+	_, err = r.Seek(0, io.SeekStart)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to rewind file, %w", err)
+	}
+
+	var exif_r io.ReaderAt
+	var exif_size int64
+	var xmp_data []byte
+	var iptc_data []byte
+
+	switch {
+	case isJPEG(head):
+
+		header, err := readJPEGHeader(r)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read JPEG header, %w", err)
+		}
+
+		e, x, i, err := readJPEGSegments(header)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read JPEG segments, %w", err)
+		}
+
+		if e != nil {
+			exif_r = bytes.NewReader(e)
+			exif_size = int64(len(e))
+		}
+
+		xmp_data = x
+		iptc_data = i
+
+		if xmp_data == nil {
+			xmp_data = findXMPPacket(header)
+		}
+
+	case isTIFF(head):
+
+		exif_r = &readerAt{r: r}
+		exif_size = size
+
+		fallthrough
+
+	default:
+
+		_, err := r.Seek(0, io.SeekStart)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to rewind file, %w", err)
+		}
+
+		xmp_data, err = scanXMPPacket(r)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read XMP packet, %w", err)
+		}
+	}
+
+	md := &Metadata{}
+
+	if exif_r != nil {
+
+		exif_md, err := parseEXIF(exif_r, exif_size)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse EXIF data, %w", err)
+		}
+
+		md.merge(exif_md)
+	}
+
+	if iptc_data != nil {
+
+		iptc_md, err := parseIPTC(iptc_data)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse IPTC data, %w", err)
+		}
+
+		md.merge(iptc_md)
+	}
+
+	if xmp_data != nil {
+
+		xmp_md, err := parseXMP(xmp_data)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse XMP data, %w", err)
+		}
+
+		md.merge(xmp_md)
+	}
+
+	return md, nil
+}
+
+// merge assigns the non-empty properties of 'other' to 'md'.
+func (md *Metadata) merge(other *Metadata) {
+
+	if other.Title != "" {
+		md.Title = other.Title
+	}
+
+	if other.Description != "" {
+		md.Description = other.Description
+	}
+
+	if len(other.Keywords) > 0 {
+		md.Keywords = other.Keywords
+	}
+
+	if other.HasLocation {
+		md.HasLocation = true
+		md.Latitude = other.Latitude
+		md.Longitude = other.Longitude
+	}
+
+	if !other.DateTaken.IsZero() {
+		md.DateTaken = other.DateTaken
+	}
+
+	if other.Artist != "" {
+		md.Artist = other.Artist
+	}
+
+	if other.Copyright != "" {
+		md.Copyright = other.Copyright
+	}
+
+	if other.Make != "" {
+		md.Make = other.Make
+	}
+
+	if other.Model != "" {
+		md.Model = other.Model
+	}
+}
+
+// The marker at the start of every JPEG file.
+var jpeg_soi = []byte{0xFF, 0xD8}
+
+// The header of an APP1 segment containing EXIF data.
+var exif_header = []byte("Exif\x00\x00")
+
+// The header of an APP1 segment containing an XMP packet.
+var xmp_header = []byte("http://ns.adobe.com/xap/1.0/\x00")
+
+// The header of an APP13 segment containing Photoshop image resources.
+var photoshop_header = []byte("Photoshop 3.0\x00")
+
+func isJPEG(body []byte) bool {
+	return bytes.HasPrefix(body, jpeg_soi)
+}
+
+func isTIFF(body []byte) bool {
+	return bytes.HasPrefix(body, []byte("II*\x00")) || bytes.HasPrefix(body, []byte("MM\x00*"))
+}
+
+// readJPEGHeader returns the bytes of the JPEG file read from 'r' up to, and including, the start of scan (or end of
+// image) marker where the image data begins. Invalid or truncated segments are returned as-is and reported by readJPEGSegments.
+func readJPEGHeader(r io.Reader) ([]byte, error) {
+
+	br := bufio.NewReader(r)
+
+	var buf bytes.Buffer
+
+	_, err := io.CopyN(&buf, br, int64(len(jpeg_soi)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+
+		b, err := br.ReadByte()
+
+		if err == io.EOF {
+			return buf.Bytes(), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.WriteByte(b)
+
+		if b != 0xFF {
+			return buf.Bytes(), nil
+		}
+
+		marker, err := br.ReadByte()
+
+		if err == io.EOF {
+			return buf.Bytes(), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Fill bytes and markers without a length
+
+		if marker == 0xFF {
+			br.UnreadByte()
+			continue
+		}
+
+		buf.WriteByte(marker)
+
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD8) {
+			continue
+		}
+
+		// Start of scan or end of image
+
+		if marker == 0xDA || marker == 0xD9 {
+			return buf.Bytes(), nil
+		}
+
+		length := make([]byte, 2)
+
+		n, err := io.ReadFull(br, length)
+		buf.Write(length[:n])
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return buf.Bytes(), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		l := int64(length[0])<<8 | int64(length[1])
+
+		if l < 2 {
+			return buf.Bytes(), nil
+		}
+
+		_, err = io.CopyN(&buf, br, l-2)
+
+		if err == io.EOF {
+			return buf.Bytes(), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readJPEGSegments returns the EXIF (TIFF), XMP and IPTC data contained in the APP1 and APP13 segments of the JPEG
+// file 'body', stopping at the start of the image data.
+func readJPEGSegments(body []byte) ([]byte, []byte, []byte, error) {
+
+	var exif_data []byte
+	var xmp_data []byte
+	var iptc_data []byte
+
+	offset := len(jpeg_soi)
+
+	for offset+4 <= len(body) {
+
+		if body[offset] != 0xFF {
+			return nil, nil, nil, fmt.Errorf("Invalid marker at offset %d", offset)
+		}
+
+		marker := body[offset+1]
+
+		// Fill bytes and markers without a length
+
+		if marker == 0xFF {
+			offset += 1
+			continue
+		}
+
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD8) {
+			offset += 2
+			continue
+		}
+
+		// Start of scan or end of image
+
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+
+		length := int(body[offset+2])<<8 | int(body[offset+3])
+
+		if length < 2 || offset+2+length > len(body) {
+			return nil, nil, nil, fmt.Errorf("Invalid segment length at offset %d", offset)
+		}
+
+		data := body[offset+4 : offset+2+length]
+
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(data, exif_header):
+			exif_data = data[len(exif_header):]
+		case marker == 0xE1 && bytes.HasPrefix(data, xmp_header):
+			xmp_data = data[len(xmp_header):]
+		case marker == 0xED && bytes.HasPrefix(data, photoshop_header):
+
+			i, err := readIPTCResource(data[len(photoshop_header):])
+
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			if i != nil {
+				iptc_data = i
+			}
+		}
+
+		offset += 2 + length
+	}
+
+	return exif_data, xmp_data, iptc_data, nil
+}
+
+// findXMPPacket returns the first XMP packet embedded in 'body', or nil.
+func findXMPPacket(body []byte) []byte {
+
+	start := bytes.Index(body, []byte("<x:xmpmeta"))
+
+	if start == -1 {
+		return nil
+	}
+
+	end_tag := []byte("</x:xmpmeta>")
+	end := bytes.Index(body[start:], end_tag)
+
+	if end == -1 {
+		return nil
+	}
+
+	return body[start : start+end+len(end_tag)]
+}
+
+// scanXMPPacket returns the first XMP packet in the file read from 'r', or nil, reading the file in chunks rather than
+// in its entirety. Packets larger than MAX_XMP_PACKET_SIZE are ignored.
+func scanXMPPacket(r io.Reader) ([]byte, error) {
+
+	start_tag := []byte("<x:xmpmeta")
+	end_tag := []byte("</x:xmpmeta>")
+
+	chunk := make([]byte, 64*1024)
+
+	var buf []byte
+	var packet []byte
+
+	for {
+
+		n, err := r.Read(chunk)
+
+		if n > 0 {
+
+			search_from := 0
+
+			if packet == nil {
+
+				buf = append(buf, chunk[:n]...)
+				idx := bytes.Index(buf, start_tag)
+
+				if idx == -1 {
+
+					// Keep the end of the chunk in case the start tag spans two chunks
+
+					keep := min(len(buf), len(start_tag)-1)
+					buf = append(buf[:0], buf[len(buf)-keep:]...)
+				} else {
+					packet = append([]byte{}, buf[idx:]...)
+					buf = nil
+				}
+
+			} else {
+				search_from = max(0, len(packet)-len(end_tag)+1)
+				packet = append(packet, chunk[:n]...)
+			}
+
+			if packet != nil {
+
+				end := bytes.Index(packet[search_from:], end_tag)
+
+				if end != -1 {
+					return packet[:search_from+end+len(end_tag)], nil
+				}
+
+				if len(packet) > MAX_XMP_PACKET_SIZE {
+					return nil, nil
+				}
+			}
+		}
+
+		if err == io.EOF {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readerAt implements the io.ReaderAt interface for an io.ReadSeeker. It is not safe for concurrent use.
+type readerAt struct {
+	r io.ReadSeeker
+}
+
+// ReadAt reads len(p) bytes from the underlying reader starting at offset 'off'.
+func (ra *readerAt) ReadAt(p []byte, off int64) (int, error) {
+
+	_, err := ra.r.Seek(off, io.SeekStart)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return io.ReadFull(ra.r, p)
+}
+
+// splitKeywords splits 's' on 'sep', discarding empty values.
+func splitKeywords(s string, sep string) []string {
+
+	keywords := make([]string, 0)
+
+	for _, k := range strings.Split(s, sep) {
+
+		k = strings.TrimSpace(k)
+
+		if k != "" {
+			keywords = append(keywords, k)
+		}
+	}
+
+	return keywords
+}
@@ -0,0 +1,358 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExtract(t *testing.T) {
+
+	body := testJPEG()
+
+	md, err := Extract(body)
+
+	if err != nil {
+		t.Fatalf("Failed to extract metadata, %v", err)
+	}
+
+	if md.Title != "XMP title" {
+		t.Fatalf("Unexpected title, %s", md.Title)
+	}
+
+	if md.Description != "EXIF description" {
+		t.Fatalf("Unexpected description, %s", md.Description)
+	}
+
+	if strings.Join(md.Keywords, ",") != "one,two words" {
+		t.Fatalf("Unexpected keywords, %v", md.Keywords)
+	}
+
+	if md.Make != "Camera" || md.Artist != "Photographer" {
+		t.Fatalf("Unexpected make or artist, %s %s", md.Make, md.Artist)
+	}
+
+	if !md.HasLocation || math.Abs(md.Latitude-37.775) > 0.000001 || math.Abs(md.Longitude+122.5) > 0.000001 {
+		t.Fatalf("Unexpected location, %v %f %f", md.HasLocation, md.Latitude, md.Longitude)
+	}
+
+	expected := time.Date(2021, 6, 1, 12, 34, 56, 0, time.FixedZone("", -7*60*60))
+
+	if !md.DateTaken.Equal(expected) || md.DateTaken.Format(FLICKR_DATE_LAYOUT) != "2021-06-01 12:34:56" {
+		t.Fatalf("Unexpected date taken, %v", md.DateTaken)
+	}
+}
+
+func TestExtractXMPPacket(t *testing.T) {
+
+	xmp := `<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` +
+		`<rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:exif="http://ns.adobe.com/exif/1.0/" xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/" photoshop:DateCreated="2020-01-02T03:04:05" exif:GPSLatitude="37,46.5N" exif:GPSLongitude="122,30,0W">` +
+		`<dc:description><rdf:Alt><rdf:li xml:lang="x-default">XMP description</rdf:li></rdf:Alt></dc:description>` +
+		`<dc:subject><rdf:Bag><rdf:li>a</rdf:li><rdf:li>b</rdf:li></rdf:Bag></dc:subject>` +
+		`</rdf:Description></rdf:RDF></x:xmpmeta>`
+
+	body := append([]byte("\x89PNG\r\n\x1a\n...iTXtXML:com.adobe.xmp\x00\x00\x00\x00\x00"), []byte(xmp)...)
+
+	md, err := Extract(body)
+
+	if err != nil {
+		t.Fatalf("Failed to extract metadata, %v", err)
+	}
+
+	if md.Description != "XMP description" || strings.Join(md.Keywords, ",") != "a,b" {
+		t.Fatalf("Unexpected metadata, %v", md)
+	}
+
+	if !md.HasLocation || math.Abs(md.Latitude-37.775) > 0.000001 || math.Abs(md.Longitude+122.5) > 0.000001 {
+		t.Fatalf("Unexpected location, %v %f %f", md.HasLocation, md.Latitude, md.Longitude)
+	}
+
+	if md.DateTaken.Format(FLICKR_DATE_LAYOUT) != "2020-01-02 03:04:05" {
+		t.Fatalf("Unexpected date taken, %v", md.DateTaken)
+	}
+
+	md, err = Extract([]byte("not an image"))
+
+	if err != nil {
+		t.Fatalf("Failed to extract metadata, %v", err)
+	}
+
+	if md.Title != "" || md.HasLocation || !md.DateTaken.IsZero() {
+		t.Fatalf("Expected empty metadata, %v", md)
+	}
+}
+
+func TestExtractReader(t *testing.T) {
+
+	// Only the segments preceding the image data should be read from JPEG files
+
+	image_data := bytes.Repeat([]byte{0x00}, 4<<20)
+	body := append(testJPEG(), image_data...)
+
+	r := &countingReader{r: bytes.NewReader(body)}
+
+	md, err := ExtractReader(r, int64(len(body)))
+
+	if err != nil {
+		t.Fatalf("Failed to extract metadata, %v", err)
+	}
+
+	if md.Title != "XMP title" || md.Description != "EXIF description" || !md.HasLocation {
+		t.Fatalf("Unexpected metadata, %v", md)
+	}
+
+	if r.n > int64(len(image_data)/4) {
+		t.Fatalf("Read too much of the file, %d bytes", r.n)
+	}
+
+	// XMP packets that span the chunks in which other files are read
+
+	xmp := `<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` +
+		`<rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+		`<dc:description><rdf:Alt><rdf:li xml:lang="x-default">XMP description</rdf:li></rdf:Alt></dc:description>` +
+		`</rdf:Description></rdf:RDF></x:xmpmeta>`
+
+	body = append(bytes.Repeat([]byte("."), 64*1024-5), []byte(xmp)...)
+
+	md, err = ExtractReader(bytes.NewReader(body), int64(len(body)))
+
+	if err != nil {
+		t.Fatalf("Failed to extract metadata, %v", err)
+	}
+
+	if md.Description != "XMP description" {
+		t.Fatalf("Unexpected description, %s", md.Description)
+	}
+}
+
+// countingReader is an io.ReadSeeker that counts the number of bytes read.
+type countingReader struct {
+	r *bytes.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) Seek(offset int64, whence int) (int64, error) {
+	return c.r.Seek(offset, whence)
+}
+
+func TestMapping(t *testing.T) {
+
+	md, err := Extract(testJPEG())
+
+	if err != nil {
+		t.Fatalf("Failed to extract metadata, %v", err)
+	}
+
+	m := DefaultMapping()
+
+	args, err := m.UploadArgs(md)
+
+	if err != nil {
+		t.Fatalf("Failed to derive upload parameters, %v", err)
+	}
+
+	if args.Get("title") != "XMP title" || args.Get("tags") != `one "two words"` {
+		t.Fatalf("Unexpected upload parameters, %v", args)
+	}
+
+	method_args, err := m.MethodArgs(md)
+
+	if err != nil {
+		t.Fatalf("Failed to derive method parameters, %v", err)
+	}
+
+	if len(method_args) != 2 {
+		t.Fatalf("Unexpected number of methods, %d", len(method_args))
+	}
+
+	if method_args[0].Get("method") != "flickr.photos.geo.setLocation" || method_args[0].Get("lat") != "37.775000" || method_args[0].Get("lon") != "-122.500000" {
+		t.Fatalf("Unexpected parameters, %v", method_args[0])
+	}
+
+	if method_args[1].Get("method") != "flickr.photos.setDates" || method_args[1].Get("date_taken") != "2021-06-01 12:34:56" {
+		t.Fatalf("Unexpected parameters, %v", method_args[1])
+	}
+
+	method_args, err = m.MethodArgs(&Metadata{})
+
+	if err != nil {
+		t.Fatalf("Failed to derive method parameters, %v", err)
+	}
+
+	if len(method_args) != 0 {
+		t.Fatalf("Did not expect methods for empty metadata")
+	}
+
+	custom := `{"params": {"title": "{{ .Title }} by {{ .Artist }}", "tags": "{{ join .Keywords \",\" }}"}}`
+
+	m, err = ReadMapping(strings.NewReader(custom))
+
+	if err != nil {
+		t.Fatalf("Failed to read mapping, %v", err)
+	}
+
+	args, err = m.UploadArgs(md)
+
+	if err != nil {
+		t.Fatalf("Failed to derive upload parameters, %v", err)
+	}
+
+	if args.Get("title") != "XMP title by Photographer" || args.Get("tags") != "one,two words" {
+		t.Fatalf("Unexpected upload parameters, %v", args)
+	}
+
+	_, err = ReadMapping(strings.NewReader(`{"params": {"title": "{{ .Unknown }}"}}`))
+
+	if err == nil {
+		t.Fatalf("Expected invalid mapping to fail")
+	}
+}
+
+// testTag is a TIFF tag used to build test EXIF data.
+type testTag struct {
+	tag   uint16
+	type_ uint16
+	count uint32
+	value []byte
+}
+
+func asciiTag(tag uint16, v string) testTag {
+	return testTag{tag, 2, uint32(len(v) + 1), append([]byte(v), 0)}
+}
+
+func longTag(tag uint16, v uint32) testTag {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return testTag{tag, 4, 1, b}
+}
+
+func rationalTag(tag uint16, v ...uint32) testTag {
+
+	b := make([]byte, 0)
+
+	for _, n := range v {
+		b = binary.LittleEndian.AppendUint32(b, n)
+	}
+
+	return testTag{tag, 5, uint32(len(v) / 2), b}
+}
+
+// buildIFD returns a little-endian TIFF image file directory, followed by its values, starting at 'offset'.
+func buildIFD(offset uint32, tags ...testTag) []byte {
+
+	ifd := binary.LittleEndian.AppendUint16(nil, uint16(len(tags)))
+	data := make([]byte, 0)
+
+	data_offset := offset + uint32(2+12*len(tags)+4)
+
+	for _, t := range tags {
+
+		ifd = binary.LittleEndian.AppendUint16(ifd, t.tag)
+		ifd = binary.LittleEndian.AppendUint16(ifd, t.type_)
+		ifd = binary.LittleEndian.AppendUint32(ifd, t.count)
+
+		if len(t.value) <= 4 {
+			v := make([]byte, 4)
+			copy(v, t.value)
+			ifd = append(ifd, v...)
+			continue
+		}
+
+		ifd = binary.LittleEndian.AppendUint32(ifd, data_offset+uint32(len(data)))
+		data = append(data, t.value...)
+
+		if len(data)%2 != 0 {
+			data = append(data, 0)
+		}
+	}
+
+	ifd = binary.LittleEndian.AppendUint32(ifd, 0)
+	return append(ifd, data...)
+}
+
+// testJPEG returns a minimal JPEG file containing EXIF, XMP and IPTC metadata.
+func testJPEG() []byte {
+
+	ifd0 := func(exif_offset uint32, gps_offset uint32) []byte {
+		return buildIFD(8,
+			asciiTag(exif_tag_image_description, "EXIF description"),
+			asciiTag(exif_tag_make, "Camera"),
+			asciiTag(exif_tag_artist, "Photographer"),
+			longTag(exif_tag_exif_ifd, exif_offset),
+			longTag(exif_tag_gps_ifd, gps_offset),
+		)
+	}
+
+	exif_offset := uint32(8 + len(ifd0(0, 0)))
+
+	exif_ifd := buildIFD(exif_offset,
+		asciiTag(exif_tag_datetime_original, "2021:06:01 12:34:56"),
+		asciiTag(exif_tag_offset_time_original, "-07:00"),
+	)
+
+	gps_offset := exif_offset + uint32(len(exif_ifd))
+
+	gps_ifd := buildIFD(gps_offset,
+		asciiTag(exif_tag_gps_latitude_ref, "N"),
+		rationalTag(exif_tag_gps_latitude, 37, 1, 46, 1, 3000, 100),
+		asciiTag(exif_tag_gps_longitude_ref, "W"),
+		rationalTag(exif_tag_gps_longitude, 122, 1, 30, 1, 0, 1),
+	)
+
+	tiff := []byte("II*\x00\x08\x00\x00\x00")
+	tiff = append(tiff, ifd0(exif_offset, gps_offset)...)
+	tiff = append(tiff, exif_ifd...)
+	tiff = append(tiff, gps_ifd...)
+
+	xmp := `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?><x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` +
+		`<rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+		`<dc:title><rdf:Alt><rdf:li xml:lang="x-default">XMP title</rdf:li></rdf:Alt></dc:title>` +
+		`</rdf:Description></rdf:RDF></x:xmpmeta><?xpacket end="w"?>`
+
+	iptc := make([]byte, 0)
+
+	for _, ds := range []struct {
+		dataset byte
+		value   string
+	}{
+		{iptc_object_name, "IPTC title"},
+		{iptc_keywords, "one"},
+		{iptc_keywords, "two words"},
+	} {
+		iptc = append(iptc, 0x1C, 2, ds.dataset)
+		iptc = binary.BigEndian.AppendUint16(iptc, uint16(len(ds.value)))
+		iptc = append(iptc, ds.value...)
+	}
+
+	resources := []byte("8BIM")
+	resources = binary.BigEndian.AppendUint16(resources, photoshop_iptc_resource)
+	resources = append(resources, 0, 0)
+	resources = binary.BigEndian.AppendUint32(resources, uint32(len(iptc)))
+	resources = append(resources, iptc...)
+
+	segment := func(marker byte, header []byte, data []byte) []byte {
+		seg := []byte{0xFF, marker}
+		seg = binary.BigEndian.AppendUint16(seg, uint16(2+len(header)+len(data)))
+		seg = append(seg, header...)
+		return append(seg, data...)
+	}
+
+	var buf bytes.Buffer
+
+	buf.Write(jpeg_soi)
+	buf.Write(segment(0xE1, exif_header, tiff))
+	buf.Write(segment(0xE1, xmp_header, []byte(xmp)))
+	buf.Write(segment(0xED, photoshop_header, resources))
+	buf.Write([]byte{0xFF, 0xDA, 0x00, 0x02, 0xFF, 0xD9})
+
+	return buf.Bytes()
+}
@@ -0,0 +1,277 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// XMP namespaces used to derive metadata.
+const (
+	xmp_ns_rdf       string = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmp_ns_dc        string = "http://purl.org/dc/elements/1.1/"
+	xmp_ns_xmp       string = "http://ns.adobe.com/xap/1.0/"
+	xmp_ns_photoshop string = "http://ns.adobe.com/photoshop/1.0/"
+	xmp_ns_exif      string = "http://ns.adobe.com/exif/1.0/"
+	xmp_ns_tiff      string = "http://ns.adobe.com/tiff/1.0/"
+)
+
+// The layouts of (ISO 8601) XMP date strings, from most to least specific.
+var xmp_date_layouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseXMP returns the metadata derived from the XMP packet in 'data'.
+func parseXMP(data []byte) (*Metadata, error) {
+
+	props, err := readXMPProperties(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	first := func(ns string, local string) string {
+
+		values := props[xml.Name{Space: ns, Local: local}]
+
+		if len(values) == 0 {
+			return ""
+		}
+
+		return values[0]
+	}
+
+	md := &Metadata{
+		Title:       first(xmp_ns_dc, "title"),
+		Description: first(xmp_ns_dc, "description"),
+		Keywords:    props[xml.Name{Space: xmp_ns_dc, Local: "subject"}],
+		Artist:      first(xmp_ns_dc, "creator"),
+		Copyright:   first(xmp_ns_dc, "rights"),
+		Make:        first(xmp_ns_tiff, "Make"),
+		Model:       first(xmp_ns_tiff, "Model"),
+	}
+
+	if md.Title == "" {
+		md.Title = first(xmp_ns_photoshop, "Headline")
+	}
+
+	dates := []string{
+		first(xmp_ns_exif, "DateTimeOriginal"),
+		first(xmp_ns_photoshop, "DateCreated"),
+		first(xmp_ns_xmp, "CreateDate"),
+	}
+
+	for _, d := range dates {
+
+		if d == "" {
+			continue
+		}
+
+		t, err := parseXMPDate(d)
+
+		if err == nil {
+			md.DateTaken = t
+			break
+		}
+	}
+
+	lat, lat_err := parseXMPCoordinate(first(xmp_ns_exif, "GPSLatitude"))
+	lon, lon_err := parseXMPCoordinate(first(xmp_ns_exif, "GPSLongitude"))
+
+	if lat_err == nil && lon_err == nil && isValidLocation(lat, lon) {
+		md.HasLocation = true
+		md.Latitude = lat
+		md.Longitude = lon
+	}
+
+	return md, nil
+}
+
+// readXMPProperties returns the values of the properties in the rdf:Description elements of the XMP packet 'data',
+// whether they are encoded as attributes, simple elements or rdf:Alt, rdf:Bag or rdf:Seq containers.
+func readXMPProperties(data []byte) (map[xml.Name][]string, error) {
+
+	props := make(map[xml.Name][]string)
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+
+	// The stack of open elements, the current property (a child of rdf:Description) and its text
+
+	stack := make([]xml.Name, 0)
+
+	var prop *xml.Name
+	var prop_text strings.Builder
+	var li_text strings.Builder
+
+	prop_values := 0
+
+	for {
+
+		tok, err := dec.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read XMP packet, %w", err)
+		}
+
+		switch el := tok.(type) {
+		case xml.StartElement:
+
+			parent := xml.Name{}
+
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1]
+			}
+
+			stack = append(stack, el.Name)
+
+			switch {
+			case el.Name.Space == xmp_ns_rdf && el.Name.Local == "Description":
+
+				for _, attr := range el.Attr {
+
+					if attr.Name.Space == xmp_ns_rdf || attr.Name.Space == "xmlns" || attr.Name.Space == "" {
+						continue
+					}
+
+					props[attr.Name] = append(props[attr.Name], strings.TrimSpace(attr.Value))
+				}
+
+			case prop == nil && parent.Space == xmp_ns_rdf && parent.Local == "Description":
+
+				name := el.Name
+				prop = &name
+				prop_text.Reset()
+				prop_values = 0
+
+			case prop != nil && el.Name.Space == xmp_ns_rdf && el.Name.Local == "li":
+				li_text.Reset()
+			}
+
+		case xml.CharData:
+
+			if prop == nil || len(stack) == 0 {
+				continue
+			}
+
+			top := stack[len(stack)-1]
+
+			switch {
+			case top.Space == xmp_ns_rdf && top.Local == "li":
+				li_text.Write(el)
+			case top == *prop:
+				prop_text.Write(el)
+			}
+
+		case xml.EndElement:
+
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+
+			if prop == nil {
+				continue
+			}
+
+			switch {
+			case el.Name.Space == xmp_ns_rdf && el.Name.Local == "li":
+
+				v := strings.TrimSpace(li_text.String())
+
+				if v != "" {
+					props[*prop] = append(props[*prop], v)
+					prop_values += 1
+				}
+
+			case el.Name == *prop:
+
+				v := strings.TrimSpace(prop_text.String())
+
+				if prop_values == 0 && v != "" {
+					props[*prop] = append(props[*prop], v)
+				}
+
+				prop = nil
+			}
+		}
+	}
+
+	return props, nil
+}
+
+// parseXMPDate parses the (ISO 8601) XMP date string 'date'.
+func parseXMPDate(date string) (time.Time, error) {
+
+	for _, layout := range xmp_date_layouts {
+
+		t, err := time.Parse(layout, date)
+
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("Invalid date '%s'", date)
+}
+
+// parseXMPCoordinate parses the XMP GPS coordinate 'coord', which is encoded as "DDD,MM,SSk" or "DDD,MM.mmk" where
+// "k" is one of N, S, E or W.
+func parseXMPCoordinate(coord string) (float64, error) {
+
+	coord = strings.TrimSpace(coord)
+
+	if len(coord) < 2 {
+		return 0, fmt.Errorf("Invalid coordinate '%s'", coord)
+	}
+
+	ref := strings.ToUpper(coord[len(coord)-1:])
+
+	if !strings.Contains("NSEW", ref) {
+		return 0, fmt.Errorf("Invalid coordinate reference '%s'", ref)
+	}
+
+	parts := strings.Split(coord[:len(coord)-1], ",")
+
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("Invalid coordinate '%s'", coord)
+	}
+
+	deg := 0.0
+
+	for i, p := range parts {
+
+		v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("Invalid coordinate '%s', %w", coord, err)
+		}
+
+		switch i {
+		case 0:
+			deg += v
+		case 1:
+			deg += v / 60
+		case 2:
+			deg += v / 3600
+		}
+	}
+
+	if ref == "S" || ref == "W" {
+		deg = -deg
+	}
+
+	return deg, nil
+}
@@ -0,0 +1,13104 @@
+// Code generated by go-flickr-api/cmd/generate-methods. DO NOT EDIT.
+
+package methods
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// Catalogue maps Flickr API method names to their MethodInfo details.
+var Catalogue = map[string]*MethodInfo{
+	"flickr.activity.userComments":                      &MethodInfo{Name: "flickr.activity.userComments", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.activity.userPhotos":                        &MethodInfo{Name: "flickr.activity.userPhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.auth.checkToken":                            &MethodInfo{Name: "flickr.auth.checkToken", NeedsLogin: false, NeedsSigning: true, RequiredPerms: "none"},
+	"flickr.auth.getFrob":                               &MethodInfo{Name: "flickr.auth.getFrob", NeedsLogin: false, NeedsSigning: true, RequiredPerms: "none"},
+	"flickr.auth.getFullToken":                          &MethodInfo{Name: "flickr.auth.getFullToken", NeedsLogin: false, NeedsSigning: true, RequiredPerms: "none"},
+	"flickr.auth.getToken":                              &MethodInfo{Name: "flickr.auth.getToken", NeedsLogin: false, NeedsSigning: true, RequiredPerms: "none"},
+	"flickr.auth.oauth.checkToken":                      &MethodInfo{Name: "flickr.auth.oauth.checkToken", NeedsLogin: false, NeedsSigning: true, RequiredPerms: "none"},
+	"flickr.auth.oauth.getAccessToken":                  &MethodInfo{Name: "flickr.auth.oauth.getAccessToken", NeedsLogin: false, NeedsSigning: true, RequiredPerms: "none"},
+	"flickr.blogs.getList":                              &MethodInfo{Name: "flickr.blogs.getList", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.blogs.getServices":                          &MethodInfo{Name: "flickr.blogs.getServices", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.blogs.postPhoto":                            &MethodInfo{Name: "flickr.blogs.postPhoto", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.cameras.getBrandModels":                     &MethodInfo{Name: "flickr.cameras.getBrandModels", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.cameras.getBrands":                          &MethodInfo{Name: "flickr.cameras.getBrands", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.collections.getInfo":                        &MethodInfo{Name: "flickr.collections.getInfo", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.collections.getTree":                        &MethodInfo{Name: "flickr.collections.getTree", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.commons.getInstitutions":                    &MethodInfo{Name: "flickr.commons.getInstitutions", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.contacts.getList":                           &MethodInfo{Name: "flickr.contacts.getList", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.contacts.getListRecentlyUploaded":           &MethodInfo{Name: "flickr.contacts.getListRecentlyUploaded", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.contacts.getPublicList":                     &MethodInfo{Name: "flickr.contacts.getPublicList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.contacts.getTaggingSuggestions":             &MethodInfo{Name: "flickr.contacts.getTaggingSuggestions", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.favorites.add":                              &MethodInfo{Name: "flickr.favorites.add", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.favorites.getContext":                       &MethodInfo{Name: "flickr.favorites.getContext", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.favorites.getList":                          &MethodInfo{Name: "flickr.favorites.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.favorites.getPublicList":                    &MethodInfo{Name: "flickr.favorites.getPublicList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.favorites.remove":                           &MethodInfo{Name: "flickr.favorites.remove", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.galleries.addPhoto":                         &MethodInfo{Name: "flickr.galleries.addPhoto", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.galleries.create":                           &MethodInfo{Name: "flickr.galleries.create", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.galleries.editMeta":                         &MethodInfo{Name: "flickr.galleries.editMeta", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.galleries.editPhoto":                        &MethodInfo{Name: "flickr.galleries.editPhoto", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.galleries.editPhotos":                       &MethodInfo{Name: "flickr.galleries.editPhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.galleries.getInfo":                          &MethodInfo{Name: "flickr.galleries.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.galleries.getList":                          &MethodInfo{Name: "flickr.galleries.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.galleries.getListForPhoto":                  &MethodInfo{Name: "flickr.galleries.getListForPhoto", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.galleries.getPhotos":                        &MethodInfo{Name: "flickr.galleries.getPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.browse":                              &MethodInfo{Name: "flickr.groups.browse", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.groups.discuss.replies.add":                 &MethodInfo{Name: "flickr.groups.discuss.replies.add", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.discuss.replies.delete":              &MethodInfo{Name: "flickr.groups.discuss.replies.delete", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "delete"},
+	"flickr.groups.discuss.replies.edit":                &MethodInfo{Name: "flickr.groups.discuss.replies.edit", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.discuss.replies.getInfo":             &MethodInfo{Name: "flickr.groups.discuss.replies.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.discuss.replies.getList":             &MethodInfo{Name: "flickr.groups.discuss.replies.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.discuss.topics.add":                  &MethodInfo{Name: "flickr.groups.discuss.topics.add", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.discuss.topics.getInfo":              &MethodInfo{Name: "flickr.groups.discuss.topics.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.discuss.topics.getList":              &MethodInfo{Name: "flickr.groups.discuss.topics.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.getInfo":                             &MethodInfo{Name: "flickr.groups.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.join":                                &MethodInfo{Name: "flickr.groups.join", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.joinRequest":                         &MethodInfo{Name: "flickr.groups.joinRequest", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.leave":                               &MethodInfo{Name: "flickr.groups.leave", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "delete"},
+	"flickr.groups.members.getList":                     &MethodInfo{Name: "flickr.groups.members.getList", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.groups.pools.add":                           &MethodInfo{Name: "flickr.groups.pools.add", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.pools.getContext":                    &MethodInfo{Name: "flickr.groups.pools.getContext", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.pools.getGroups":                     &MethodInfo{Name: "flickr.groups.pools.getGroups", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.groups.pools.getPhotos":                     &MethodInfo{Name: "flickr.groups.pools.getPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.groups.pools.remove":                        &MethodInfo{Name: "flickr.groups.pools.remove", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.groups.search":                              &MethodInfo{Name: "flickr.groups.search", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.interestingness.getList":                    &MethodInfo{Name: "flickr.interestingness.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.machinetags.getNamespaces":                  &MethodInfo{Name: "flickr.machinetags.getNamespaces", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.machinetags.getPairs":                       &MethodInfo{Name: "flickr.machinetags.getPairs", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.machinetags.getPredicates":                  &MethodInfo{Name: "flickr.machinetags.getPredicates", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.machinetags.getRecentValues":                &MethodInfo{Name: "flickr.machinetags.getRecentValues", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.machinetags.getValues":                      &MethodInfo{Name: "flickr.machinetags.getValues", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.panda.getList":                              &MethodInfo{Name: "flickr.panda.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.panda.getPhotos":                            &MethodInfo{Name: "flickr.panda.getPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.findByEmail":                         &MethodInfo{Name: "flickr.people.findByEmail", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.findByUsername":                      &MethodInfo{Name: "flickr.people.findByUsername", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.getGroups":                           &MethodInfo{Name: "flickr.people.getGroups", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.people.getInfo":                             &MethodInfo{Name: "flickr.people.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.getLimits":                           &MethodInfo{Name: "flickr.people.getLimits", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.people.getPhotos":                           &MethodInfo{Name: "flickr.people.getPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.getPhotosOf":                         &MethodInfo{Name: "flickr.people.getPhotosOf", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.getPublicGroups":                     &MethodInfo{Name: "flickr.people.getPublicGroups", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.getPublicPhotos":                     &MethodInfo{Name: "flickr.people.getPublicPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.people.getUploadStatus":                     &MethodInfo{Name: "flickr.people.getUploadStatus", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.addTags":                             &MethodInfo{Name: "flickr.photos.addTags", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.comments.addComment":                 &MethodInfo{Name: "flickr.photos.comments.addComment", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.comments.deleteComment":              &MethodInfo{Name: "flickr.photos.comments.deleteComment", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.comments.editComment":                &MethodInfo{Name: "flickr.photos.comments.editComment", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.comments.getList":                    &MethodInfo{Name: "flickr.photos.comments.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.comments.getRecentForContacts":       &MethodInfo{Name: "flickr.photos.comments.getRecentForContacts", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.delete":                              &MethodInfo{Name: "flickr.photos.delete", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "delete"},
+	"flickr.photos.geo.batchCorrectLocation":            &MethodInfo{Name: "flickr.photos.geo.batchCorrectLocation", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.geo.correctLocation":                 &MethodInfo{Name: "flickr.photos.geo.correctLocation", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.geo.getLocation":                     &MethodInfo{Name: "flickr.photos.geo.getLocation", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.geo.getPerms":                        &MethodInfo{Name: "flickr.photos.geo.getPerms", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.geo.photosForLocation":               &MethodInfo{Name: "flickr.photos.geo.photosForLocation", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.geo.removeLocation":                  &MethodInfo{Name: "flickr.photos.geo.removeLocation", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.geo.setContext":                      &MethodInfo{Name: "flickr.photos.geo.setContext", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.geo.setLocation":                     &MethodInfo{Name: "flickr.photos.geo.setLocation", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.geo.setPerms":                        &MethodInfo{Name: "flickr.photos.geo.setPerms", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.getAllContexts":                      &MethodInfo{Name: "flickr.photos.getAllContexts", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getContactsPhotos":                   &MethodInfo{Name: "flickr.photos.getContactsPhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.getContactsPublicPhotos":             &MethodInfo{Name: "flickr.photos.getContactsPublicPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getContext":                          &MethodInfo{Name: "flickr.photos.getContext", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getCounts":                           &MethodInfo{Name: "flickr.photos.getCounts", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.getExif":                             &MethodInfo{Name: "flickr.photos.getExif", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getFavorites":                        &MethodInfo{Name: "flickr.photos.getFavorites", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getInfo":                             &MethodInfo{Name: "flickr.photos.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getNotInSet":                         &MethodInfo{Name: "flickr.photos.getNotInSet", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.getPerms":                            &MethodInfo{Name: "flickr.photos.getPerms", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.getPopular":                          &MethodInfo{Name: "flickr.photos.getPopular", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getRecent":                           &MethodInfo{Name: "flickr.photos.getRecent", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getSizes":                            &MethodInfo{Name: "flickr.photos.getSizes", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.getUntagged":                         &MethodInfo{Name: "flickr.photos.getUntagged", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.getWithGeoData":                      &MethodInfo{Name: "flickr.photos.getWithGeoData", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.getWithoutGeoData":                   &MethodInfo{Name: "flickr.photos.getWithoutGeoData", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.licenses.getInfo":                    &MethodInfo{Name: "flickr.photos.licenses.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.licenses.setLicense":                 &MethodInfo{Name: "flickr.photos.licenses.setLicense", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.notes.add":                           &MethodInfo{Name: "flickr.photos.notes.add", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.notes.delete":                        &MethodInfo{Name: "flickr.photos.notes.delete", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.notes.edit":                          &MethodInfo{Name: "flickr.photos.notes.edit", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.people.add":                          &MethodInfo{Name: "flickr.photos.people.add", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.people.delete":                       &MethodInfo{Name: "flickr.photos.people.delete", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.people.deleteCoords":                 &MethodInfo{Name: "flickr.photos.people.deleteCoords", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.people.editCoords":                   &MethodInfo{Name: "flickr.photos.people.editCoords", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.people.getList":                      &MethodInfo{Name: "flickr.photos.people.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.recentlyUpdated":                     &MethodInfo{Name: "flickr.photos.recentlyUpdated", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.removeTag":                           &MethodInfo{Name: "flickr.photos.removeTag", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.search":                              &MethodInfo{Name: "flickr.photos.search", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photos.setContentType":                      &MethodInfo{Name: "flickr.photos.setContentType", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.setDates":                            &MethodInfo{Name: "flickr.photos.setDates", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.setMeta":                             &MethodInfo{Name: "flickr.photos.setMeta", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.setPerms":                            &MethodInfo{Name: "flickr.photos.setPerms", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.setSafetyLevel":                      &MethodInfo{Name: "flickr.photos.setSafetyLevel", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.setTags":                             &MethodInfo{Name: "flickr.photos.setTags", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.suggestions.approveSuggestion":       &MethodInfo{Name: "flickr.photos.suggestions.approveSuggestion", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.suggestions.getList":                 &MethodInfo{Name: "flickr.photos.suggestions.getList", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.photos.suggestions.rejectSuggestion":        &MethodInfo{Name: "flickr.photos.suggestions.rejectSuggestion", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.suggestions.removeSuggestion":        &MethodInfo{Name: "flickr.photos.suggestions.removeSuggestion", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.suggestions.suggestLocation":         &MethodInfo{Name: "flickr.photos.suggestions.suggestLocation", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.transform.rotate":                    &MethodInfo{Name: "flickr.photos.transform.rotate", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photos.upload.checkTickets":                 &MethodInfo{Name: "flickr.photos.upload.checkTickets", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photosets.addPhoto":                         &MethodInfo{Name: "flickr.photosets.addPhoto", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.comments.addComment":              &MethodInfo{Name: "flickr.photosets.comments.addComment", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.comments.deleteComment":           &MethodInfo{Name: "flickr.photosets.comments.deleteComment", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.comments.editComment":             &MethodInfo{Name: "flickr.photosets.comments.editComment", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.comments.getList":                 &MethodInfo{Name: "flickr.photosets.comments.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photosets.create":                           &MethodInfo{Name: "flickr.photosets.create", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.delete":                           &MethodInfo{Name: "flickr.photosets.delete", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.editMeta":                         &MethodInfo{Name: "flickr.photosets.editMeta", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.editPhotos":                       &MethodInfo{Name: "flickr.photosets.editPhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.getContext":                       &MethodInfo{Name: "flickr.photosets.getContext", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photosets.getInfo":                          &MethodInfo{Name: "flickr.photosets.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photosets.getList":                          &MethodInfo{Name: "flickr.photosets.getList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photosets.getPhotos":                        &MethodInfo{Name: "flickr.photosets.getPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.photosets.orderSets":                        &MethodInfo{Name: "flickr.photosets.orderSets", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.removePhoto":                      &MethodInfo{Name: "flickr.photosets.removePhoto", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.removePhotos":                     &MethodInfo{Name: "flickr.photosets.removePhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.reorderPhotos":                    &MethodInfo{Name: "flickr.photosets.reorderPhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.photosets.setPrimaryPhoto":                  &MethodInfo{Name: "flickr.photosets.setPrimaryPhoto", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.places.find":                                &MethodInfo{Name: "flickr.places.find", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.findByLatLon":                        &MethodInfo{Name: "flickr.places.findByLatLon", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.getChildrenWithPhotosPublic":         &MethodInfo{Name: "flickr.places.getChildrenWithPhotosPublic", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.getInfo":                             &MethodInfo{Name: "flickr.places.getInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.getInfoByUrl":                        &MethodInfo{Name: "flickr.places.getInfoByUrl", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.getPlaceTypes":                       &MethodInfo{Name: "flickr.places.getPlaceTypes", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.getShapeHistory":                     &MethodInfo{Name: "flickr.places.getShapeHistory", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.getTopPlacesList":                    &MethodInfo{Name: "flickr.places.getTopPlacesList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.placesForBoundingBox":                &MethodInfo{Name: "flickr.places.placesForBoundingBox", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.placesForContacts":                   &MethodInfo{Name: "flickr.places.placesForContacts", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.places.placesForTags":                       &MethodInfo{Name: "flickr.places.placesForTags", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.placesForUser":                       &MethodInfo{Name: "flickr.places.placesForUser", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.places.resolvePlaceId":                      &MethodInfo{Name: "flickr.places.resolvePlaceId", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.resolvePlaceURL":                     &MethodInfo{Name: "flickr.places.resolvePlaceURL", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.places.tagsForPlace":                        &MethodInfo{Name: "flickr.places.tagsForPlace", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.prefs.getContentType":                       &MethodInfo{Name: "flickr.prefs.getContentType", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.prefs.getGeoPerms":                          &MethodInfo{Name: "flickr.prefs.getGeoPerms", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.prefs.getHidden":                            &MethodInfo{Name: "flickr.prefs.getHidden", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.prefs.getPrivacy":                           &MethodInfo{Name: "flickr.prefs.getPrivacy", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.prefs.getSafetyLevel":                       &MethodInfo{Name: "flickr.prefs.getSafetyLevel", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.profile.getProfile":                         &MethodInfo{Name: "flickr.profile.getProfile", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.push.getSubscriptions":                      &MethodInfo{Name: "flickr.push.getSubscriptions", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.push.getTopics":                             &MethodInfo{Name: "flickr.push.getTopics", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.push.subscribe":                             &MethodInfo{Name: "flickr.push.subscribe", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.push.unsubscribe":                           &MethodInfo{Name: "flickr.push.unsubscribe", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.reflection.getMethodInfo":                   &MethodInfo{Name: "flickr.reflection.getMethodInfo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.reflection.getMethods":                      &MethodInfo{Name: "flickr.reflection.getMethods", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.stats.getCSVFiles":                          &MethodInfo{Name: "flickr.stats.getCSVFiles", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getCollectionDomains":                 &MethodInfo{Name: "flickr.stats.getCollectionDomains", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getCollectionReferrers":               &MethodInfo{Name: "flickr.stats.getCollectionReferrers", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getCollectionStats":                   &MethodInfo{Name: "flickr.stats.getCollectionStats", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotoDomains":                      &MethodInfo{Name: "flickr.stats.getPhotoDomains", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotoReferrers":                    &MethodInfo{Name: "flickr.stats.getPhotoReferrers", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotoStats":                        &MethodInfo{Name: "flickr.stats.getPhotoStats", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotosetDomains":                   &MethodInfo{Name: "flickr.stats.getPhotosetDomains", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotosetReferrers":                 &MethodInfo{Name: "flickr.stats.getPhotosetReferrers", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotosetStats":                     &MethodInfo{Name: "flickr.stats.getPhotosetStats", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotostreamDomains":                &MethodInfo{Name: "flickr.stats.getPhotostreamDomains", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotostreamReferrers":              &MethodInfo{Name: "flickr.stats.getPhotostreamReferrers", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPhotostreamStats":                  &MethodInfo{Name: "flickr.stats.getPhotostreamStats", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getPopularPhotos":                     &MethodInfo{Name: "flickr.stats.getPopularPhotos", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.stats.getTotalViews":                        &MethodInfo{Name: "flickr.stats.getTotalViews", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.tags.getClusterPhotos":                      &MethodInfo{Name: "flickr.tags.getClusterPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getClusters":                           &MethodInfo{Name: "flickr.tags.getClusters", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getHotList":                            &MethodInfo{Name: "flickr.tags.getHotList", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getListPhoto":                          &MethodInfo{Name: "flickr.tags.getListPhoto", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getListUser":                           &MethodInfo{Name: "flickr.tags.getListUser", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getListUserPopular":                    &MethodInfo{Name: "flickr.tags.getListUserPopular", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getListUserRaw":                        &MethodInfo{Name: "flickr.tags.getListUserRaw", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.tags.getMostFrequentlyUsed":                 &MethodInfo{Name: "flickr.tags.getMostFrequentlyUsed", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.tags.getRelated":                            &MethodInfo{Name: "flickr.tags.getRelated", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.test.echo":                                  &MethodInfo{Name: "flickr.test.echo", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.test.login":                                 &MethodInfo{Name: "flickr.test.login", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.test.null":                                  &MethodInfo{Name: "flickr.test.null", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.addTestimonial":                &MethodInfo{Name: "flickr.testimonials.addTestimonial", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.testimonials.approveTestimonial":            &MethodInfo{Name: "flickr.testimonials.approveTestimonial", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.testimonials.deleteTestimonial":             &MethodInfo{Name: "flickr.testimonials.deleteTestimonial", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.testimonials.editTestimonial":               &MethodInfo{Name: "flickr.testimonials.editTestimonial", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "write"},
+	"flickr.testimonials.getAllTestimonialsAbout":       &MethodInfo{Name: "flickr.testimonials.getAllTestimonialsAbout", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getAllTestimonialsAboutBy":     &MethodInfo{Name: "flickr.testimonials.getAllTestimonialsAboutBy", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getAllTestimonialsBy":          &MethodInfo{Name: "flickr.testimonials.getAllTestimonialsBy", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getPendingTestimonialsAbout":   &MethodInfo{Name: "flickr.testimonials.getPendingTestimonialsAbout", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getPendingTestimonialsAboutBy": &MethodInfo{Name: "flickr.testimonials.getPendingTestimonialsAboutBy", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getPendingTestimonialsBy":      &MethodInfo{Name: "flickr.testimonials.getPendingTestimonialsBy", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getTestimonialsAbout":          &MethodInfo{Name: "flickr.testimonials.getTestimonialsAbout", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.testimonials.getTestimonialsAboutBy":        &MethodInfo{Name: "flickr.testimonials.getTestimonialsAboutBy", NeedsLogin: true, NeedsSigning: true, RequiredPerms: "read"},
+	"flickr.testimonials.getTestimonialsBy":             &MethodInfo{Name: "flickr.testimonials.getTestimonialsBy", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.urls.getGroup":                              &MethodInfo{Name: "flickr.urls.getGroup", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.urls.getUserPhotos":                         &MethodInfo{Name: "flickr.urls.getUserPhotos", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.urls.getUserProfile":                        &MethodInfo{Name: "flickr.urls.getUserProfile", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.urls.lookupGallery":                         &MethodInfo{Name: "flickr.urls.lookupGallery", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.urls.lookupGroup":                           &MethodInfo{Name: "flickr.urls.lookupGroup", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+	"flickr.urls.lookupUser":                            &MethodInfo{Name: "flickr.urls.lookupUser", NeedsLogin: false, NeedsSigning: false, RequiredPerms: "none"},
+}
+
+// Activity provides methods in the flickr.activity namespace.
+var Activity ActivityNamespace
+
+// Auth provides methods in the flickr.auth namespace.
+var Auth AuthNamespace
+
+// Blogs provides methods in the flickr.blogs namespace.
+var Blogs BlogsNamespace
+
+// Cameras provides methods in the flickr.cameras namespace.
+var Cameras CamerasNamespace
+
+// Collections provides methods in the flickr.collections namespace.
+var Collections CollectionsNamespace
+
+// Commons provides methods in the flickr.commons namespace.
+var Commons CommonsNamespace
+
+// Contacts provides methods in the flickr.contacts namespace.
+var Contacts ContactsNamespace
+
+// Favorites provides methods in the flickr.favorites namespace.
+var Favorites FavoritesNamespace
+
+// Galleries provides methods in the flickr.galleries namespace.
+var Galleries GalleriesNamespace
+
+// Groups provides methods in the flickr.groups namespace.
+var Groups GroupsNamespace
+
+// Interestingness provides methods in the flickr.interestingness namespace.
+var Interestingness InterestingnessNamespace
+
+// Machinetags provides methods in the flickr.machinetags namespace.
+var Machinetags MachinetagsNamespace
+
+// Panda provides methods in the flickr.panda namespace.
+var Panda PandaNamespace
+
+// People provides methods in the flickr.people namespace.
+var People PeopleNamespace
+
+// Photos provides methods in the flickr.photos namespace.
+var Photos PhotosNamespace
+
+// Photosets provides methods in the flickr.photosets namespace.
+var Photosets PhotosetsNamespace
+
+// Places provides methods in the flickr.places namespace.
+var Places PlacesNamespace
+
+// Prefs provides methods in the flickr.prefs namespace.
+var Prefs PrefsNamespace
+
+// Profile provides methods in the flickr.profile namespace.
+var Profile ProfileNamespace
+
+// Push provides methods in the flickr.push namespace.
+var Push PushNamespace
+
+// Reflection provides methods in the flickr.reflection namespace.
+var Reflection ReflectionNamespace
+
+// Stats provides methods in the flickr.stats namespace.
+var Stats StatsNamespace
+
+// Tags provides methods in the flickr.tags namespace.
+var Tags TagsNamespace
+
+// Test provides methods in the flickr.test namespace.
+var Test TestNamespace
+
+// Testimonials provides methods in the flickr.testimonials namespace.
+var Testimonials TestimonialsNamespace
+
+// Urls provides methods in the flickr.urls namespace.
+var Urls UrlsNamespace
+
+// ActivityNamespace provides methods in the flickr.activity namespace.
+type ActivityNamespace struct {
+}
+
+// AuthNamespace provides methods in the flickr.auth namespace.
+type AuthNamespace struct {
+	// Oauth provides methods in the flickr.auth.oauth namespace.
+	Oauth AuthOauthNamespace
+}
+
+// AuthOauthNamespace provides methods in the flickr.auth.oauth namespace.
+type AuthOauthNamespace struct {
+}
+
+// BlogsNamespace provides methods in the flickr.blogs namespace.
+type BlogsNamespace struct {
+}
+
+// CamerasNamespace provides methods in the flickr.cameras namespace.
+type CamerasNamespace struct {
+}
+
+// CollectionsNamespace provides methods in the flickr.collections namespace.
+type CollectionsNamespace struct {
+}
+
+// CommonsNamespace provides methods in the flickr.commons namespace.
+type CommonsNamespace struct {
+}
+
+// ContactsNamespace provides methods in the flickr.contacts namespace.
+type ContactsNamespace struct {
+}
+
+// FavoritesNamespace provides methods in the flickr.favorites namespace.
+type FavoritesNamespace struct {
+}
+
+// GalleriesNamespace provides methods in the flickr.galleries namespace.
+type GalleriesNamespace struct {
+}
+
+// GroupsNamespace provides methods in the flickr.groups namespace.
+type GroupsNamespace struct {
+	// Discuss provides methods in the flickr.groups.discuss namespace.
+	Discuss GroupsDiscussNamespace
+	// Members provides methods in the flickr.groups.members namespace.
+	Members GroupsMembersNamespace
+	// Pools provides methods in the flickr.groups.pools namespace.
+	Pools GroupsPoolsNamespace
+}
+
+// GroupsDiscussNamespace provides methods in the flickr.groups.discuss namespace.
+type GroupsDiscussNamespace struct {
+	// Replies provides methods in the flickr.groups.discuss.replies namespace.
+	Replies GroupsDiscussRepliesNamespace
+	// Topics provides methods in the flickr.groups.discuss.topics namespace.
+	Topics GroupsDiscussTopicsNamespace
+}
+
+// GroupsDiscussRepliesNamespace provides methods in the flickr.groups.discuss.replies namespace.
+type GroupsDiscussRepliesNamespace struct {
+}
+
+// GroupsDiscussTopicsNamespace provides methods in the flickr.groups.discuss.topics namespace.
+type GroupsDiscussTopicsNamespace struct {
+}
+
+// GroupsMembersNamespace provides methods in the flickr.groups.members namespace.
+type GroupsMembersNamespace struct {
+}
+
+// GroupsPoolsNamespace provides methods in the flickr.groups.pools namespace.
+type GroupsPoolsNamespace struct {
+}
+
+// InterestingnessNamespace provides methods in the flickr.interestingness namespace.
+type InterestingnessNamespace struct {
+}
+
+// MachinetagsNamespace provides methods in the flickr.machinetags namespace.
+type MachinetagsNamespace struct {
+}
+
+// PandaNamespace provides methods in the flickr.panda namespace.
+type PandaNamespace struct {
+}
+
+// PeopleNamespace provides methods in the flickr.people namespace.
+type PeopleNamespace struct {
+}
+
+// PhotosNamespace provides methods in the flickr.photos namespace.
+type PhotosNamespace struct {
+	// Comments provides methods in the flickr.photos.comments namespace.
+	Comments PhotosCommentsNamespace
+	// Geo provides methods in the flickr.photos.geo namespace.
+	Geo PhotosGeoNamespace
+	// Licenses provides methods in the flickr.photos.licenses namespace.
+	Licenses PhotosLicensesNamespace
+	// Notes provides methods in the flickr.photos.notes namespace.
+	Notes PhotosNotesNamespace
+	// People provides methods in the flickr.photos.people namespace.
+	People PhotosPeopleNamespace
+	// Suggestions provides methods in the flickr.photos.suggestions namespace.
+	Suggestions PhotosSuggestionsNamespace
+	// Transform provides methods in the flickr.photos.transform namespace.
+	Transform PhotosTransformNamespace
+	// Upload provides methods in the flickr.photos.upload namespace.
+	Upload PhotosUploadNamespace
+}
+
+// PhotosCommentsNamespace provides methods in the flickr.photos.comments namespace.
+type PhotosCommentsNamespace struct {
+}
+
+// PhotosGeoNamespace provides methods in the flickr.photos.geo namespace.
+type PhotosGeoNamespace struct {
+}
+
+// PhotosLicensesNamespace provides methods in the flickr.photos.licenses namespace.
+type PhotosLicensesNamespace struct {
+}
+
+// PhotosNotesNamespace provides methods in the flickr.photos.notes namespace.
+type PhotosNotesNamespace struct {
+}
+
+// PhotosPeopleNamespace provides methods in the flickr.photos.people namespace.
+type PhotosPeopleNamespace struct {
+}
+
+// PhotosSuggestionsNamespace provides methods in the flickr.photos.suggestions namespace.
+type PhotosSuggestionsNamespace struct {
+}
+
+// PhotosTransformNamespace provides methods in the flickr.photos.transform namespace.
+type PhotosTransformNamespace struct {
+}
+
+// PhotosUploadNamespace provides methods in the flickr.photos.upload namespace.
+type PhotosUploadNamespace struct {
+}
+
+// PhotosetsNamespace provides methods in the flickr.photosets namespace.
+type PhotosetsNamespace struct {
+	// Comments provides methods in the flickr.photosets.comments namespace.
+	Comments PhotosetsCommentsNamespace
+}
+
+// PhotosetsCommentsNamespace provides methods in the flickr.photosets.comments namespace.
+type PhotosetsCommentsNamespace struct {
+}
+
+// PlacesNamespace provides methods in the flickr.places namespace.
+type PlacesNamespace struct {
+}
+
+// PrefsNamespace provides methods in the flickr.prefs namespace.
+type PrefsNamespace struct {
+}
+
+// ProfileNamespace provides methods in the flickr.profile namespace.
+type ProfileNamespace struct {
+}
+
+// PushNamespace provides methods in the flickr.push namespace.
+type PushNamespace struct {
+}
+
+// ReflectionNamespace provides methods in the flickr.reflection namespace.
+type ReflectionNamespace struct {
+}
+
+// StatsNamespace provides methods in the flickr.stats namespace.
+type StatsNamespace struct {
+}
+
+// TagsNamespace provides methods in the flickr.tags namespace.
+type TagsNamespace struct {
+}
+
+// TestNamespace provides methods in the flickr.test namespace.
+type TestNamespace struct {
+}
+
+// TestimonialsNamespace provides methods in the flickr.testimonials namespace.
+type TestimonialsNamespace struct {
+}
+
+// UrlsNamespace provides methods in the flickr.urls namespace.
+type UrlsNamespace struct {
+}
+
+// ActivityUserCommentsArgs is a struct containing the arguments for the flickr.activity.userComments method.
+type ActivityUserCommentsArgs struct {
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ActivityUserCommentsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// ActivityUserCommentsResponse is a struct containing the response for the flickr.activity.userComments method.
+type ActivityUserCommentsResponse struct {
+	*Response
+}
+
+// UserComments calls the flickr.activity.userComments method. Returns a list of recent activity on photos commented on by the calling user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (ActivityNamespace) UserComments(ctx context.Context, cl client.Client, args ActivityUserCommentsArgs) (*ActivityUserCommentsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.activity.userComments", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ActivityUserCommentsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ActivityUserPhotosArgs is a struct containing the arguments for the flickr.activity.userPhotos method.
+type ActivityUserPhotosArgs struct {
+	// The timeframe argument.
+	Timeframe string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ActivityUserPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Timeframe != "" {
+		args.Set("timeframe", a.Timeframe)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// ActivityUserPhotosResponse is a struct containing the response for the flickr.activity.userPhotos method.
+type ActivityUserPhotosResponse struct {
+	*Response
+}
+
+// UserPhotos calls the flickr.activity.userPhotos method. Returns a list of recent activity on photos belonging to the calling user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (ActivityNamespace) UserPhotos(ctx context.Context, cl client.Client, args ActivityUserPhotosArgs) (*ActivityUserPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.activity.userPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ActivityUserPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// AuthCheckTokenArgs is a struct containing the arguments for the flickr.auth.checkToken method.
+type AuthCheckTokenArgs struct {
+	// The auth_token argument. Required.
+	AuthToken string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a AuthCheckTokenArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.AuthToken == "" {
+		return nil, fmt.Errorf("Missing required auth_token argument")
+	}
+
+	args.Set("auth_token", a.AuthToken)
+
+	return args, nil
+}
+
+// AuthCheckTokenResponse is a struct containing the response for the flickr.auth.checkToken method.
+type AuthCheckTokenResponse struct {
+	*Response
+}
+
+// CheckToken calls the flickr.auth.checkToken method. Returns the credentials attached to an authentication token. Requires a signed request.
+func (AuthNamespace) CheckToken(ctx context.Context, cl client.Client, args AuthCheckTokenArgs) (*AuthCheckTokenResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.auth.checkToken", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AuthCheckTokenResponse{Response: rsp}
+
+	return r, nil
+}
+
+// AuthGetFrobArgs is a struct containing the arguments for the flickr.auth.getFrob method.
+type AuthGetFrobArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a AuthGetFrobArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// AuthGetFrobResponse is a struct containing the response for the flickr.auth.getFrob method.
+type AuthGetFrobResponse struct {
+	*Response
+}
+
+// GetFrob calls the flickr.auth.getFrob method. Returns a frob to be used during authentication. Requires a signed request.
+func (AuthNamespace) GetFrob(ctx context.Context, cl client.Client, args AuthGetFrobArgs) (*AuthGetFrobResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.auth.getFrob", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AuthGetFrobResponse{Response: rsp}
+
+	return r, nil
+}
+
+// AuthGetFullTokenArgs is a struct containing the arguments for the flickr.auth.getFullToken method.
+type AuthGetFullTokenArgs struct {
+	// The mini_token argument. Required.
+	MiniToken string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a AuthGetFullTokenArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MiniToken == "" {
+		return nil, fmt.Errorf("Missing required mini_token argument")
+	}
+
+	args.Set("mini_token", a.MiniToken)
+
+	return args, nil
+}
+
+// AuthGetFullTokenResponse is a struct containing the response for the flickr.auth.getFullToken method.
+type AuthGetFullTokenResponse struct {
+	*Response
+}
+
+// GetFullToken calls the flickr.auth.getFullToken method. Get the full authentication token for a mini-token. Requires a signed request.
+func (AuthNamespace) GetFullToken(ctx context.Context, cl client.Client, args AuthGetFullTokenArgs) (*AuthGetFullTokenResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.auth.getFullToken", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AuthGetFullTokenResponse{Response: rsp}
+
+	return r, nil
+}
+
+// AuthGetTokenArgs is a struct containing the arguments for the flickr.auth.getToken method.
+type AuthGetTokenArgs struct {
+	// The frob argument. Required.
+	Frob string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a AuthGetTokenArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Frob == "" {
+		return nil, fmt.Errorf("Missing required frob argument")
+	}
+
+	args.Set("frob", a.Frob)
+
+	return args, nil
+}
+
+// AuthGetTokenResponse is a struct containing the response for the flickr.auth.getToken method.
+type AuthGetTokenResponse struct {
+	*Response
+}
+
+// GetToken calls the flickr.auth.getToken method. Returns the auth token for the given frob, if one has been attached. Requires a signed request.
+func (AuthNamespace) GetToken(ctx context.Context, cl client.Client, args AuthGetTokenArgs) (*AuthGetTokenResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.auth.getToken", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AuthGetTokenResponse{Response: rsp}
+
+	return r, nil
+}
+
+// AuthOauthCheckTokenArgs is a struct containing the arguments for the flickr.auth.oauth.checkToken method.
+type AuthOauthCheckTokenArgs struct {
+	// The oauth_token argument. Required.
+	OauthToken string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a AuthOauthCheckTokenArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.OauthToken == "" {
+		return nil, fmt.Errorf("Missing required oauth_token argument")
+	}
+
+	args.Set("oauth_token", a.OauthToken)
+
+	return args, nil
+}
+
+// AuthOauthCheckTokenResponse is a struct containing the response for the flickr.auth.oauth.checkToken method.
+type AuthOauthCheckTokenResponse struct {
+	*Response
+}
+
+// CheckToken calls the flickr.auth.oauth.checkToken method. Returns the credentials attached to an OAuth authentication token. Requires a signed request.
+func (AuthOauthNamespace) CheckToken(ctx context.Context, cl client.Client, args AuthOauthCheckTokenArgs) (*AuthOauthCheckTokenResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.auth.oauth.checkToken", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AuthOauthCheckTokenResponse{Response: rsp}
+
+	return r, nil
+}
+
+// AuthOauthGetAccessTokenArgs is a struct containing the arguments for the flickr.auth.oauth.getAccessToken method.
+type AuthOauthGetAccessTokenArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a AuthOauthGetAccessTokenArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// AuthOauthGetAccessTokenResponse is a struct containing the response for the flickr.auth.oauth.getAccessToken method.
+type AuthOauthGetAccessTokenResponse struct {
+	*Response
+}
+
+// GetAccessToken calls the flickr.auth.oauth.getAccessToken method. Exchanges a legacy authentication token for an OAuth access token. Requires a signed request.
+func (AuthOauthNamespace) GetAccessToken(ctx context.Context, cl client.Client, args AuthOauthGetAccessTokenArgs) (*AuthOauthGetAccessTokenResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.auth.oauth.getAccessToken", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AuthOauthGetAccessTokenResponse{Response: rsp}
+
+	return r, nil
+}
+
+// BlogsGetListArgs is a struct containing the arguments for the flickr.blogs.getList method.
+type BlogsGetListArgs struct {
+	// The service argument.
+	Service string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a BlogsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Service != "" {
+		args.Set("service", a.Service)
+	}
+
+	return args, nil
+}
+
+// BlogsGetListResponse is a struct containing the response for the flickr.blogs.getList method.
+type BlogsGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.blogs.getList method. Get a list of configured blogs for the calling user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (BlogsNamespace) GetList(ctx context.Context, cl client.Client, args BlogsGetListArgs) (*BlogsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.blogs.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &BlogsGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// BlogsGetServicesArgs is a struct containing the arguments for the flickr.blogs.getServices method.
+type BlogsGetServicesArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a BlogsGetServicesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// BlogsGetServicesResponse is a struct containing the response for the flickr.blogs.getServices method.
+type BlogsGetServicesResponse struct {
+	*Response
+}
+
+// GetServices calls the flickr.blogs.getServices method. Return a list of Flickr supported blogging services.
+func (BlogsNamespace) GetServices(ctx context.Context, cl client.Client, args BlogsGetServicesArgs) (*BlogsGetServicesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.blogs.getServices", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &BlogsGetServicesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// BlogsPostPhotoArgs is a struct containing the arguments for the flickr.blogs.postPhoto method.
+type BlogsPostPhotoArgs struct {
+	// The blog_id argument.
+	BlogId string
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The title for the photo. At least one of title or description must be set. Required.
+	Title string
+	// The description for the photo. At least one of title or description must be set. Required.
+	Description string
+	// The blog_password argument.
+	BlogPassword string
+	// The service argument.
+	Service string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a BlogsPostPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.BlogId != "" {
+		args.Set("blog_id", a.BlogId)
+	}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Title == "" {
+		return nil, fmt.Errorf("Missing required title argument")
+	}
+
+	args.Set("title", a.Title)
+
+	if a.Description == "" {
+		return nil, fmt.Errorf("Missing required description argument")
+	}
+
+	args.Set("description", a.Description)
+
+	if a.BlogPassword != "" {
+		args.Set("blog_password", a.BlogPassword)
+	}
+
+	if a.Service != "" {
+		args.Set("service", a.Service)
+	}
+
+	return args, nil
+}
+
+// BlogsPostPhotoResponse is a struct containing the response for the flickr.blogs.postPhoto method.
+type BlogsPostPhotoResponse struct {
+	*Response
+}
+
+// PostPhoto calls the flickr.blogs.postPhoto method. Post a photo to a configured blog. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (BlogsNamespace) PostPhoto(ctx context.Context, cl client.Client, args BlogsPostPhotoArgs) (*BlogsPostPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.blogs.postPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &BlogsPostPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// CamerasGetBrandModelsArgs is a struct containing the arguments for the flickr.cameras.getBrandModels method.
+type CamerasGetBrandModelsArgs struct {
+	// The brand argument. Required.
+	Brand string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a CamerasGetBrandModelsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Brand == "" {
+		return nil, fmt.Errorf("Missing required brand argument")
+	}
+
+	args.Set("brand", a.Brand)
+
+	return args, nil
+}
+
+// CamerasGetBrandModelsResponse is a struct containing the response for the flickr.cameras.getBrandModels method.
+type CamerasGetBrandModelsResponse struct {
+	*Response
+}
+
+// GetBrandModels calls the flickr.cameras.getBrandModels method. Retrieve all the models for a given camera brand.
+func (CamerasNamespace) GetBrandModels(ctx context.Context, cl client.Client, args CamerasGetBrandModelsArgs) (*CamerasGetBrandModelsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.cameras.getBrandModels", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &CamerasGetBrandModelsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// CamerasGetBrandsArgs is a struct containing the arguments for the flickr.cameras.getBrands method.
+type CamerasGetBrandsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a CamerasGetBrandsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// CamerasGetBrandsResponse is a struct containing the response for the flickr.cameras.getBrands method.
+type CamerasGetBrandsResponse struct {
+	*Response
+}
+
+// GetBrands calls the flickr.cameras.getBrands method. Returns all the brands of cameras that Flickr knows about.
+func (CamerasNamespace) GetBrands(ctx context.Context, cl client.Client, args CamerasGetBrandsArgs) (*CamerasGetBrandsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.cameras.getBrands", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &CamerasGetBrandsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// CollectionsGetInfoArgs is a struct containing the arguments for the flickr.collections.getInfo method.
+type CollectionsGetInfoArgs struct {
+	// The ID of the collection. Required.
+	CollectionId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a CollectionsGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CollectionId == "" {
+		return nil, fmt.Errorf("Missing required collection_id argument")
+	}
+
+	args.Set("collection_id", a.CollectionId)
+
+	return args, nil
+}
+
+// CollectionsGetInfoResponse is a struct containing the response for the flickr.collections.getInfo method.
+type CollectionsGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.collections.getInfo method. Returns information for a single collection. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (CollectionsNamespace) GetInfo(ctx context.Context, cl client.Client, args CollectionsGetInfoArgs) (*CollectionsGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.collections.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &CollectionsGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// CollectionsGetTreeArgs is a struct containing the arguments for the flickr.collections.getTree method.
+type CollectionsGetTreeArgs struct {
+	// The ID of the collection.
+	CollectionId string
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a CollectionsGetTreeArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CollectionId != "" {
+		args.Set("collection_id", a.CollectionId)
+	}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	return args, nil
+}
+
+// CollectionsGetTreeResponse is a struct containing the response for the flickr.collections.getTree method.
+type CollectionsGetTreeResponse struct {
+	*Response
+}
+
+// GetTree calls the flickr.collections.getTree method. Returns a tree (or sub tree) of collections belonging to a given user.
+func (CollectionsNamespace) GetTree(ctx context.Context, cl client.Client, args CollectionsGetTreeArgs) (*CollectionsGetTreeResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.collections.getTree", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &CollectionsGetTreeResponse{Response: rsp}
+
+	return r, nil
+}
+
+// CommonsGetInstitutionsArgs is a struct containing the arguments for the flickr.commons.getInstitutions method.
+type CommonsGetInstitutionsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a CommonsGetInstitutionsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// CommonsGetInstitutionsResponse is a struct containing the response for the flickr.commons.getInstitutions method.
+type CommonsGetInstitutionsResponse struct {
+	*Response
+}
+
+// GetInstitutions calls the flickr.commons.getInstitutions method. Retrieves a list of the current Commons institutions.
+func (CommonsNamespace) GetInstitutions(ctx context.Context, cl client.Client, args CommonsGetInstitutionsArgs) (*CommonsGetInstitutionsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.commons.getInstitutions", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &CommonsGetInstitutionsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ContactsGetListArgs is a struct containing the arguments for the flickr.contacts.getList method.
+type ContactsGetListArgs struct {
+	// The filter argument.
+	Filter string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The order in which to sort returned photos. Defaults to date-posted-desc (unless you are doing a radial geo query, in which case the default sorting is by ascending distance from the point specified). The possible values are: date-posted-asc, date-posted-desc, date-taken-asc, date-taken-desc, interestingness-desc, interestingness-asc, and relevance.
+	Sort string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ContactsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Filter != "" {
+		args.Set("filter", a.Filter)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Sort != "" {
+		args.Set("sort", a.Sort)
+	}
+
+	return args, nil
+}
+
+// ContactsGetListResponse is a struct containing the response for the flickr.contacts.getList method.
+type ContactsGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.contacts.getList method. Get a list of contacts for the calling user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (ContactsNamespace) GetList(ctx context.Context, cl client.Client, args ContactsGetListArgs) (*ContactsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.contacts.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ContactsGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ContactsGetListRecentlyUploadedArgs is a struct containing the arguments for the flickr.contacts.getListRecentlyUploaded method.
+type ContactsGetListRecentlyUploadedArgs struct {
+	// The date_lastupload argument.
+	DateLastupload string
+	// The filter argument.
+	Filter string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ContactsGetListRecentlyUploadedArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.DateLastupload != "" {
+		args.Set("date_lastupload", a.DateLastupload)
+	}
+
+	if a.Filter != "" {
+		args.Set("filter", a.Filter)
+	}
+
+	return args, nil
+}
+
+// ContactsGetListRecentlyUploadedResponse is a struct containing the response for the flickr.contacts.getListRecentlyUploaded method.
+type ContactsGetListRecentlyUploadedResponse struct {
+	*Response
+}
+
+// GetListRecentlyUploaded calls the flickr.contacts.getListRecentlyUploaded method. Return a list of contacts for a user who have recently uploaded photos along with the total count of photos uploaded. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (ContactsNamespace) GetListRecentlyUploaded(ctx context.Context, cl client.Client, args ContactsGetListRecentlyUploadedArgs) (*ContactsGetListRecentlyUploadedResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.contacts.getListRecentlyUploaded", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ContactsGetListRecentlyUploadedResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ContactsGetPublicListArgs is a struct containing the arguments for the flickr.contacts.getPublicList method.
+type ContactsGetPublicListArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ContactsGetPublicListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// ContactsGetPublicListResponse is a struct containing the response for the flickr.contacts.getPublicList method.
+type ContactsGetPublicListResponse struct {
+	*Response
+}
+
+// GetPublicList calls the flickr.contacts.getPublicList method. Get the contact list for a user.
+func (ContactsNamespace) GetPublicList(ctx context.Context, cl client.Client, args ContactsGetPublicListArgs) (*ContactsGetPublicListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.contacts.getPublicList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ContactsGetPublicListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ContactsGetTaggingSuggestionsArgs is a struct containing the arguments for the flickr.contacts.getTaggingSuggestions method.
+type ContactsGetTaggingSuggestionsArgs struct {
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ContactsGetTaggingSuggestionsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// ContactsGetTaggingSuggestionsResponse is a struct containing the response for the flickr.contacts.getTaggingSuggestions method.
+type ContactsGetTaggingSuggestionsResponse struct {
+	*Response
+}
+
+// GetTaggingSuggestions calls the flickr.contacts.getTaggingSuggestions method. Get suggestions for tagging people in photos based on the calling user's contacts. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (ContactsNamespace) GetTaggingSuggestions(ctx context.Context, cl client.Client, args ContactsGetTaggingSuggestionsArgs) (*ContactsGetTaggingSuggestionsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.contacts.getTaggingSuggestions", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ContactsGetTaggingSuggestionsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// FavoritesAddArgs is a struct containing the arguments for the flickr.favorites.add method.
+type FavoritesAddArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a FavoritesAddArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// FavoritesAddResponse is a struct containing the response for the flickr.favorites.add method.
+type FavoritesAddResponse struct {
+	*Response
+}
+
+// Add calls the flickr.favorites.add method. Adds a photo to a user's favorites list. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (FavoritesNamespace) Add(ctx context.Context, cl client.Client, args FavoritesAddArgs) (*FavoritesAddResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.favorites.add", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &FavoritesAddResponse{Response: rsp}
+
+	return r, nil
+}
+
+// FavoritesGetContextArgs is a struct containing the arguments for the flickr.favorites.getContext method.
+type FavoritesGetContextArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a FavoritesGetContextArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// FavoritesGetContextResponse is a struct containing the response for the flickr.favorites.getContext method.
+type FavoritesGetContextResponse struct {
+	*Response
+}
+
+// GetContext calls the flickr.favorites.getContext method. Returns next and previous favorites for a photo in a user's favorites.
+func (FavoritesNamespace) GetContext(ctx context.Context, cl client.Client, args FavoritesGetContextArgs) (*FavoritesGetContextResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.favorites.getContext", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &FavoritesGetContextResponse{Response: rsp}
+
+	return r, nil
+}
+
+// FavoritesGetListArgs is a struct containing the arguments for the flickr.favorites.getList method.
+type FavoritesGetListArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+	// Minimum date that a photo was favorited on. The date should be in the form of a unix timestamp.
+	MinFaveDate string
+	// Maximum date that a photo was favorited on. The date should be in the form of a unix timestamp.
+	MaxFaveDate string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a FavoritesGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	if a.MinFaveDate != "" {
+		args.Set("min_fave_date", a.MinFaveDate)
+	}
+
+	if a.MaxFaveDate != "" {
+		args.Set("max_fave_date", a.MaxFaveDate)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// FavoritesGetListResponse is a struct containing the response for the flickr.favorites.getList method.
+type FavoritesGetListResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetList calls the flickr.favorites.getList method. Returns a list of the user's favorite photos. Only photos which the calling user has permission to see are returned.
+func (FavoritesNamespace) GetList(ctx context.Context, cl client.Client, args FavoritesGetListArgs) (*FavoritesGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.favorites.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &FavoritesGetListResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.favorites.getList response, %w", err)
+	}
+
+	return r, nil
+}
+
+// FavoritesGetPublicListArgs is a struct containing the arguments for the flickr.favorites.getPublicList method.
+type FavoritesGetPublicListArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// Minimum date that a photo was favorited on. The date should be in the form of a unix timestamp.
+	MinFaveDate string
+	// Maximum date that a photo was favorited on. The date should be in the form of a unix timestamp.
+	MaxFaveDate string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a FavoritesGetPublicListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.MinFaveDate != "" {
+		args.Set("min_fave_date", a.MinFaveDate)
+	}
+
+	if a.MaxFaveDate != "" {
+		args.Set("max_fave_date", a.MaxFaveDate)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// FavoritesGetPublicListResponse is a struct containing the response for the flickr.favorites.getPublicList method.
+type FavoritesGetPublicListResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPublicList calls the flickr.favorites.getPublicList method. Returns a list of favorite public photos for the given user.
+func (FavoritesNamespace) GetPublicList(ctx context.Context, cl client.Client, args FavoritesGetPublicListArgs) (*FavoritesGetPublicListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.favorites.getPublicList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &FavoritesGetPublicListResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.favorites.getPublicList response, %w", err)
+	}
+
+	return r, nil
+}
+
+// FavoritesRemoveArgs is a struct containing the arguments for the flickr.favorites.remove method.
+type FavoritesRemoveArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a FavoritesRemoveArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// FavoritesRemoveResponse is a struct containing the response for the flickr.favorites.remove method.
+type FavoritesRemoveResponse struct {
+	*Response
+}
+
+// Remove calls the flickr.favorites.remove method. Removes a photo from a user's favorites list. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (FavoritesNamespace) Remove(ctx context.Context, cl client.Client, args FavoritesRemoveArgs) (*FavoritesRemoveResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.favorites.remove", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &FavoritesRemoveResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesAddPhotoArgs is a struct containing the arguments for the flickr.galleries.addPhoto method.
+type GalleriesAddPhotoArgs struct {
+	// The ID of the gallery to add a photo to. Note: this is the compound ID returned in methods like flickr.galleries.getList, and flickr.galleries.getListForPhoto. Required.
+	GalleryId string
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// A short comment or story to accompany the photo.
+	Comment string
+	// If specified, return updated details of the gallery the photo was added to
+	FullResponse string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesAddPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GalleryId == "" {
+		return nil, fmt.Errorf("Missing required gallery_id argument")
+	}
+
+	args.Set("gallery_id", a.GalleryId)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Comment != "" {
+		args.Set("comment", a.Comment)
+	}
+
+	if a.FullResponse != "" {
+		args.Set("full_response", a.FullResponse)
+	}
+
+	return args, nil
+}
+
+// GalleriesAddPhotoResponse is a struct containing the response for the flickr.galleries.addPhoto method.
+type GalleriesAddPhotoResponse struct {
+	*Response
+}
+
+// AddPhoto calls the flickr.galleries.addPhoto method. Add a photo to a gallery. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GalleriesNamespace) AddPhoto(ctx context.Context, cl client.Client, args GalleriesAddPhotoArgs) (*GalleriesAddPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.addPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesAddPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesCreateArgs is a struct containing the arguments for the flickr.galleries.create method.
+type GalleriesCreateArgs struct {
+	// The title for the photo. At least one of title or description must be set. Required.
+	Title string
+	// The description for the photo. At least one of title or description must be set. Required.
+	Description string
+	// The id of the photo to represent this set. The photo must belong to the calling user.
+	PrimaryPhotoId string
+	// The full_result argument.
+	FullResult string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesCreateArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Title == "" {
+		return nil, fmt.Errorf("Missing required title argument")
+	}
+
+	args.Set("title", a.Title)
+
+	if a.Description == "" {
+		return nil, fmt.Errorf("Missing required description argument")
+	}
+
+	args.Set("description", a.Description)
+
+	if a.PrimaryPhotoId != "" {
+		args.Set("primary_photo_id", a.PrimaryPhotoId)
+	}
+
+	if a.FullResult != "" {
+		args.Set("full_result", a.FullResult)
+	}
+
+	return args, nil
+}
+
+// GalleriesCreateResponse is a struct containing the response for the flickr.galleries.create method.
+type GalleriesCreateResponse struct {
+	*Response
+}
+
+// Create calls the flickr.galleries.create method. Create a new gallery for the calling user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GalleriesNamespace) Create(ctx context.Context, cl client.Client, args GalleriesCreateArgs) (*GalleriesCreateResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.create", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesCreateResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesEditMetaArgs is a struct containing the arguments for the flickr.galleries.editMeta method.
+type GalleriesEditMetaArgs struct {
+	// The ID of the gallery to add a photo to. Note: this is the compound ID returned in methods like flickr.galleries.getList, and flickr.galleries.getListForPhoto. Required.
+	GalleryId string
+	// The title for the photo. At least one of title or description must be set. Required.
+	Title string
+	// The description for the photo. At least one of title or description must be set.
+	Description string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesEditMetaArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GalleryId == "" {
+		return nil, fmt.Errorf("Missing required gallery_id argument")
+	}
+
+	args.Set("gallery_id", a.GalleryId)
+
+	if a.Title == "" {
+		return nil, fmt.Errorf("Missing required title argument")
+	}
+
+	args.Set("title", a.Title)
+
+	if a.Description != "" {
+		args.Set("description", a.Description)
+	}
+
+	return args, nil
+}
+
+// GalleriesEditMetaResponse is a struct containing the response for the flickr.galleries.editMeta method.
+type GalleriesEditMetaResponse struct {
+	*Response
+}
+
+// EditMeta calls the flickr.galleries.editMeta method. Modify the meta-data for a gallery. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GalleriesNamespace) EditMeta(ctx context.Context, cl client.Client, args GalleriesEditMetaArgs) (*GalleriesEditMetaResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.editMeta", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesEditMetaResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesEditPhotoArgs is a struct containing the arguments for the flickr.galleries.editPhoto method.
+type GalleriesEditPhotoArgs struct {
+	// The ID of the gallery to add a photo to. Note: this is the compound ID returned in methods like flickr.galleries.getList, and flickr.galleries.getListForPhoto. Required.
+	GalleryId string
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// A short comment or story to accompany the photo. Required.
+	Comment string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesEditPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GalleryId == "" {
+		return nil, fmt.Errorf("Missing required gallery_id argument")
+	}
+
+	args.Set("gallery_id", a.GalleryId)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Comment == "" {
+		return nil, fmt.Errorf("Missing required comment argument")
+	}
+
+	args.Set("comment", a.Comment)
+
+	return args, nil
+}
+
+// GalleriesEditPhotoResponse is a struct containing the response for the flickr.galleries.editPhoto method.
+type GalleriesEditPhotoResponse struct {
+	*Response
+}
+
+// EditPhoto calls the flickr.galleries.editPhoto method. Edit the comment for a gallery photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GalleriesNamespace) EditPhoto(ctx context.Context, cl client.Client, args GalleriesEditPhotoArgs) (*GalleriesEditPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.editPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesEditPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesEditPhotosArgs is a struct containing the arguments for the flickr.galleries.editPhotos method.
+type GalleriesEditPhotosArgs struct {
+	// The ID of the gallery to add a photo to. Note: this is the compound ID returned in methods like flickr.galleries.getList, and flickr.galleries.getListForPhoto. Required.
+	GalleryId string
+	// The id of the photo to represent this set. The photo must belong to the calling user. Required.
+	PrimaryPhotoId string
+	// A comma-separated list of photo ids. If specified, each returned set will include a list of these photo ids that are present in the set as "has_requested_photos" Required.
+	PhotoIds string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesEditPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GalleryId == "" {
+		return nil, fmt.Errorf("Missing required gallery_id argument")
+	}
+
+	args.Set("gallery_id", a.GalleryId)
+
+	if a.PrimaryPhotoId == "" {
+		return nil, fmt.Errorf("Missing required primary_photo_id argument")
+	}
+
+	args.Set("primary_photo_id", a.PrimaryPhotoId)
+
+	if a.PhotoIds == "" {
+		return nil, fmt.Errorf("Missing required photo_ids argument")
+	}
+
+	args.Set("photo_ids", a.PhotoIds)
+
+	return args, nil
+}
+
+// GalleriesEditPhotosResponse is a struct containing the response for the flickr.galleries.editPhotos method.
+type GalleriesEditPhotosResponse struct {
+	*Response
+}
+
+// EditPhotos calls the flickr.galleries.editPhotos method. Modify the photos in a gallery. Use this method to add, remove and re-order photos. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GalleriesNamespace) EditPhotos(ctx context.Context, cl client.Client, args GalleriesEditPhotosArgs) (*GalleriesEditPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.editPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesEditPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesGetInfoArgs is a struct containing the arguments for the flickr.galleries.getInfo method.
+type GalleriesGetInfoArgs struct {
+	// The ID of the gallery to add a photo to. Note: this is the compound ID returned in methods like flickr.galleries.getList, and flickr.galleries.getListForPhoto. Required.
+	GalleryId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GalleryId == "" {
+		return nil, fmt.Errorf("Missing required gallery_id argument")
+	}
+
+	args.Set("gallery_id", a.GalleryId)
+
+	return args, nil
+}
+
+// GalleriesGetInfoResponse is a struct containing the response for the flickr.galleries.getInfo method.
+type GalleriesGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.galleries.getInfo method. Returns information about a gallery.
+func (GalleriesNamespace) GetInfo(ctx context.Context, cl client.Client, args GalleriesGetInfoArgs) (*GalleriesGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesGetListArgs is a struct containing the arguments for the flickr.galleries.getList method.
+type GalleriesGetListArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// A comma-delimited list of extra information to fetch for the primary photo.
+	PrimaryPhotoExtras string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PrimaryPhotoExtras != "" {
+		args.Set("primary_photo_extras", a.PrimaryPhotoExtras)
+	}
+
+	return args, nil
+}
+
+// GalleriesGetListResponse is a struct containing the response for the flickr.galleries.getList method.
+type GalleriesGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.galleries.getList method. Return the list of galleries created by a user. Sorted from newest to oldest.
+func (GalleriesNamespace) GetList(ctx context.Context, cl client.Client, args GalleriesGetListArgs) (*GalleriesGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesGetListForPhotoArgs is a struct containing the arguments for the flickr.galleries.getListForPhoto method.
+type GalleriesGetListForPhotoArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesGetListForPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GalleriesGetListForPhotoResponse is a struct containing the response for the flickr.galleries.getListForPhoto method.
+type GalleriesGetListForPhotoResponse struct {
+	*Response
+}
+
+// GetListForPhoto calls the flickr.galleries.getListForPhoto method. Return the list of galleries to which a photo has been added. Galleries are returned sorted by date which the photo was added to the gallery.
+func (GalleriesNamespace) GetListForPhoto(ctx context.Context, cl client.Client, args GalleriesGetListForPhotoArgs) (*GalleriesGetListForPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.getListForPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesGetListForPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GalleriesGetPhotosArgs is a struct containing the arguments for the flickr.galleries.getPhotos method.
+type GalleriesGetPhotosArgs struct {
+	// The ID of the gallery to add a photo to. Note: this is the compound ID returned in methods like flickr.galleries.getList, and flickr.galleries.getListForPhoto. Required.
+	GalleryId string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GalleriesGetPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GalleryId == "" {
+		return nil, fmt.Errorf("Missing required gallery_id argument")
+	}
+
+	args.Set("gallery_id", a.GalleryId)
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GalleriesGetPhotosResponse is a struct containing the response for the flickr.galleries.getPhotos method.
+type GalleriesGetPhotosResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPhotos calls the flickr.galleries.getPhotos method. Return the list of photos for a gallery.
+func (GalleriesNamespace) GetPhotos(ctx context.Context, cl client.Client, args GalleriesGetPhotosArgs) (*GalleriesGetPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.galleries.getPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GalleriesGetPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.galleries.getPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// GroupsBrowseArgs is a struct containing the arguments for the flickr.groups.browse method.
+type GroupsBrowseArgs struct {
+	// The cat_id argument.
+	CatId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsBrowseArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CatId != "" {
+		args.Set("cat_id", a.CatId)
+	}
+
+	return args, nil
+}
+
+// GroupsBrowseResponse is a struct containing the response for the flickr.groups.browse method.
+type GroupsBrowseResponse struct {
+	*Response
+}
+
+// Browse calls the flickr.groups.browse method. Browse the group category tree, finding groups and sub-categories. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsNamespace) Browse(ctx context.Context, cl client.Client, args GroupsBrowseArgs) (*GroupsBrowseResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.browse", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsBrowseResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussRepliesAddArgs is a struct containing the arguments for the flickr.groups.discuss.replies.add method.
+type GroupsDiscussRepliesAddArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The ID of the topic. Required.
+	TopicId string
+	// The message argument. Required.
+	Message string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussRepliesAddArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.TopicId == "" {
+		return nil, fmt.Errorf("Missing required topic_id argument")
+	}
+
+	args.Set("topic_id", a.TopicId)
+
+	if a.Message == "" {
+		return nil, fmt.Errorf("Missing required message argument")
+	}
+
+	args.Set("message", a.Message)
+
+	return args, nil
+}
+
+// GroupsDiscussRepliesAddResponse is a struct containing the response for the flickr.groups.discuss.replies.add method.
+type GroupsDiscussRepliesAddResponse struct {
+	*Response
+}
+
+// Add calls the flickr.groups.discuss.replies.add method. Post a new reply to a group discussion topic. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsDiscussRepliesNamespace) Add(ctx context.Context, cl client.Client, args GroupsDiscussRepliesAddArgs) (*GroupsDiscussRepliesAddResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.replies.add", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussRepliesAddResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussRepliesDeleteArgs is a struct containing the arguments for the flickr.groups.discuss.replies.delete method.
+type GroupsDiscussRepliesDeleteArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The ID of the topic. Required.
+	TopicId string
+	// The ID of the reply. Required.
+	ReplyId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussRepliesDeleteArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.TopicId == "" {
+		return nil, fmt.Errorf("Missing required topic_id argument")
+	}
+
+	args.Set("topic_id", a.TopicId)
+
+	if a.ReplyId == "" {
+		return nil, fmt.Errorf("Missing required reply_id argument")
+	}
+
+	args.Set("reply_id", a.ReplyId)
+
+	return args, nil
+}
+
+// GroupsDiscussRepliesDeleteResponse is a struct containing the response for the flickr.groups.discuss.replies.delete method.
+type GroupsDiscussRepliesDeleteResponse struct {
+	*Response
+}
+
+// Delete calls the flickr.groups.discuss.replies.delete method. Delete a reply from a group topic. Requires "delete" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsDiscussRepliesNamespace) Delete(ctx context.Context, cl client.Client, args GroupsDiscussRepliesDeleteArgs) (*GroupsDiscussRepliesDeleteResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.replies.delete", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussRepliesDeleteResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussRepliesEditArgs is a struct containing the arguments for the flickr.groups.discuss.replies.edit method.
+type GroupsDiscussRepliesEditArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The ID of the topic. Required.
+	TopicId string
+	// The ID of the reply. Required.
+	ReplyId string
+	// The message argument. Required.
+	Message string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussRepliesEditArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.TopicId == "" {
+		return nil, fmt.Errorf("Missing required topic_id argument")
+	}
+
+	args.Set("topic_id", a.TopicId)
+
+	if a.ReplyId == "" {
+		return nil, fmt.Errorf("Missing required reply_id argument")
+	}
+
+	args.Set("reply_id", a.ReplyId)
+
+	if a.Message == "" {
+		return nil, fmt.Errorf("Missing required message argument")
+	}
+
+	args.Set("message", a.Message)
+
+	return args, nil
+}
+
+// GroupsDiscussRepliesEditResponse is a struct containing the response for the flickr.groups.discuss.replies.edit method.
+type GroupsDiscussRepliesEditResponse struct {
+	*Response
+}
+
+// Edit calls the flickr.groups.discuss.replies.edit method. Edit a topic reply. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsDiscussRepliesNamespace) Edit(ctx context.Context, cl client.Client, args GroupsDiscussRepliesEditArgs) (*GroupsDiscussRepliesEditResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.replies.edit", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussRepliesEditResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussRepliesGetInfoArgs is a struct containing the arguments for the flickr.groups.discuss.replies.getInfo method.
+type GroupsDiscussRepliesGetInfoArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The ID of the topic. Required.
+	TopicId string
+	// The ID of the reply. Required.
+	ReplyId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussRepliesGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.TopicId == "" {
+		return nil, fmt.Errorf("Missing required topic_id argument")
+	}
+
+	args.Set("topic_id", a.TopicId)
+
+	if a.ReplyId == "" {
+		return nil, fmt.Errorf("Missing required reply_id argument")
+	}
+
+	args.Set("reply_id", a.ReplyId)
+
+	return args, nil
+}
+
+// GroupsDiscussRepliesGetInfoResponse is a struct containing the response for the flickr.groups.discuss.replies.getInfo method.
+type GroupsDiscussRepliesGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.groups.discuss.replies.getInfo method. Get information on a group topic reply.
+func (GroupsDiscussRepliesNamespace) GetInfo(ctx context.Context, cl client.Client, args GroupsDiscussRepliesGetInfoArgs) (*GroupsDiscussRepliesGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.replies.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussRepliesGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussRepliesGetListArgs is a struct containing the arguments for the flickr.groups.discuss.replies.getList method.
+type GroupsDiscussRepliesGetListArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The ID of the topic. Required.
+	TopicId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500. Required.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussRepliesGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.TopicId == "" {
+		return nil, fmt.Errorf("Missing required topic_id argument")
+	}
+
+	args.Set("topic_id", a.TopicId)
+
+	if a.PerPage == "" {
+		return nil, fmt.Errorf("Missing required per_page argument")
+	}
+
+	args.Set("per_page", a.PerPage)
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GroupsDiscussRepliesGetListResponse is a struct containing the response for the flickr.groups.discuss.replies.getList method.
+type GroupsDiscussRepliesGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.groups.discuss.replies.getList method. Get a list of replies from a group discussion topic.
+func (GroupsDiscussRepliesNamespace) GetList(ctx context.Context, cl client.Client, args GroupsDiscussRepliesGetListArgs) (*GroupsDiscussRepliesGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.replies.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussRepliesGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussTopicsAddArgs is a struct containing the arguments for the flickr.groups.discuss.topics.add method.
+type GroupsDiscussTopicsAddArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The subject argument. Required.
+	Subject string
+	// The message argument. Required.
+	Message string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussTopicsAddArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.Subject == "" {
+		return nil, fmt.Errorf("Missing required subject argument")
+	}
+
+	args.Set("subject", a.Subject)
+
+	if a.Message == "" {
+		return nil, fmt.Errorf("Missing required message argument")
+	}
+
+	args.Set("message", a.Message)
+
+	return args, nil
+}
+
+// GroupsDiscussTopicsAddResponse is a struct containing the response for the flickr.groups.discuss.topics.add method.
+type GroupsDiscussTopicsAddResponse struct {
+	*Response
+}
+
+// Add calls the flickr.groups.discuss.topics.add method. Post a new discussion topic to a group. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsDiscussTopicsNamespace) Add(ctx context.Context, cl client.Client, args GroupsDiscussTopicsAddArgs) (*GroupsDiscussTopicsAddResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.topics.add", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussTopicsAddResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussTopicsGetInfoArgs is a struct containing the arguments for the flickr.groups.discuss.topics.getInfo method.
+type GroupsDiscussTopicsGetInfoArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The ID of the topic. Required.
+	TopicId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussTopicsGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.TopicId == "" {
+		return nil, fmt.Errorf("Missing required topic_id argument")
+	}
+
+	args.Set("topic_id", a.TopicId)
+
+	return args, nil
+}
+
+// GroupsDiscussTopicsGetInfoResponse is a struct containing the response for the flickr.groups.discuss.topics.getInfo method.
+type GroupsDiscussTopicsGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.groups.discuss.topics.getInfo method. Get information about a group discussion topic.
+func (GroupsDiscussTopicsNamespace) GetInfo(ctx context.Context, cl client.Client, args GroupsDiscussTopicsGetInfoArgs) (*GroupsDiscussTopicsGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.topics.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussTopicsGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsDiscussTopicsGetListArgs is a struct containing the arguments for the flickr.groups.discuss.topics.getList method.
+type GroupsDiscussTopicsGetListArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsDiscussTopicsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GroupsDiscussTopicsGetListResponse is a struct containing the response for the flickr.groups.discuss.topics.getList method.
+type GroupsDiscussTopicsGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.groups.discuss.topics.getList method. Get a list of discussion topics in a group.
+func (GroupsDiscussTopicsNamespace) GetList(ctx context.Context, cl client.Client, args GroupsDiscussTopicsGetListArgs) (*GroupsDiscussTopicsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.discuss.topics.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsDiscussTopicsGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsGetInfoArgs is a struct containing the arguments for the flickr.groups.getInfo method.
+type GroupsGetInfoArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The group_path_alias argument.
+	GroupPathAlias string
+	// The lang argument.
+	Lang string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.GroupPathAlias != "" {
+		args.Set("group_path_alias", a.GroupPathAlias)
+	}
+
+	if a.Lang != "" {
+		args.Set("lang", a.Lang)
+	}
+
+	return args, nil
+}
+
+// GroupsGetInfoResponse is a struct containing the response for the flickr.groups.getInfo method.
+type GroupsGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.groups.getInfo method. Get information about a group.
+func (GroupsNamespace) GetInfo(ctx context.Context, cl client.Client, args GroupsGetInfoArgs) (*GroupsGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsJoinArgs is a struct containing the arguments for the flickr.groups.join method.
+type GroupsJoinArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The accept_rules argument.
+	AcceptRules string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsJoinArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.AcceptRules != "" {
+		args.Set("accept_rules", a.AcceptRules)
+	}
+
+	return args, nil
+}
+
+// GroupsJoinResponse is a struct containing the response for the flickr.groups.join method.
+type GroupsJoinResponse struct {
+	*Response
+}
+
+// Join calls the flickr.groups.join method. Join a public group as a member. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsNamespace) Join(ctx context.Context, cl client.Client, args GroupsJoinArgs) (*GroupsJoinResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.join", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsJoinResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsJoinRequestArgs is a struct containing the arguments for the flickr.groups.joinRequest method.
+type GroupsJoinRequestArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The message argument. Required.
+	Message string
+	// The accept_rules argument. Required.
+	AcceptRules string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsJoinRequestArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.Message == "" {
+		return nil, fmt.Errorf("Missing required message argument")
+	}
+
+	args.Set("message", a.Message)
+
+	if a.AcceptRules == "" {
+		return nil, fmt.Errorf("Missing required accept_rules argument")
+	}
+
+	args.Set("accept_rules", a.AcceptRules)
+
+	return args, nil
+}
+
+// GroupsJoinRequestResponse is a struct containing the response for the flickr.groups.joinRequest method.
+type GroupsJoinRequestResponse struct {
+	*Response
+}
+
+// JoinRequest calls the flickr.groups.joinRequest method. Request to join a group that is invitation-only. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsNamespace) JoinRequest(ctx context.Context, cl client.Client, args GroupsJoinRequestArgs) (*GroupsJoinRequestResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.joinRequest", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsJoinRequestResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsLeaveArgs is a struct containing the arguments for the flickr.groups.leave method.
+type GroupsLeaveArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The delete_photos argument.
+	DeletePhotos string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsLeaveArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.DeletePhotos != "" {
+		args.Set("delete_photos", a.DeletePhotos)
+	}
+
+	return args, nil
+}
+
+// GroupsLeaveResponse is a struct containing the response for the flickr.groups.leave method.
+type GroupsLeaveResponse struct {
+	*Response
+}
+
+// Leave calls the flickr.groups.leave method. Leave a group. Requires "delete" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsNamespace) Leave(ctx context.Context, cl client.Client, args GroupsLeaveArgs) (*GroupsLeaveResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.leave", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsLeaveResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsMembersGetListArgs is a struct containing the arguments for the flickr.groups.members.getList method.
+type GroupsMembersGetListArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+	// The membertypes argument.
+	Membertypes string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsMembersGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.Membertypes != "" {
+		args.Set("membertypes", a.Membertypes)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GroupsMembersGetListResponse is a struct containing the response for the flickr.groups.members.getList method.
+type GroupsMembersGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.groups.members.getList method. Get a list of the members of a group. The call must be signed on behalf of a Flickr member, and the ability to see the group membership will be determined by the Flickr member's group privileges. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsMembersNamespace) GetList(ctx context.Context, cl client.Client, args GroupsMembersGetListArgs) (*GroupsMembersGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.members.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsMembersGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsPoolsAddArgs is a struct containing the arguments for the flickr.groups.pools.add method.
+type GroupsPoolsAddArgs struct {
+	// The id of the photo to add to the group pool. The photo must belong to the calling user. Required.
+	PhotoId string
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsPoolsAddArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	return args, nil
+}
+
+// GroupsPoolsAddResponse is a struct containing the response for the flickr.groups.pools.add method.
+type GroupsPoolsAddResponse struct {
+	*Response
+}
+
+// Add calls the flickr.groups.pools.add method. Add a photo to a group's pool. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsPoolsNamespace) Add(ctx context.Context, cl client.Client, args GroupsPoolsAddArgs) (*GroupsPoolsAddResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.pools.add", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsPoolsAddResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsPoolsGetContextArgs is a struct containing the arguments for the flickr.groups.pools.getContext method.
+type GroupsPoolsGetContextArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsPoolsGetContextArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	return args, nil
+}
+
+// GroupsPoolsGetContextResponse is a struct containing the response for the flickr.groups.pools.getContext method.
+type GroupsPoolsGetContextResponse struct {
+	*Response
+}
+
+// GetContext calls the flickr.groups.pools.getContext method. Returns next and previous photos for a photo in a group pool.
+func (GroupsPoolsNamespace) GetContext(ctx context.Context, cl client.Client, args GroupsPoolsGetContextArgs) (*GroupsPoolsGetContextResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.pools.getContext", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsPoolsGetContextResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsPoolsGetGroupsArgs is a struct containing the arguments for the flickr.groups.pools.getGroups method.
+type GroupsPoolsGetGroupsArgs struct {
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsPoolsGetGroupsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// GroupsPoolsGetGroupsResponse is a struct containing the response for the flickr.groups.pools.getGroups method.
+type GroupsPoolsGetGroupsResponse struct {
+	*Response
+}
+
+// GetGroups calls the flickr.groups.pools.getGroups method. Returns a list of groups to which you can add photos. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsPoolsNamespace) GetGroups(ctx context.Context, cl client.Client, args GroupsPoolsGetGroupsArgs) (*GroupsPoolsGetGroupsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.pools.getGroups", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsPoolsGetGroupsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsPoolsGetPhotosArgs is a struct containing the arguments for the flickr.groups.pools.getPhotos method.
+type GroupsPoolsGetPhotosArgs struct {
+	// The id of the group who's pool you which to get the photo list for. Required.
+	GroupId string
+	// A tag to filter the pool with. At the moment only one tag at a time is supported.
+	Tags string
+	// The nsid of a user. Specifiying this parameter will retrieve for you only those photos that the user has contributed to the group pool.
+	UserId string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsPoolsGetPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	if a.Tags != "" {
+		args.Set("tags", a.Tags)
+	}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GroupsPoolsGetPhotosResponse is a struct containing the response for the flickr.groups.pools.getPhotos method.
+type GroupsPoolsGetPhotosResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPhotos calls the flickr.groups.pools.getPhotos method. Returns a list of pool photos for a given group, based on the permissions of the group and the user logged in (if any).
+func (GroupsPoolsNamespace) GetPhotos(ctx context.Context, cl client.Client, args GroupsPoolsGetPhotosArgs) (*GroupsPoolsGetPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.pools.getPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsPoolsGetPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.groups.pools.getPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// GroupsPoolsRemoveArgs is a struct containing the arguments for the flickr.groups.pools.remove method.
+type GroupsPoolsRemoveArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsPoolsRemoveArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	return args, nil
+}
+
+// GroupsPoolsRemoveResponse is a struct containing the response for the flickr.groups.pools.remove method.
+type GroupsPoolsRemoveResponse struct {
+	*Response
+}
+
+// Remove calls the flickr.groups.pools.remove method. Remove a photo from a group pool. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (GroupsPoolsNamespace) Remove(ctx context.Context, cl client.Client, args GroupsPoolsRemoveArgs) (*GroupsPoolsRemoveResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.pools.remove", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsPoolsRemoveResponse{Response: rsp}
+
+	return r, nil
+}
+
+// GroupsSearchArgs is a struct containing the arguments for the flickr.groups.search method.
+type GroupsSearchArgs struct {
+	// A free text search. Photos who's title, description or tags contain the text will be returned. You can exclude results that match a term by prepending it with a - character. Required.
+	Text string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a GroupsSearchArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Text == "" {
+		return nil, fmt.Errorf("Missing required text argument")
+	}
+
+	args.Set("text", a.Text)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// GroupsSearchResponse is a struct containing the response for the flickr.groups.search method.
+type GroupsSearchResponse struct {
+	*Response
+}
+
+// Search calls the flickr.groups.search method. Search for groups. 18+ groups will only be returned for authenticated calls where the authenticated user is over 18.
+func (GroupsNamespace) Search(ctx context.Context, cl client.Client, args GroupsSearchArgs) (*GroupsSearchResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.groups.search", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &GroupsSearchResponse{Response: rsp}
+
+	return r, nil
+}
+
+// InterestingnessGetListArgs is a struct containing the arguments for the flickr.interestingness.getList method.
+type InterestingnessGetListArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format.
+	Date string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a InterestingnessGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date != "" {
+		args.Set("date", a.Date)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// InterestingnessGetListResponse is a struct containing the response for the flickr.interestingness.getList method.
+type InterestingnessGetListResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetList calls the flickr.interestingness.getList method. Returns the list of interesting photos for the most recent day or a user-specified date.
+func (InterestingnessNamespace) GetList(ctx context.Context, cl client.Client, args InterestingnessGetListArgs) (*InterestingnessGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.interestingness.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &InterestingnessGetListResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.interestingness.getList response, %w", err)
+	}
+
+	return r, nil
+}
+
+// MachinetagsGetNamespacesArgs is a struct containing the arguments for the flickr.machinetags.getNamespaces method.
+type MachinetagsGetNamespacesArgs struct {
+	// The predicate argument.
+	Predicate string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a MachinetagsGetNamespacesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Predicate != "" {
+		args.Set("predicate", a.Predicate)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// MachinetagsGetNamespacesResponse is a struct containing the response for the flickr.machinetags.getNamespaces method.
+type MachinetagsGetNamespacesResponse struct {
+	*Response
+}
+
+// GetNamespaces calls the flickr.machinetags.getNamespaces method. Return a list of unique namespaces, optionally limited by a given predicate, in alphabetical order.
+func (MachinetagsNamespace) GetNamespaces(ctx context.Context, cl client.Client, args MachinetagsGetNamespacesArgs) (*MachinetagsGetNamespacesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.machinetags.getNamespaces", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MachinetagsGetNamespacesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// MachinetagsGetPairsArgs is a struct containing the arguments for the flickr.machinetags.getPairs method.
+type MachinetagsGetPairsArgs struct {
+	// The namespace argument.
+	Namespace string
+	// The predicate argument.
+	Predicate string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a MachinetagsGetPairsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Namespace != "" {
+		args.Set("namespace", a.Namespace)
+	}
+
+	if a.Predicate != "" {
+		args.Set("predicate", a.Predicate)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// MachinetagsGetPairsResponse is a struct containing the response for the flickr.machinetags.getPairs method.
+type MachinetagsGetPairsResponse struct {
+	*Response
+}
+
+// GetPairs calls the flickr.machinetags.getPairs method. Return a list of unique namespace and predicate pairs, optionally limited by predicate or namespace, in alphabetical order.
+func (MachinetagsNamespace) GetPairs(ctx context.Context, cl client.Client, args MachinetagsGetPairsArgs) (*MachinetagsGetPairsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.machinetags.getPairs", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MachinetagsGetPairsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// MachinetagsGetPredicatesArgs is a struct containing the arguments for the flickr.machinetags.getPredicates method.
+type MachinetagsGetPredicatesArgs struct {
+	// The namespace argument.
+	Namespace string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a MachinetagsGetPredicatesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Namespace != "" {
+		args.Set("namespace", a.Namespace)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// MachinetagsGetPredicatesResponse is a struct containing the response for the flickr.machinetags.getPredicates method.
+type MachinetagsGetPredicatesResponse struct {
+	*Response
+}
+
+// GetPredicates calls the flickr.machinetags.getPredicates method. Return a list of unique predicates, optionally limited by a given namespace.
+func (MachinetagsNamespace) GetPredicates(ctx context.Context, cl client.Client, args MachinetagsGetPredicatesArgs) (*MachinetagsGetPredicatesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.machinetags.getPredicates", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MachinetagsGetPredicatesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// MachinetagsGetRecentValuesArgs is a struct containing the arguments for the flickr.machinetags.getRecentValues method.
+type MachinetagsGetRecentValuesArgs struct {
+	// The namespace argument.
+	Namespace string
+	// The predicate argument.
+	Predicate string
+	// The added_since argument.
+	AddedSince string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a MachinetagsGetRecentValuesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Namespace != "" {
+		args.Set("namespace", a.Namespace)
+	}
+
+	if a.Predicate != "" {
+		args.Set("predicate", a.Predicate)
+	}
+
+	if a.AddedSince != "" {
+		args.Set("added_since", a.AddedSince)
+	}
+
+	return args, nil
+}
+
+// MachinetagsGetRecentValuesResponse is a struct containing the response for the flickr.machinetags.getRecentValues method.
+type MachinetagsGetRecentValuesResponse struct {
+	*Response
+}
+
+// GetRecentValues calls the flickr.machinetags.getRecentValues method. Fetch recently used (or created) machine tags values.
+func (MachinetagsNamespace) GetRecentValues(ctx context.Context, cl client.Client, args MachinetagsGetRecentValuesArgs) (*MachinetagsGetRecentValuesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.machinetags.getRecentValues", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MachinetagsGetRecentValuesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// MachinetagsGetValuesArgs is a struct containing the arguments for the flickr.machinetags.getValues method.
+type MachinetagsGetValuesArgs struct {
+	// The namespace argument. Required.
+	Namespace string
+	// The predicate argument. Required.
+	Predicate string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a MachinetagsGetValuesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Namespace == "" {
+		return nil, fmt.Errorf("Missing required namespace argument")
+	}
+
+	args.Set("namespace", a.Namespace)
+
+	if a.Predicate == "" {
+		return nil, fmt.Errorf("Missing required predicate argument")
+	}
+
+	args.Set("predicate", a.Predicate)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// MachinetagsGetValuesResponse is a struct containing the response for the flickr.machinetags.getValues method.
+type MachinetagsGetValuesResponse struct {
+	*Response
+}
+
+// GetValues calls the flickr.machinetags.getValues method. Return a list of unique values for a namespace and predicate.
+func (MachinetagsNamespace) GetValues(ctx context.Context, cl client.Client, args MachinetagsGetValuesArgs) (*MachinetagsGetValuesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.machinetags.getValues", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MachinetagsGetValuesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PandaGetListArgs is a struct containing the arguments for the flickr.panda.getList method.
+type PandaGetListArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PandaGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PandaGetListResponse is a struct containing the response for the flickr.panda.getList method.
+type PandaGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.panda.getList method. Return a list of Flickr pandas, from whom you can request photos using the flickr.panda.getPhotos API method.
+func (PandaNamespace) GetList(ctx context.Context, cl client.Client, args PandaGetListArgs) (*PandaGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.panda.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PandaGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PandaGetPhotosArgs is a struct containing the arguments for the flickr.panda.getPhotos method.
+type PandaGetPhotosArgs struct {
+	// The panda_name argument. Required.
+	PandaName string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PandaGetPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PandaName == "" {
+		return nil, fmt.Errorf("Missing required panda_name argument")
+	}
+
+	args.Set("panda_name", a.PandaName)
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PandaGetPhotosResponse is a struct containing the response for the flickr.panda.getPhotos method.
+type PandaGetPhotosResponse struct {
+	*Response
+}
+
+// GetPhotos calls the flickr.panda.getPhotos method. Ask the Flickr Pandas for a list of recent public (and "safe") photos.
+func (PandaNamespace) GetPhotos(ctx context.Context, cl client.Client, args PandaGetPhotosArgs) (*PandaGetPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.panda.getPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PandaGetPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleFindByEmailArgs is a struct containing the arguments for the flickr.people.findByEmail method.
+type PeopleFindByEmailArgs struct {
+	// The find_email argument. Required.
+	FindEmail string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleFindByEmailArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.FindEmail == "" {
+		return nil, fmt.Errorf("Missing required find_email argument")
+	}
+
+	args.Set("find_email", a.FindEmail)
+
+	return args, nil
+}
+
+// PeopleFindByEmailResponse is a struct containing the response for the flickr.people.findByEmail method.
+type PeopleFindByEmailResponse struct {
+	*Response
+}
+
+// FindByEmail calls the flickr.people.findByEmail method. Return a user's NSID, given their email address.
+func (PeopleNamespace) FindByEmail(ctx context.Context, cl client.Client, args PeopleFindByEmailArgs) (*PeopleFindByEmailResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.findByEmail", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleFindByEmailResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleFindByUsernameArgs is a struct containing the arguments for the flickr.people.findByUsername method.
+type PeopleFindByUsernameArgs struct {
+	// The username of the user to lookup. Required.
+	Username string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleFindByUsernameArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Username == "" {
+		return nil, fmt.Errorf("Missing required username argument")
+	}
+
+	args.Set("username", a.Username)
+
+	return args, nil
+}
+
+// PeopleFindByUsernameResponse is a struct containing the response for the flickr.people.findByUsername method.
+type PeopleFindByUsernameResponse struct {
+	*Response
+}
+
+// FindByUsername calls the flickr.people.findByUsername method. Return a user's NSID, given their username.
+func (PeopleNamespace) FindByUsername(ctx context.Context, cl client.Client, args PeopleFindByUsernameArgs) (*PeopleFindByUsernameResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.findByUsername", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleFindByUsernameResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleGetGroupsArgs is a struct containing the arguments for the flickr.people.getGroups method.
+type PeopleGetGroupsArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetGroupsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	return args, nil
+}
+
+// PeopleGetGroupsResponse is a struct containing the response for the flickr.people.getGroups method.
+type PeopleGetGroupsResponse struct {
+	*Response
+}
+
+// GetGroups calls the flickr.people.getGroups method. Returns the list of groups a user is a member of. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PeopleNamespace) GetGroups(ctx context.Context, cl client.Client, args PeopleGetGroupsArgs) (*PeopleGetGroupsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getGroups", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetGroupsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleGetInfoArgs is a struct containing the arguments for the flickr.people.getInfo method.
+type PeopleGetInfoArgs struct {
+	// The NSID of the user to fetch information about. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// PeopleGetInfoResponse is a struct containing the response for the flickr.people.getInfo method.
+type PeopleGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.people.getInfo method. Get information about a user.
+func (PeopleNamespace) GetInfo(ctx context.Context, cl client.Client, args PeopleGetInfoArgs) (*PeopleGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleGetLimitsArgs is a struct containing the arguments for the flickr.people.getLimits method.
+type PeopleGetLimitsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetLimitsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PeopleGetLimitsResponse is a struct containing the response for the flickr.people.getLimits method.
+type PeopleGetLimitsResponse struct {
+	*Response
+}
+
+// GetLimits calls the flickr.people.getLimits method. Returns the photo and video limits that apply to the calling user account. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PeopleNamespace) GetLimits(ctx context.Context, cl client.Client, args PeopleGetLimitsArgs) (*PeopleGetLimitsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getLimits", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetLimitsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleGetPhotosArgs is a struct containing the arguments for the flickr.people.getPhotos method.
+type PeopleGetPhotosArgs struct {
+	// The NSID of the user who's photos to return. A value of "me" will return the calling user's photos. Required.
+	UserId string
+	// Safe search setting: 1 for safe, 2 for moderate, 3 for restricted.
+	SafeSearch string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+	// Content Type setting: 1 for photos only, 2 for screenshots only, 3 for 'other' only, 4 for photos and screenshots, 5 for screenshots and 'other', 6 for photos and 'other', 7 for photos, screenshots, and 'other' (all).
+	ContentType string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view photos you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.SafeSearch != "" {
+		args.Set("safe_search", a.SafeSearch)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	if a.ContentType != "" {
+		args.Set("content_type", a.ContentType)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PeopleGetPhotosResponse is a struct containing the response for the flickr.people.getPhotos method.
+type PeopleGetPhotosResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPhotos calls the flickr.people.getPhotos method. Return photos from the given user's photostream. Only photos visible to the calling user will be returned. This method must be authenticated; to return public photos for a user, use flickr.people.getPublicPhotos.
+func (PeopleNamespace) GetPhotos(ctx context.Context, cl client.Client, args PeopleGetPhotosArgs) (*PeopleGetPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.people.getPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PeopleGetPhotosOfArgs is a struct containing the arguments for the flickr.people.getPhotosOf method.
+type PeopleGetPhotosOfArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The owner_id argument.
+	OwnerId string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetPhotosOfArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.OwnerId != "" {
+		args.Set("owner_id", a.OwnerId)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PeopleGetPhotosOfResponse is a struct containing the response for the flickr.people.getPhotosOf method.
+type PeopleGetPhotosOfResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPhotosOf calls the flickr.people.getPhotosOf method. Returns a list of photos containing a particular Flickr member.
+func (PeopleNamespace) GetPhotosOf(ctx context.Context, cl client.Client, args PeopleGetPhotosOfArgs) (*PeopleGetPhotosOfResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getPhotosOf", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetPhotosOfResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.people.getPhotosOf response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PeopleGetPublicGroupsArgs is a struct containing the arguments for the flickr.people.getPublicGroups method.
+type PeopleGetPublicGroupsArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The invitation_only argument.
+	InvitationOnly string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetPublicGroupsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.InvitationOnly != "" {
+		args.Set("invitation_only", a.InvitationOnly)
+	}
+
+	return args, nil
+}
+
+// PeopleGetPublicGroupsResponse is a struct containing the response for the flickr.people.getPublicGroups method.
+type PeopleGetPublicGroupsResponse struct {
+	*Response
+}
+
+// GetPublicGroups calls the flickr.people.getPublicGroups method. Returns the list of public groups a user is a member of.
+func (PeopleNamespace) GetPublicGroups(ctx context.Context, cl client.Client, args PeopleGetPublicGroupsArgs) (*PeopleGetPublicGroupsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getPublicGroups", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetPublicGroupsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PeopleGetPublicPhotosArgs is a struct containing the arguments for the flickr.people.getPublicPhotos method.
+type PeopleGetPublicPhotosArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// Safe search setting: 1 for safe, 2 for moderate, 3 for restricted.
+	SafeSearch string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetPublicPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.SafeSearch != "" {
+		args.Set("safe_search", a.SafeSearch)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PeopleGetPublicPhotosResponse is a struct containing the response for the flickr.people.getPublicPhotos method.
+type PeopleGetPublicPhotosResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPublicPhotos calls the flickr.people.getPublicPhotos method. Get a list of public photos for the given user.
+func (PeopleNamespace) GetPublicPhotos(ctx context.Context, cl client.Client, args PeopleGetPublicPhotosArgs) (*PeopleGetPublicPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getPublicPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetPublicPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.people.getPublicPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PeopleGetUploadStatusArgs is a struct containing the arguments for the flickr.people.getUploadStatus method.
+type PeopleGetUploadStatusArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PeopleGetUploadStatusArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PeopleGetUploadStatusResponse is a struct containing the response for the flickr.people.getUploadStatus method.
+type PeopleGetUploadStatusResponse struct {
+	*Response
+}
+
+// GetUploadStatus calls the flickr.people.getUploadStatus method. Returns information for the calling user related to photo uploads. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PeopleNamespace) GetUploadStatus(ctx context.Context, cl client.Client, args PeopleGetUploadStatusArgs) (*PeopleGetUploadStatusResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.people.getUploadStatus", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PeopleGetUploadStatusResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosAddTagsArgs is a struct containing the arguments for the flickr.photos.addTags method.
+type PhotosAddTagsArgs struct {
+	// The id of the photo to add tags to. Required.
+	PhotoId string
+	// The tags to add to the photo. Required.
+	Tags string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosAddTagsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Tags == "" {
+		return nil, fmt.Errorf("Missing required tags argument")
+	}
+
+	args.Set("tags", a.Tags)
+
+	return args, nil
+}
+
+// PhotosAddTagsResponse is a struct containing the response for the flickr.photos.addTags method.
+type PhotosAddTagsResponse struct {
+	*Response
+}
+
+// AddTags calls the flickr.photos.addTags method. Add tags to a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) AddTags(ctx context.Context, cl client.Client, args PhotosAddTagsArgs) (*PhotosAddTagsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.addTags", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosAddTagsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosCommentsAddCommentArgs is a struct containing the arguments for the flickr.photos.comments.addComment method.
+type PhotosCommentsAddCommentArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// Text of the comment. Required.
+	CommentText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosCommentsAddCommentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.CommentText == "" {
+		return nil, fmt.Errorf("Missing required comment_text argument")
+	}
+
+	args.Set("comment_text", a.CommentText)
+
+	return args, nil
+}
+
+// PhotosCommentsAddCommentResponse is a struct containing the response for the flickr.photos.comments.addComment method.
+type PhotosCommentsAddCommentResponse struct {
+	*Response
+}
+
+// AddComment calls the flickr.photos.comments.addComment method. Add comment to a photo as the currently authenticated user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosCommentsNamespace) AddComment(ctx context.Context, cl client.Client, args PhotosCommentsAddCommentArgs) (*PhotosCommentsAddCommentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.comments.addComment", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosCommentsAddCommentResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosCommentsDeleteCommentArgs is a struct containing the arguments for the flickr.photos.comments.deleteComment method.
+type PhotosCommentsDeleteCommentArgs struct {
+	// The id of the comment. Required.
+	CommentId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosCommentsDeleteCommentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CommentId == "" {
+		return nil, fmt.Errorf("Missing required comment_id argument")
+	}
+
+	args.Set("comment_id", a.CommentId)
+
+	return args, nil
+}
+
+// PhotosCommentsDeleteCommentResponse is a struct containing the response for the flickr.photos.comments.deleteComment method.
+type PhotosCommentsDeleteCommentResponse struct {
+	*Response
+}
+
+// DeleteComment calls the flickr.photos.comments.deleteComment method. Delete a comment as the currently authenticated user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosCommentsNamespace) DeleteComment(ctx context.Context, cl client.Client, args PhotosCommentsDeleteCommentArgs) (*PhotosCommentsDeleteCommentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.comments.deleteComment", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosCommentsDeleteCommentResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosCommentsEditCommentArgs is a struct containing the arguments for the flickr.photos.comments.editComment method.
+type PhotosCommentsEditCommentArgs struct {
+	// The id of the comment. Required.
+	CommentId string
+	// Text of the comment. Required.
+	CommentText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosCommentsEditCommentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CommentId == "" {
+		return nil, fmt.Errorf("Missing required comment_id argument")
+	}
+
+	args.Set("comment_id", a.CommentId)
+
+	if a.CommentText == "" {
+		return nil, fmt.Errorf("Missing required comment_text argument")
+	}
+
+	args.Set("comment_text", a.CommentText)
+
+	return args, nil
+}
+
+// PhotosCommentsEditCommentResponse is a struct containing the response for the flickr.photos.comments.editComment method.
+type PhotosCommentsEditCommentResponse struct {
+	*Response
+}
+
+// EditComment calls the flickr.photos.comments.editComment method. Edit the text of a comment as the currently authenticated user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosCommentsNamespace) EditComment(ctx context.Context, cl client.Client, args PhotosCommentsEditCommentArgs) (*PhotosCommentsEditCommentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.comments.editComment", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosCommentsEditCommentResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosCommentsGetListArgs is a struct containing the arguments for the flickr.photos.comments.getList method.
+type PhotosCommentsGetListArgs struct {
+	// The id of the photo to fetch comments for. Required.
+	PhotoId string
+	// Minimum date that a a comment was added. The date should be in the form of a unix timestamp.
+	MinCommentDate string
+	// Maximum date that a comment was added. The date should be in the form of a unix timestamp.
+	MaxCommentDate string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosCommentsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.MinCommentDate != "" {
+		args.Set("min_comment_date", a.MinCommentDate)
+	}
+
+	if a.MaxCommentDate != "" {
+		args.Set("max_comment_date", a.MaxCommentDate)
+	}
+
+	return args, nil
+}
+
+// PhotosCommentsGetListResponse is a struct containing the response for the flickr.photos.comments.getList method.
+type PhotosCommentsGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.photos.comments.getList method. Returns the comments for a photo
+func (PhotosCommentsNamespace) GetList(ctx context.Context, cl client.Client, args PhotosCommentsGetListArgs) (*PhotosCommentsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.comments.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosCommentsGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosCommentsGetRecentForContactsArgs is a struct containing the arguments for the flickr.photos.comments.getRecentForContacts method.
+type PhotosCommentsGetRecentForContactsArgs struct {
+	// The date_lastcomment argument.
+	DateLastcomment string
+	// The contacts_filter argument.
+	ContactsFilter string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosCommentsGetRecentForContactsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.DateLastcomment != "" {
+		args.Set("date_lastcomment", a.DateLastcomment)
+	}
+
+	if a.ContactsFilter != "" {
+		args.Set("contacts_filter", a.ContactsFilter)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosCommentsGetRecentForContactsResponse is a struct containing the response for the flickr.photos.comments.getRecentForContacts method.
+type PhotosCommentsGetRecentForContactsResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetRecentForContacts calls the flickr.photos.comments.getRecentForContacts method. Return the list of photos belonging to your contacts that have been commented on recently. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosCommentsNamespace) GetRecentForContacts(ctx context.Context, cl client.Client, args PhotosCommentsGetRecentForContactsArgs) (*PhotosCommentsGetRecentForContactsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.comments.getRecentForContacts", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosCommentsGetRecentForContactsResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.comments.getRecentForContacts response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosDeleteArgs is a struct containing the arguments for the flickr.photos.delete method.
+type PhotosDeleteArgs struct {
+	// The id of the photo to delete. Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosDeleteArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosDeleteResponse is a struct containing the response for the flickr.photos.delete method.
+type PhotosDeleteResponse struct {
+	*Response
+}
+
+// Delete calls the flickr.photos.delete method. Delete a photo from flickr. Requires "delete" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) Delete(ctx context.Context, cl client.Client, args PhotosDeleteArgs) (*PhotosDeleteResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.delete", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosDeleteResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoBatchCorrectLocationArgs is a struct containing the arguments for the flickr.photos.geo.batchCorrectLocation method.
+type PhotosGeoBatchCorrectLocationArgs struct {
+	// The latitude whose valid range is -90 to 90. Anything more than 6 decimal places will be truncated. Required.
+	Lat string
+	// The longitude whose valid range is -180 to 180. Anything more than 6 decimal places will be truncated. Required.
+	Lon string
+	// Recorded accuracy level of the location information. World level is 1, Country is ~3, Region ~6, City ~11, Street ~16. Current range is 1-16. Defaults to 16 if not specified. Required.
+	Accuracy string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoBatchCorrectLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Lat == "" {
+		return nil, fmt.Errorf("Missing required lat argument")
+	}
+
+	args.Set("lat", a.Lat)
+
+	if a.Lon == "" {
+		return nil, fmt.Errorf("Missing required lon argument")
+	}
+
+	args.Set("lon", a.Lon)
+
+	if a.Accuracy == "" {
+		return nil, fmt.Errorf("Missing required accuracy argument")
+	}
+
+	args.Set("accuracy", a.Accuracy)
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	return args, nil
+}
+
+// PhotosGeoBatchCorrectLocationResponse is a struct containing the response for the flickr.photos.geo.batchCorrectLocation method.
+type PhotosGeoBatchCorrectLocationResponse struct {
+	*Response
+}
+
+// BatchCorrectLocation calls the flickr.photos.geo.batchCorrectLocation method. Correct the places hierarchy for all the photos for a user at a given latitude, longitude and accuracy. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) BatchCorrectLocation(ctx context.Context, cl client.Client, args PhotosGeoBatchCorrectLocationArgs) (*PhotosGeoBatchCorrectLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.batchCorrectLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoBatchCorrectLocationResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoCorrectLocationArgs is a struct containing the arguments for the flickr.photos.geo.correctLocation method.
+type PhotosGeoCorrectLocationArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// The foursquare_id argument. Required.
+	FoursquareId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoCorrectLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.FoursquareId == "" {
+		return nil, fmt.Errorf("Missing required foursquare_id argument")
+	}
+
+	args.Set("foursquare_id", a.FoursquareId)
+
+	return args, nil
+}
+
+// PhotosGeoCorrectLocationResponse is a struct containing the response for the flickr.photos.geo.correctLocation method.
+type PhotosGeoCorrectLocationResponse struct {
+	*Response
+}
+
+// CorrectLocation calls the flickr.photos.geo.correctLocation method. Correct the places hierarchy for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) CorrectLocation(ctx context.Context, cl client.Client, args PhotosGeoCorrectLocationArgs) (*PhotosGeoCorrectLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.correctLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoCorrectLocationResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoGetLocationArgs is a struct containing the arguments for the flickr.photos.geo.getLocation method.
+type PhotosGeoGetLocationArgs struct {
+	// The id of the photo you want to retrieve location data for. Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoGetLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGeoGetLocationResponse is a struct containing the response for the flickr.photos.geo.getLocation method.
+type PhotosGeoGetLocationResponse struct {
+	*Response
+}
+
+// GetLocation calls the flickr.photos.geo.getLocation method. Get the geo data (latitude and longitude and the accuracy level) for a photo.
+func (PhotosGeoNamespace) GetLocation(ctx context.Context, cl client.Client, args PhotosGeoGetLocationArgs) (*PhotosGeoGetLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.getLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoGetLocationResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoGetPermsArgs is a struct containing the arguments for the flickr.photos.geo.getPerms method.
+type PhotosGeoGetPermsArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoGetPermsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGeoGetPermsResponse is a struct containing the response for the flickr.photos.geo.getPerms method.
+type PhotosGeoGetPermsResponse struct {
+	*Response
+}
+
+// GetPerms calls the flickr.photos.geo.getPerms method. Get permissions for who may view geo data for a photo. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) GetPerms(ctx context.Context, cl client.Client, args PhotosGeoGetPermsArgs) (*PhotosGeoGetPermsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.getPerms", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoGetPermsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoPhotosForLocationArgs is a struct containing the arguments for the flickr.photos.geo.photosForLocation method.
+type PhotosGeoPhotosForLocationArgs struct {
+	// The latitude whose valid range is -90 to 90. Anything more than 6 decimal places will be truncated. Required.
+	Lat string
+	// The longitude whose valid range is -180 to 180. Anything more than 6 decimal places will be truncated. Required.
+	Lon string
+	// Recorded accuracy level of the location information. World level is 1, Country is ~3, Region ~6, City ~11, Street ~16. Current range is 1-16. Defaults to 16 if not specified.
+	Accuracy string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoPhotosForLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Lat == "" {
+		return nil, fmt.Errorf("Missing required lat argument")
+	}
+
+	args.Set("lat", a.Lat)
+
+	if a.Lon == "" {
+		return nil, fmt.Errorf("Missing required lon argument")
+	}
+
+	args.Set("lon", a.Lon)
+
+	if a.Accuracy != "" {
+		args.Set("accuracy", a.Accuracy)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGeoPhotosForLocationResponse is a struct containing the response for the flickr.photos.geo.photosForLocation method.
+type PhotosGeoPhotosForLocationResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// PhotosForLocation calls the flickr.photos.geo.photosForLocation method. Return a list of photos for the calling user at a specific latitude, longitude and accuracy. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) PhotosForLocation(ctx context.Context, cl client.Client, args PhotosGeoPhotosForLocationArgs) (*PhotosGeoPhotosForLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.photosForLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoPhotosForLocationResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.geo.photosForLocation response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGeoRemoveLocationArgs is a struct containing the arguments for the flickr.photos.geo.removeLocation method.
+type PhotosGeoRemoveLocationArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoRemoveLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGeoRemoveLocationResponse is a struct containing the response for the flickr.photos.geo.removeLocation method.
+type PhotosGeoRemoveLocationResponse struct {
+	*Response
+}
+
+// RemoveLocation calls the flickr.photos.geo.removeLocation method. Removes the geo data associated with a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) RemoveLocation(ctx context.Context, cl client.Client, args PhotosGeoRemoveLocationArgs) (*PhotosGeoRemoveLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.removeLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoRemoveLocationResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoSetContextArgs is a struct containing the arguments for the flickr.photos.geo.setContext method.
+type PhotosGeoSetContextArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// Context is a numeric value representing the photo's geotagginess beyond latitude and longitude. 0, not defined. 1, indoors. 2, outdoors. Required.
+	Context string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoSetContextArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Context == "" {
+		return nil, fmt.Errorf("Missing required context argument")
+	}
+
+	args.Set("context", a.Context)
+
+	return args, nil
+}
+
+// PhotosGeoSetContextResponse is a struct containing the response for the flickr.photos.geo.setContext method.
+type PhotosGeoSetContextResponse struct {
+	*Response
+}
+
+// SetContext calls the flickr.photos.geo.setContext method. Indicate the state of a photo's geotagginess beyond latitude and longitude. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) SetContext(ctx context.Context, cl client.Client, args PhotosGeoSetContextArgs) (*PhotosGeoSetContextResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.setContext", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoSetContextResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoSetLocationArgs is a struct containing the arguments for the flickr.photos.geo.setLocation method.
+type PhotosGeoSetLocationArgs struct {
+	// The id of the photo to set location data for. Required.
+	PhotoId string
+	// The latitude whose valid range is -90 to 90. Anything more than 6 decimal places will be truncated. Required.
+	Lat string
+	// The longitude whose valid range is -180 to 180. Anything more than 6 decimal places will be truncated. Required.
+	Lon string
+	// Recorded accuracy level of the location information. World level is 1, Country is ~3, Region ~6, City ~11, Street ~16. Current range is 1-16. Defaults to 16 if not specified.
+	Accuracy string
+	// Context is a numeric value representing the photo's geotagginess beyond latitude and longitude. 0, not defined. 1, indoors. 2, outdoors.
+	Context string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoSetLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Lat == "" {
+		return nil, fmt.Errorf("Missing required lat argument")
+	}
+
+	args.Set("lat", a.Lat)
+
+	if a.Lon == "" {
+		return nil, fmt.Errorf("Missing required lon argument")
+	}
+
+	args.Set("lon", a.Lon)
+
+	if a.Accuracy != "" {
+		args.Set("accuracy", a.Accuracy)
+	}
+
+	if a.Context != "" {
+		args.Set("context", a.Context)
+	}
+
+	return args, nil
+}
+
+// PhotosGeoSetLocationResponse is a struct containing the response for the flickr.photos.geo.setLocation method.
+type PhotosGeoSetLocationResponse struct {
+	*Response
+}
+
+// SetLocation calls the flickr.photos.geo.setLocation method. Sets the geo data (latitude and longitude and, optionally, the accuracy level) for a photo. Before users may assign location data to a photo they must define who, by default, may view that information. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) SetLocation(ctx context.Context, cl client.Client, args PhotosGeoSetLocationArgs) (*PhotosGeoSetLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.setLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoSetLocationResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGeoSetPermsArgs is a struct containing the arguments for the flickr.photos.geo.setPerms method.
+type PhotosGeoSetPermsArgs struct {
+	// 1 to set the photo to public, 0 to set it to private. Required.
+	IsPublic string
+	// The is_contact argument. Required.
+	IsContact string
+	// 1 to make the photo visible to friends when private, 0 to not. Required.
+	IsFriend string
+	// 1 to make the photo visible to family when private, 0 to not. Required.
+	IsFamily string
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGeoSetPermsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.IsPublic == "" {
+		return nil, fmt.Errorf("Missing required is_public argument")
+	}
+
+	args.Set("is_public", a.IsPublic)
+
+	if a.IsContact == "" {
+		return nil, fmt.Errorf("Missing required is_contact argument")
+	}
+
+	args.Set("is_contact", a.IsContact)
+
+	if a.IsFriend == "" {
+		return nil, fmt.Errorf("Missing required is_friend argument")
+	}
+
+	args.Set("is_friend", a.IsFriend)
+
+	if a.IsFamily == "" {
+		return nil, fmt.Errorf("Missing required is_family argument")
+	}
+
+	args.Set("is_family", a.IsFamily)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGeoSetPermsResponse is a struct containing the response for the flickr.photos.geo.setPerms method.
+type PhotosGeoSetPermsResponse struct {
+	*Response
+}
+
+// SetPerms calls the flickr.photos.geo.setPerms method. Set the permission for who may view the geo data associated with a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosGeoNamespace) SetPerms(ctx context.Context, cl client.Client, args PhotosGeoSetPermsArgs) (*PhotosGeoSetPermsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.geo.setPerms", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGeoSetPermsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetAllContextsArgs is a struct containing the arguments for the flickr.photos.getAllContexts method.
+type PhotosGetAllContextsArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetAllContextsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGetAllContextsResponse is a struct containing the response for the flickr.photos.getAllContexts method.
+type PhotosGetAllContextsResponse struct {
+	*Response
+}
+
+// GetAllContexts calls the flickr.photos.getAllContexts method. Returns all visible sets and pools the photo belongs to.
+func (PhotosNamespace) GetAllContexts(ctx context.Context, cl client.Client, args PhotosGetAllContextsArgs) (*PhotosGetAllContextsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getAllContexts", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetAllContextsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetContactsPhotosArgs is a struct containing the arguments for the flickr.photos.getContactsPhotos method.
+type PhotosGetContactsPhotosArgs struct {
+	// Number of results to return.
+	Count string
+	// The just_friends argument.
+	JustFriends string
+	// The single_photo argument.
+	SinglePhoto string
+	// The include_self argument.
+	IncludeSelf string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetContactsPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Count != "" {
+		args.Set("count", a.Count)
+	}
+
+	if a.JustFriends != "" {
+		args.Set("just_friends", a.JustFriends)
+	}
+
+	if a.SinglePhoto != "" {
+		args.Set("single_photo", a.SinglePhoto)
+	}
+
+	if a.IncludeSelf != "" {
+		args.Set("include_self", a.IncludeSelf)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	return args, nil
+}
+
+// PhotosGetContactsPhotosResponse is a struct containing the response for the flickr.photos.getContactsPhotos method.
+type PhotosGetContactsPhotosResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetContactsPhotos calls the flickr.photos.getContactsPhotos method. Fetch a list of recent photos from the calling users' contacts. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetContactsPhotos(ctx context.Context, cl client.Client, args PhotosGetContactsPhotosArgs) (*PhotosGetContactsPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getContactsPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetContactsPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getContactsPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetContactsPublicPhotosArgs is a struct containing the arguments for the flickr.photos.getContactsPublicPhotos method.
+type PhotosGetContactsPublicPhotosArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// Number of results to return.
+	Count string
+	// The just_friends argument.
+	JustFriends string
+	// The single_photo argument.
+	SinglePhoto string
+	// The include_self argument.
+	IncludeSelf string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetContactsPublicPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.Count != "" {
+		args.Set("count", a.Count)
+	}
+
+	if a.JustFriends != "" {
+		args.Set("just_friends", a.JustFriends)
+	}
+
+	if a.SinglePhoto != "" {
+		args.Set("single_photo", a.SinglePhoto)
+	}
+
+	if a.IncludeSelf != "" {
+		args.Set("include_self", a.IncludeSelf)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	return args, nil
+}
+
+// PhotosGetContactsPublicPhotosResponse is a struct containing the response for the flickr.photos.getContactsPublicPhotos method.
+type PhotosGetContactsPublicPhotosResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetContactsPublicPhotos calls the flickr.photos.getContactsPublicPhotos method. Fetch a list of recent public photos from a users' contacts.
+func (PhotosNamespace) GetContactsPublicPhotos(ctx context.Context, cl client.Client, args PhotosGetContactsPublicPhotosArgs) (*PhotosGetContactsPublicPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getContactsPublicPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetContactsPublicPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getContactsPublicPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetContextArgs is a struct containing the arguments for the flickr.photos.getContext method.
+type PhotosGetContextArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetContextArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGetContextResponse is a struct containing the response for the flickr.photos.getContext method.
+type PhotosGetContextResponse struct {
+	*Response
+}
+
+// GetContext calls the flickr.photos.getContext method. Returns next and previous photos for a photo in a photostream.
+func (PhotosNamespace) GetContext(ctx context.Context, cl client.Client, args PhotosGetContextArgs) (*PhotosGetContextResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getContext", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetContextResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetCountsArgs is a struct containing the arguments for the flickr.photos.getCounts method.
+type PhotosGetCountsArgs struct {
+	// The dates argument.
+	Dates string
+	// The taken_dates argument.
+	TakenDates string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetCountsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Dates != "" {
+		args.Set("dates", a.Dates)
+	}
+
+	if a.TakenDates != "" {
+		args.Set("taken_dates", a.TakenDates)
+	}
+
+	return args, nil
+}
+
+// PhotosGetCountsResponse is a struct containing the response for the flickr.photos.getCounts method.
+type PhotosGetCountsResponse struct {
+	*Response
+}
+
+// GetCounts calls the flickr.photos.getCounts method. Gets a list of photo counts for the given date ranges for the calling user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetCounts(ctx context.Context, cl client.Client, args PhotosGetCountsArgs) (*PhotosGetCountsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getCounts", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetCountsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetExifArgs is a struct containing the arguments for the flickr.photos.getExif method.
+type PhotosGetExifArgs struct {
+	// The id of the photo to fetch information for. Required.
+	PhotoId string
+	// The secret for the photo. If the correct secret is passed then permissions checking is skipped. This enables the 'sharing' of individual photos by passing around the id and secret.
+	Secret string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetExifArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Secret != "" {
+		args.Set("secret", a.Secret)
+	}
+
+	return args, nil
+}
+
+// PhotosGetExifResponse is a struct containing the response for the flickr.photos.getExif method.
+type PhotosGetExifResponse struct {
+	*Response
+}
+
+// GetExif calls the flickr.photos.getExif method. Retrieves a list of EXIF/TIFF/GPS tags for a given photo. The calling user must have permission to view the photo.
+func (PhotosNamespace) GetExif(ctx context.Context, cl client.Client, args PhotosGetExifArgs) (*PhotosGetExifResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getExif", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetExifResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetFavoritesArgs is a struct containing the arguments for the flickr.photos.getFavorites method.
+type PhotosGetFavoritesArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetFavoritesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// PhotosGetFavoritesResponse is a struct containing the response for the flickr.photos.getFavorites method.
+type PhotosGetFavoritesResponse struct {
+	*Response
+}
+
+// GetFavorites calls the flickr.photos.getFavorites method. Returns the list of people who have favorited a given photo.
+func (PhotosNamespace) GetFavorites(ctx context.Context, cl client.Client, args PhotosGetFavoritesArgs) (*PhotosGetFavoritesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getFavorites", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetFavoritesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetInfoArgs is a struct containing the arguments for the flickr.photos.getInfo method.
+type PhotosGetInfoArgs struct {
+	// The id of the photo to get information for. Required.
+	PhotoId string
+	// The secret for the photo. If the correct secret is passed then permissions checking is skipped. This enables the 'sharing' of individual photos by passing around the id and secret.
+	Secret string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Secret != "" {
+		args.Set("secret", a.Secret)
+	}
+
+	return args, nil
+}
+
+// PhotosGetInfoResponse is a struct containing the response for the flickr.photos.getInfo method.
+type PhotosGetInfoResponse struct {
+	*Response
+	// The "photo" element in the API response.
+	Photo *response.PhotoInfo `json:"photo"`
+}
+
+// GetInfo calls the flickr.photos.getInfo method. Get information about a photo. The calling user must have permission to view the photo.
+func (PhotosNamespace) GetInfo(ctx context.Context, cl client.Client, args PhotosGetInfoArgs) (*PhotosGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetInfoResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getInfo response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetNotInSetArgs is a struct containing the arguments for the flickr.photos.getNotInSet method.
+type PhotosGetNotInSetArgs struct {
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view photos you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// Filter results by media type. Possible values are all (default), photos or videos
+	Media string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetNotInSetArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Media != "" {
+		args.Set("media", a.Media)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGetNotInSetResponse is a struct containing the response for the flickr.photos.getNotInSet method.
+type PhotosGetNotInSetResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetNotInSet calls the flickr.photos.getNotInSet method. Returns a list of your photos that are not part of any sets. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetNotInSet(ctx context.Context, cl client.Client, args PhotosGetNotInSetArgs) (*PhotosGetNotInSetResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getNotInSet", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetNotInSetResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getNotInSet response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetPermsArgs is a struct containing the arguments for the flickr.photos.getPerms method.
+type PhotosGetPermsArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetPermsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGetPermsResponse is a struct containing the response for the flickr.photos.getPerms method.
+type PhotosGetPermsResponse struct {
+	*Response
+}
+
+// GetPerms calls the flickr.photos.getPerms method. Get permissions for a photo. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetPerms(ctx context.Context, cl client.Client, args PhotosGetPermsArgs) (*PhotosGetPermsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getPerms", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetPermsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetPopularArgs is a struct containing the arguments for the flickr.photos.getPopular method.
+type PhotosGetPopularArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+	// The order in which to sort returned photos. Defaults to date-posted-desc (unless you are doing a radial geo query, in which case the default sorting is by ascending distance from the point specified). The possible values are: date-posted-asc, date-posted-desc, date-taken-asc, date-taken-desc, interestingness-desc, interestingness-asc, and relevance.
+	Sort string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetPopularArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	if a.Sort != "" {
+		args.Set("sort", a.Sort)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGetPopularResponse is a struct containing the response for the flickr.photos.getPopular method.
+type PhotosGetPopularResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetPopular calls the flickr.photos.getPopular method. Returns a list of popular photos.
+func (PhotosNamespace) GetPopular(ctx context.Context, cl client.Client, args PhotosGetPopularArgs) (*PhotosGetPopularResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getPopular", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetPopularResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getPopular response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetRecentArgs is a struct containing the arguments for the flickr.photos.getRecent method.
+type PhotosGetRecentArgs struct {
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetRecentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGetRecentResponse is a struct containing the response for the flickr.photos.getRecent method.
+type PhotosGetRecentResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetRecent calls the flickr.photos.getRecent method. Returns a list of the latest public photos uploaded to flickr.
+func (PhotosNamespace) GetRecent(ctx context.Context, cl client.Client, args PhotosGetRecentArgs) (*PhotosGetRecentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getRecent", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetRecentResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getRecent response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetSizesArgs is a struct containing the arguments for the flickr.photos.getSizes method.
+type PhotosGetSizesArgs struct {
+	// The id of the photo to fetch size information for. Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetSizesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosGetSizesResponse is a struct containing the response for the flickr.photos.getSizes method.
+type PhotosGetSizesResponse struct {
+	*Response
+}
+
+// GetSizes calls the flickr.photos.getSizes method. Returns the available sizes for a photo. The calling user must have permission to view the photo.
+func (PhotosNamespace) GetSizes(ctx context.Context, cl client.Client, args PhotosGetSizesArgs) (*PhotosGetSizesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getSizes", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetSizesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosGetUntaggedArgs is a struct containing the arguments for the flickr.photos.getUntagged method.
+type PhotosGetUntaggedArgs struct {
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view photos you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// Filter results by media type. Possible values are all (default), photos or videos
+	Media string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetUntaggedArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Media != "" {
+		args.Set("media", a.Media)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGetUntaggedResponse is a struct containing the response for the flickr.photos.getUntagged method.
+type PhotosGetUntaggedResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetUntagged calls the flickr.photos.getUntagged method. Returns a list of your photos with no tags. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetUntagged(ctx context.Context, cl client.Client, args PhotosGetUntaggedArgs) (*PhotosGetUntaggedResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getUntagged", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetUntaggedResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getUntagged response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetWithGeoDataArgs is a struct containing the arguments for the flickr.photos.getWithGeoData method.
+type PhotosGetWithGeoDataArgs struct {
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view photos you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// The order in which to sort returned photos. Defaults to date-posted-desc (unless you are doing a radial geo query, in which case the default sorting is by ascending distance from the point specified). The possible values are: date-posted-asc, date-posted-desc, date-taken-asc, date-taken-desc, interestingness-desc, interestingness-asc, and relevance.
+	Sort string
+	// Filter results by media type. Possible values are all (default), photos or videos
+	Media string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetWithGeoDataArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Sort != "" {
+		args.Set("sort", a.Sort)
+	}
+
+	if a.Media != "" {
+		args.Set("media", a.Media)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGetWithGeoDataResponse is a struct containing the response for the flickr.photos.getWithGeoData method.
+type PhotosGetWithGeoDataResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetWithGeoData calls the flickr.photos.getWithGeoData method. Returns a list of your geo-tagged photos. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetWithGeoData(ctx context.Context, cl client.Client, args PhotosGetWithGeoDataArgs) (*PhotosGetWithGeoDataResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getWithGeoData", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetWithGeoDataResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getWithGeoData response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosGetWithoutGeoDataArgs is a struct containing the arguments for the flickr.photos.getWithoutGeoData method.
+type PhotosGetWithoutGeoDataArgs struct {
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view photos you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// The order in which to sort returned photos. Defaults to date-posted-desc (unless you are doing a radial geo query, in which case the default sorting is by ascending distance from the point specified). The possible values are: date-posted-asc, date-posted-desc, date-taken-asc, date-taken-desc, interestingness-desc, interestingness-asc, and relevance.
+	Sort string
+	// Filter results by media type. Possible values are all (default), photos or videos
+	Media string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosGetWithoutGeoDataArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Sort != "" {
+		args.Set("sort", a.Sort)
+	}
+
+	if a.Media != "" {
+		args.Set("media", a.Media)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosGetWithoutGeoDataResponse is a struct containing the response for the flickr.photos.getWithoutGeoData method.
+type PhotosGetWithoutGeoDataResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// GetWithoutGeoData calls the flickr.photos.getWithoutGeoData method. Returns a list of your photos which haven't been geo-tagged. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) GetWithoutGeoData(ctx context.Context, cl client.Client, args PhotosGetWithoutGeoDataArgs) (*PhotosGetWithoutGeoDataResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.getWithoutGeoData", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosGetWithoutGeoDataResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.getWithoutGeoData response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosLicensesGetInfoArgs is a struct containing the arguments for the flickr.photos.licenses.getInfo method.
+type PhotosLicensesGetInfoArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosLicensesGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PhotosLicensesGetInfoResponse is a struct containing the response for the flickr.photos.licenses.getInfo method.
+type PhotosLicensesGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.photos.licenses.getInfo method. Fetches a list of available photo licenses for Flickr.
+func (PhotosLicensesNamespace) GetInfo(ctx context.Context, cl client.Client, args PhotosLicensesGetInfoArgs) (*PhotosLicensesGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.licenses.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosLicensesGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosLicensesSetLicenseArgs is a struct containing the arguments for the flickr.photos.licenses.setLicense method.
+type PhotosLicensesSetLicenseArgs struct {
+	// The photo to update the license for. Required.
+	PhotoId string
+	// The license to apply, or 0 (zero) to remove the current license. Note: as of this writing the "no known copyright restrictions" license (7) is not a valid argument. Required.
+	LicenseId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosLicensesSetLicenseArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.LicenseId == "" {
+		return nil, fmt.Errorf("Missing required license_id argument")
+	}
+
+	args.Set("license_id", a.LicenseId)
+
+	return args, nil
+}
+
+// PhotosLicensesSetLicenseResponse is a struct containing the response for the flickr.photos.licenses.setLicense method.
+type PhotosLicensesSetLicenseResponse struct {
+	*Response
+}
+
+// SetLicense calls the flickr.photos.licenses.setLicense method. Sets the license for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosLicensesNamespace) SetLicense(ctx context.Context, cl client.Client, args PhotosLicensesSetLicenseArgs) (*PhotosLicensesSetLicenseResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.licenses.setLicense", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosLicensesSetLicenseResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosNotesAddArgs is a struct containing the arguments for the flickr.photos.notes.add method.
+type PhotosNotesAddArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The note_x argument. Required.
+	NoteX string
+	// The note_y argument. Required.
+	NoteY string
+	// The note_w argument. Required.
+	NoteW string
+	// The note_h argument. Required.
+	NoteH string
+	// The note_text argument. Required.
+	NoteText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosNotesAddArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.NoteX == "" {
+		return nil, fmt.Errorf("Missing required note_x argument")
+	}
+
+	args.Set("note_x", a.NoteX)
+
+	if a.NoteY == "" {
+		return nil, fmt.Errorf("Missing required note_y argument")
+	}
+
+	args.Set("note_y", a.NoteY)
+
+	if a.NoteW == "" {
+		return nil, fmt.Errorf("Missing required note_w argument")
+	}
+
+	args.Set("note_w", a.NoteW)
+
+	if a.NoteH == "" {
+		return nil, fmt.Errorf("Missing required note_h argument")
+	}
+
+	args.Set("note_h", a.NoteH)
+
+	if a.NoteText == "" {
+		return nil, fmt.Errorf("Missing required note_text argument")
+	}
+
+	args.Set("note_text", a.NoteText)
+
+	return args, nil
+}
+
+// PhotosNotesAddResponse is a struct containing the response for the flickr.photos.notes.add method.
+type PhotosNotesAddResponse struct {
+	*Response
+}
+
+// Add calls the flickr.photos.notes.add method. Add a note to a photo. Coordinates and sizes are in pixels, based on the 500px image size shown on individual photo pages. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNotesNamespace) Add(ctx context.Context, cl client.Client, args PhotosNotesAddArgs) (*PhotosNotesAddResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.notes.add", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosNotesAddResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosNotesDeleteArgs is a struct containing the arguments for the flickr.photos.notes.delete method.
+type PhotosNotesDeleteArgs struct {
+	// The id of the note. Required.
+	NoteId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosNotesDeleteArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.NoteId == "" {
+		return nil, fmt.Errorf("Missing required note_id argument")
+	}
+
+	args.Set("note_id", a.NoteId)
+
+	return args, nil
+}
+
+// PhotosNotesDeleteResponse is a struct containing the response for the flickr.photos.notes.delete method.
+type PhotosNotesDeleteResponse struct {
+	*Response
+}
+
+// Delete calls the flickr.photos.notes.delete method. Delete a note from a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNotesNamespace) Delete(ctx context.Context, cl client.Client, args PhotosNotesDeleteArgs) (*PhotosNotesDeleteResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.notes.delete", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosNotesDeleteResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosNotesEditArgs is a struct containing the arguments for the flickr.photos.notes.edit method.
+type PhotosNotesEditArgs struct {
+	// The id of the note. Required.
+	NoteId string
+	// The note_x argument. Required.
+	NoteX string
+	// The note_y argument. Required.
+	NoteY string
+	// The note_w argument. Required.
+	NoteW string
+	// The note_h argument. Required.
+	NoteH string
+	// The note_text argument. Required.
+	NoteText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosNotesEditArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.NoteId == "" {
+		return nil, fmt.Errorf("Missing required note_id argument")
+	}
+
+	args.Set("note_id", a.NoteId)
+
+	if a.NoteX == "" {
+		return nil, fmt.Errorf("Missing required note_x argument")
+	}
+
+	args.Set("note_x", a.NoteX)
+
+	if a.NoteY == "" {
+		return nil, fmt.Errorf("Missing required note_y argument")
+	}
+
+	args.Set("note_y", a.NoteY)
+
+	if a.NoteW == "" {
+		return nil, fmt.Errorf("Missing required note_w argument")
+	}
+
+	args.Set("note_w", a.NoteW)
+
+	if a.NoteH == "" {
+		return nil, fmt.Errorf("Missing required note_h argument")
+	}
+
+	args.Set("note_h", a.NoteH)
+
+	if a.NoteText == "" {
+		return nil, fmt.Errorf("Missing required note_text argument")
+	}
+
+	args.Set("note_text", a.NoteText)
+
+	return args, nil
+}
+
+// PhotosNotesEditResponse is a struct containing the response for the flickr.photos.notes.edit method.
+type PhotosNotesEditResponse struct {
+	*Response
+}
+
+// Edit calls the flickr.photos.notes.edit method. Edit a note on a photo. Coordinates and sizes are in pixels, based on the 500px image size shown on individual photo pages. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNotesNamespace) Edit(ctx context.Context, cl client.Client, args PhotosNotesEditArgs) (*PhotosNotesEditResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.notes.edit", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosNotesEditResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosPeopleAddArgs is a struct containing the arguments for the flickr.photos.people.add method.
+type PhotosPeopleAddArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The person_x argument.
+	PersonX string
+	// The person_y argument.
+	PersonY string
+	// The person_w argument.
+	PersonW string
+	// The person_h argument.
+	PersonH string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosPeopleAddArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.PersonX != "" {
+		args.Set("person_x", a.PersonX)
+	}
+
+	if a.PersonY != "" {
+		args.Set("person_y", a.PersonY)
+	}
+
+	if a.PersonW != "" {
+		args.Set("person_w", a.PersonW)
+	}
+
+	if a.PersonH != "" {
+		args.Set("person_h", a.PersonH)
+	}
+
+	return args, nil
+}
+
+// PhotosPeopleAddResponse is a struct containing the response for the flickr.photos.people.add method.
+type PhotosPeopleAddResponse struct {
+	*Response
+}
+
+// Add calls the flickr.photos.people.add method. Add a person to a photo. Coordinates and sizes of boxes are optional; they are measured in pixels, based on the 500px image size shown on individual photo pages. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosPeopleNamespace) Add(ctx context.Context, cl client.Client, args PhotosPeopleAddArgs) (*PhotosPeopleAddResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.people.add", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosPeopleAddResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosPeopleDeleteArgs is a struct containing the arguments for the flickr.photos.people.delete method.
+type PhotosPeopleDeleteArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosPeopleDeleteArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// PhotosPeopleDeleteResponse is a struct containing the response for the flickr.photos.people.delete method.
+type PhotosPeopleDeleteResponse struct {
+	*Response
+}
+
+// Delete calls the flickr.photos.people.delete method. Remove a person from a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosPeopleNamespace) Delete(ctx context.Context, cl client.Client, args PhotosPeopleDeleteArgs) (*PhotosPeopleDeleteResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.people.delete", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosPeopleDeleteResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosPeopleDeleteCoordsArgs is a struct containing the arguments for the flickr.photos.people.deleteCoords method.
+type PhotosPeopleDeleteCoordsArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosPeopleDeleteCoordsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// PhotosPeopleDeleteCoordsResponse is a struct containing the response for the flickr.photos.people.deleteCoords method.
+type PhotosPeopleDeleteCoordsResponse struct {
+	*Response
+}
+
+// DeleteCoords calls the flickr.photos.people.deleteCoords method. Remove the bounding box from a person in a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosPeopleNamespace) DeleteCoords(ctx context.Context, cl client.Client, args PhotosPeopleDeleteCoordsArgs) (*PhotosPeopleDeleteCoordsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.people.deleteCoords", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosPeopleDeleteCoordsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosPeopleEditCoordsArgs is a struct containing the arguments for the flickr.photos.people.editCoords method.
+type PhotosPeopleEditCoordsArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The person_x argument. Required.
+	PersonX string
+	// The person_y argument. Required.
+	PersonY string
+	// The person_w argument. Required.
+	PersonW string
+	// The person_h argument. Required.
+	PersonH string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosPeopleEditCoordsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.PersonX == "" {
+		return nil, fmt.Errorf("Missing required person_x argument")
+	}
+
+	args.Set("person_x", a.PersonX)
+
+	if a.PersonY == "" {
+		return nil, fmt.Errorf("Missing required person_y argument")
+	}
+
+	args.Set("person_y", a.PersonY)
+
+	if a.PersonW == "" {
+		return nil, fmt.Errorf("Missing required person_w argument")
+	}
+
+	args.Set("person_w", a.PersonW)
+
+	if a.PersonH == "" {
+		return nil, fmt.Errorf("Missing required person_h argument")
+	}
+
+	args.Set("person_h", a.PersonH)
+
+	return args, nil
+}
+
+// PhotosPeopleEditCoordsResponse is a struct containing the response for the flickr.photos.people.editCoords method.
+type PhotosPeopleEditCoordsResponse struct {
+	*Response
+}
+
+// EditCoords calls the flickr.photos.people.editCoords method. Edit the bounding box of an existing person on a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosPeopleNamespace) EditCoords(ctx context.Context, cl client.Client, args PhotosPeopleEditCoordsArgs) (*PhotosPeopleEditCoordsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.people.editCoords", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosPeopleEditCoordsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosPeopleGetListArgs is a struct containing the arguments for the flickr.photos.people.getList method.
+type PhotosPeopleGetListArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosPeopleGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosPeopleGetListResponse is a struct containing the response for the flickr.photos.people.getList method.
+type PhotosPeopleGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.photos.people.getList method. Get a list of people in a given photo.
+func (PhotosPeopleNamespace) GetList(ctx context.Context, cl client.Client, args PhotosPeopleGetListArgs) (*PhotosPeopleGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.people.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosPeopleGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosRecentlyUpdatedArgs is a struct containing the arguments for the flickr.photos.recentlyUpdated method.
+type PhotosRecentlyUpdatedArgs struct {
+	// The min_date argument. Required.
+	MinDate string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosRecentlyUpdatedArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MinDate == "" {
+		return nil, fmt.Errorf("Missing required min_date argument")
+	}
+
+	args.Set("min_date", a.MinDate)
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosRecentlyUpdatedResponse is a struct containing the response for the flickr.photos.recentlyUpdated method.
+type PhotosRecentlyUpdatedResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// RecentlyUpdated calls the flickr.photos.recentlyUpdated method. Return a list of your photos that have been recently created or which have been recently modified. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) RecentlyUpdated(ctx context.Context, cl client.Client, args PhotosRecentlyUpdatedArgs) (*PhotosRecentlyUpdatedResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.recentlyUpdated", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosRecentlyUpdatedResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.recentlyUpdated response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosRemoveTagArgs is a struct containing the arguments for the flickr.photos.removeTag method.
+type PhotosRemoveTagArgs struct {
+	// The tag to remove from the photo. This parameter should contain a tag id, as returned by flickr.photos.getInfo. Required.
+	TagId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosRemoveTagArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.TagId == "" {
+		return nil, fmt.Errorf("Missing required tag_id argument")
+	}
+
+	args.Set("tag_id", a.TagId)
+
+	return args, nil
+}
+
+// PhotosRemoveTagResponse is a struct containing the response for the flickr.photos.removeTag method.
+type PhotosRemoveTagResponse struct {
+	*Response
+}
+
+// RemoveTag calls the flickr.photos.removeTag method. Remove a tag from a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) RemoveTag(ctx context.Context, cl client.Client, args PhotosRemoveTagArgs) (*PhotosRemoveTagResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.removeTag", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosRemoveTagResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSearchArgs is a struct containing the arguments for the flickr.photos.search method.
+type PhotosSearchArgs struct {
+	// The NSID of the user who's photo to search. If this parameter isn't passed then everybody's public photos will be searched. A value of "me" will search against the calling user's photos for authenticated calls.
+	UserId string
+	// A comma-delimited list of tags. Photos with one or more of the tags listed will be returned. You can exclude results that match a term by prepending it with a - character.
+	Tags string
+	// Either 'any' for an OR combination of tags, or 'all' for an AND combination. Defaults to 'any' if not specified.
+	TagMode string
+	// A free text search. Photos who's title, description or tags contain the text will be returned. You can exclude results that match a term by prepending it with a - character.
+	Text string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+	// The license id for photos (for possible values see the flickr.photos.licenses.getInfo method). Multiple licenses may be comma-separated.
+	License string
+	// The order in which to sort returned photos. Defaults to date-posted-desc (unless you are doing a radial geo query, in which case the default sorting is by ascending distance from the point specified). The possible values are: date-posted-asc, date-posted-desc, date-taken-asc, date-taken-desc, interestingness-desc, interestingness-asc, and relevance.
+	Sort string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view photos you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// A comma-delimited list of 4 values defining the Bounding Box of the area that will be searched. The 4 values represent the bottom-left corner of the box and the top-right corner, minimum_longitude, minimum_latitude, maximum_longitude, maximum_latitude.
+	Bbox string
+	// Recorded accuracy level of the location information. Current range is 1-16.
+	Accuracy string
+	// Safe search setting: 1 for safe, 2 for moderate, 3 for restricted.
+	SafeSearch string
+	// Content Type setting: 1 for photos only, 2 for screenshots only, 3 for 'other' only, 4 for photos and screenshots, 5 for screenshots and 'other', 6 for photos and 'other', 7 for photos, screenshots, and 'other' (all).
+	ContentType string
+	// Aside from passing in a fully formed machine tag, there is a special syntax for searching specific properties: find photos using the 'dc' namespace: "machine_tags" => "dc:"; find photos with a title in the 'dc' namespace: "machine_tags" => "dc:title=".
+	MachineTags string
+	// Either 'any' for an OR combination of tags, or 'all' for an AND combination. Defaults to 'any' if not specified.
+	MachineTagMode string
+	// The id of a group who's pool to search. If specified, only matching photos posted to the group's pool will be returned.
+	GroupId string
+	// Search your contacts. Either 'all' or 'ff' for just friends and family. (Experimental)
+	Contacts string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// Filter results by media type. Possible values are all (default), photos or videos
+	Media string
+	// Any photo that has been geotagged, or if the value is "0" any photo that has not been geotagged.
+	HasGeo string
+	// Geo context is a numeric value representing the photo's geotagginess beyond latitude and longitude. 0, not defined. 1, indoors. 2, outdoors.
+	GeoContext string
+	// A valid latitude, in decimal format, for doing radial geo queries.
+	Lat string
+	// A valid longitude, in decimal format, for doing radial geo queries.
+	Lon string
+	// A valid radius used for geo queries, greater than zero and less than 20 miles (or 32 kilometers), for use with point-based geo queries. The default value is 5 (km).
+	Radius string
+	// The unit of measure when doing radial geo queries. Valid options are "mi" (miles) and "km" (kilometers). The default is "km".
+	RadiusUnits string
+	// Limit the scope of the search to only photos that are part of the Flickr Commons project. Default is false.
+	IsCommons string
+	// Limit the scope of the search to only photos that are in a gallery? Default is false, search all photos.
+	InGallery string
+	// Limit the scope of the search to only photos that are for sale on Getty. Default is false.
+	IsGetty string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSearchArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	if a.Tags != "" {
+		args.Set("tags", a.Tags)
+	}
+
+	if a.TagMode != "" {
+		args.Set("tag_mode", a.TagMode)
+	}
+
+	if a.Text != "" {
+		args.Set("text", a.Text)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	if a.License != "" {
+		args.Set("license", a.License)
+	}
+
+	if a.Sort != "" {
+		args.Set("sort", a.Sort)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Bbox != "" {
+		args.Set("bbox", a.Bbox)
+	}
+
+	if a.Accuracy != "" {
+		args.Set("accuracy", a.Accuracy)
+	}
+
+	if a.SafeSearch != "" {
+		args.Set("safe_search", a.SafeSearch)
+	}
+
+	if a.ContentType != "" {
+		args.Set("content_type", a.ContentType)
+	}
+
+	if a.MachineTags != "" {
+		args.Set("machine_tags", a.MachineTags)
+	}
+
+	if a.MachineTagMode != "" {
+		args.Set("machine_tag_mode", a.MachineTagMode)
+	}
+
+	if a.GroupId != "" {
+		args.Set("group_id", a.GroupId)
+	}
+
+	if a.Contacts != "" {
+		args.Set("contacts", a.Contacts)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.Media != "" {
+		args.Set("media", a.Media)
+	}
+
+	if a.HasGeo != "" {
+		args.Set("has_geo", a.HasGeo)
+	}
+
+	if a.GeoContext != "" {
+		args.Set("geo_context", a.GeoContext)
+	}
+
+	if a.Lat != "" {
+		args.Set("lat", a.Lat)
+	}
+
+	if a.Lon != "" {
+		args.Set("lon", a.Lon)
+	}
+
+	if a.Radius != "" {
+		args.Set("radius", a.Radius)
+	}
+
+	if a.RadiusUnits != "" {
+		args.Set("radius_units", a.RadiusUnits)
+	}
+
+	if a.IsCommons != "" {
+		args.Set("is_commons", a.IsCommons)
+	}
+
+	if a.InGallery != "" {
+		args.Set("in_gallery", a.InGallery)
+	}
+
+	if a.IsGetty != "" {
+		args.Set("is_getty", a.IsGetty)
+	}
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// PhotosSearchResponse is a struct containing the response for the flickr.photos.search method.
+type PhotosSearchResponse struct {
+	*Response
+	// The "photos" element in the API response.
+	Photos *response.Photos `json:"photos"`
+}
+
+// Search calls the flickr.photos.search method. Return a list of photos matching some criteria. Only photos visible to the calling user will be returned. To return private or semi-private photos, the caller must be authenticated with 'read' permissions, and have permission to view the photos. Unauthenticated calls will only return public photos.
+func (PhotosNamespace) Search(ctx context.Context, cl client.Client, args PhotosSearchArgs) (*PhotosSearchResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.search", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSearchResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.search response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosSetContentTypeArgs is a struct containing the arguments for the flickr.photos.setContentType method.
+type PhotosSetContentTypeArgs struct {
+	// The id of the photo to set the content type of. Required.
+	PhotoId string
+	// The content type of the photo. Must be one of: 1 for Photo, 2 for Screenshot, and 3 for Other. Required.
+	ContentType string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSetContentTypeArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.ContentType == "" {
+		return nil, fmt.Errorf("Missing required content_type argument")
+	}
+
+	args.Set("content_type", a.ContentType)
+
+	return args, nil
+}
+
+// PhotosSetContentTypeResponse is a struct containing the response for the flickr.photos.setContentType method.
+type PhotosSetContentTypeResponse struct {
+	*Response
+}
+
+// SetContentType calls the flickr.photos.setContentType method. Set the content type of a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) SetContentType(ctx context.Context, cl client.Client, args PhotosSetContentTypeArgs) (*PhotosSetContentTypeResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.setContentType", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSetContentTypeResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSetDatesArgs is a struct containing the arguments for the flickr.photos.setDates method.
+type PhotosSetDatesArgs struct {
+	// The id of the photo to edit dates for. Required.
+	PhotoId string
+	// The date the photo was uploaded to flickr (see the dates documentation)
+	DatePosted string
+	// The date the photo was taken (see the dates documentation)
+	DateTaken string
+	// The granularity of the date the photo was taken (see the dates documentation)
+	DateTakenGranularity string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSetDatesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.DatePosted != "" {
+		args.Set("date_posted", a.DatePosted)
+	}
+
+	if a.DateTaken != "" {
+		args.Set("date_taken", a.DateTaken)
+	}
+
+	if a.DateTakenGranularity != "" {
+		args.Set("date_taken_granularity", a.DateTakenGranularity)
+	}
+
+	return args, nil
+}
+
+// PhotosSetDatesResponse is a struct containing the response for the flickr.photos.setDates method.
+type PhotosSetDatesResponse struct {
+	*Response
+}
+
+// SetDates calls the flickr.photos.setDates method. Set one or both of the dates for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) SetDates(ctx context.Context, cl client.Client, args PhotosSetDatesArgs) (*PhotosSetDatesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.setDates", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSetDatesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSetMetaArgs is a struct containing the arguments for the flickr.photos.setMeta method.
+type PhotosSetMetaArgs struct {
+	// The id of the photo. Required.
+	PhotoId string
+	// The title for the photo. At least one of title or description must be set.
+	Title string
+	// The description for the photo. At least one of title or description must be set.
+	Description string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSetMetaArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Title != "" {
+		args.Set("title", a.Title)
+	}
+
+	if a.Description != "" {
+		args.Set("description", a.Description)
+	}
+
+	return args, nil
+}
+
+// PhotosSetMetaResponse is a struct containing the response for the flickr.photos.setMeta method.
+type PhotosSetMetaResponse struct {
+	*Response
+}
+
+// SetMeta calls the flickr.photos.setMeta method. Set the meta information for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) SetMeta(ctx context.Context, cl client.Client, args PhotosSetMetaArgs) (*PhotosSetMetaResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.setMeta", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSetMetaResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSetPermsArgs is a struct containing the arguments for the flickr.photos.setPerms method.
+type PhotosSetPermsArgs struct {
+	// The id of the photo to set permissions for. Required.
+	PhotoId string
+	// 1 to set the photo to public, 0 to set it to private. Required.
+	IsPublic string
+	// 1 to make the photo visible to friends when private, 0 to not. Required.
+	IsFriend string
+	// 1 to make the photo visible to family when private, 0 to not. Required.
+	IsFamily string
+	// who can add comments to the photo and it's notes. one of: 0: nobody, 1: friends & family, 2: contacts, 3: everybody
+	PermComment string
+	// who can add notes and tags to the photo. one of: 0: nobody / just the owner, 1: friends & family, 2: contacts, 3: everybody
+	PermAddmeta string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSetPermsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.IsPublic == "" {
+		return nil, fmt.Errorf("Missing required is_public argument")
+	}
+
+	args.Set("is_public", a.IsPublic)
+
+	if a.IsFriend == "" {
+		return nil, fmt.Errorf("Missing required is_friend argument")
+	}
+
+	args.Set("is_friend", a.IsFriend)
+
+	if a.IsFamily == "" {
+		return nil, fmt.Errorf("Missing required is_family argument")
+	}
+
+	args.Set("is_family", a.IsFamily)
+
+	if a.PermComment != "" {
+		args.Set("perm_comment", a.PermComment)
+	}
+
+	if a.PermAddmeta != "" {
+		args.Set("perm_addmeta", a.PermAddmeta)
+	}
+
+	return args, nil
+}
+
+// PhotosSetPermsResponse is a struct containing the response for the flickr.photos.setPerms method.
+type PhotosSetPermsResponse struct {
+	*Response
+}
+
+// SetPerms calls the flickr.photos.setPerms method. Set permissions for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) SetPerms(ctx context.Context, cl client.Client, args PhotosSetPermsArgs) (*PhotosSetPermsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.setPerms", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSetPermsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSetSafetyLevelArgs is a struct containing the arguments for the flickr.photos.setSafetyLevel method.
+type PhotosSetSafetyLevelArgs struct {
+	// The id of the photo to set the adultness of. Required.
+	PhotoId string
+	// The safety level of the photo. Must be one of: 1 for Safe, 2 for Moderate, and 3 for Restricted.
+	SafetyLevel string
+	// Whether or not to additionally hide the photo from public searches. Must be either 1 for Yes or 0 for No.
+	Hidden string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSetSafetyLevelArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.SafetyLevel != "" {
+		args.Set("safety_level", a.SafetyLevel)
+	}
+
+	if a.Hidden != "" {
+		args.Set("hidden", a.Hidden)
+	}
+
+	return args, nil
+}
+
+// PhotosSetSafetyLevelResponse is a struct containing the response for the flickr.photos.setSafetyLevel method.
+type PhotosSetSafetyLevelResponse struct {
+	*Response
+}
+
+// SetSafetyLevel calls the flickr.photos.setSafetyLevel method. Set the safety level of a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) SetSafetyLevel(ctx context.Context, cl client.Client, args PhotosSetSafetyLevelArgs) (*PhotosSetSafetyLevelResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.setSafetyLevel", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSetSafetyLevelResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSetTagsArgs is a struct containing the arguments for the flickr.photos.setTags method.
+type PhotosSetTagsArgs struct {
+	// The id of the photo to set tags for. Required.
+	PhotoId string
+	// All tags for the photo (as a single space-delimited string). Required.
+	Tags string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSetTagsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Tags == "" {
+		return nil, fmt.Errorf("Missing required tags argument")
+	}
+
+	args.Set("tags", a.Tags)
+
+	return args, nil
+}
+
+// PhotosSetTagsResponse is a struct containing the response for the flickr.photos.setTags method.
+type PhotosSetTagsResponse struct {
+	*Response
+}
+
+// SetTags calls the flickr.photos.setTags method. Set the tags for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosNamespace) SetTags(ctx context.Context, cl client.Client, args PhotosSetTagsArgs) (*PhotosSetTagsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.setTags", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSetTagsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSuggestionsApproveSuggestionArgs is a struct containing the arguments for the flickr.photos.suggestions.approveSuggestion method.
+type PhotosSuggestionsApproveSuggestionArgs struct {
+	// The unique ID of the suggestion. Required.
+	SuggestionId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSuggestionsApproveSuggestionArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.SuggestionId == "" {
+		return nil, fmt.Errorf("Missing required suggestion_id argument")
+	}
+
+	args.Set("suggestion_id", a.SuggestionId)
+
+	return args, nil
+}
+
+// PhotosSuggestionsApproveSuggestionResponse is a struct containing the response for the flickr.photos.suggestions.approveSuggestion method.
+type PhotosSuggestionsApproveSuggestionResponse struct {
+	*Response
+}
+
+// ApproveSuggestion calls the flickr.photos.suggestions.approveSuggestion method. Approve a suggestion for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosSuggestionsNamespace) ApproveSuggestion(ctx context.Context, cl client.Client, args PhotosSuggestionsApproveSuggestionArgs) (*PhotosSuggestionsApproveSuggestionResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.suggestions.approveSuggestion", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSuggestionsApproveSuggestionResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSuggestionsGetListArgs is a struct containing the arguments for the flickr.photos.suggestions.getList method.
+type PhotosSuggestionsGetListArgs struct {
+	// The photo ID to add to the gallery
+	PhotoId string
+	// The status_id argument.
+	StatusId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSuggestionsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId != "" {
+		args.Set("photo_id", a.PhotoId)
+	}
+
+	if a.StatusId != "" {
+		args.Set("status_id", a.StatusId)
+	}
+
+	return args, nil
+}
+
+// PhotosSuggestionsGetListResponse is a struct containing the response for the flickr.photos.suggestions.getList method.
+type PhotosSuggestionsGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.photos.suggestions.getList method. Return a list of suggestions for a user that are pending approval. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosSuggestionsNamespace) GetList(ctx context.Context, cl client.Client, args PhotosSuggestionsGetListArgs) (*PhotosSuggestionsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.suggestions.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSuggestionsGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSuggestionsRejectSuggestionArgs is a struct containing the arguments for the flickr.photos.suggestions.rejectSuggestion method.
+type PhotosSuggestionsRejectSuggestionArgs struct {
+	// The unique ID of the suggestion. Required.
+	SuggestionId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSuggestionsRejectSuggestionArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.SuggestionId == "" {
+		return nil, fmt.Errorf("Missing required suggestion_id argument")
+	}
+
+	args.Set("suggestion_id", a.SuggestionId)
+
+	return args, nil
+}
+
+// PhotosSuggestionsRejectSuggestionResponse is a struct containing the response for the flickr.photos.suggestions.rejectSuggestion method.
+type PhotosSuggestionsRejectSuggestionResponse struct {
+	*Response
+}
+
+// RejectSuggestion calls the flickr.photos.suggestions.rejectSuggestion method. Reject a suggestion for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosSuggestionsNamespace) RejectSuggestion(ctx context.Context, cl client.Client, args PhotosSuggestionsRejectSuggestionArgs) (*PhotosSuggestionsRejectSuggestionResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.suggestions.rejectSuggestion", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSuggestionsRejectSuggestionResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSuggestionsRemoveSuggestionArgs is a struct containing the arguments for the flickr.photos.suggestions.removeSuggestion method.
+type PhotosSuggestionsRemoveSuggestionArgs struct {
+	// The unique ID of the suggestion. Required.
+	SuggestionId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSuggestionsRemoveSuggestionArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.SuggestionId == "" {
+		return nil, fmt.Errorf("Missing required suggestion_id argument")
+	}
+
+	args.Set("suggestion_id", a.SuggestionId)
+
+	return args, nil
+}
+
+// PhotosSuggestionsRemoveSuggestionResponse is a struct containing the response for the flickr.photos.suggestions.removeSuggestion method.
+type PhotosSuggestionsRemoveSuggestionResponse struct {
+	*Response
+}
+
+// RemoveSuggestion calls the flickr.photos.suggestions.removeSuggestion method. Remove a suggestion, made by the calling user, from a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosSuggestionsNamespace) RemoveSuggestion(ctx context.Context, cl client.Client, args PhotosSuggestionsRemoveSuggestionArgs) (*PhotosSuggestionsRemoveSuggestionResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.suggestions.removeSuggestion", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSuggestionsRemoveSuggestionResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosSuggestionsSuggestLocationArgs is a struct containing the arguments for the flickr.photos.suggestions.suggestLocation method.
+type PhotosSuggestionsSuggestLocationArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The latitude whose valid range is -90 to 90. Anything more than 6 decimal places will be truncated. Required.
+	Lat string
+	// The longitude whose valid range is -180 to 180. Anything more than 6 decimal places will be truncated. Required.
+	Lon string
+	// Recorded accuracy level of the location information. World level is 1, Country is ~3, Region ~6, City ~11, Street ~16. Current range is 1-16. Defaults to 16 if not specified.
+	Accuracy string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// The note argument.
+	Note string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosSuggestionsSuggestLocationArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Lat == "" {
+		return nil, fmt.Errorf("Missing required lat argument")
+	}
+
+	args.Set("lat", a.Lat)
+
+	if a.Lon == "" {
+		return nil, fmt.Errorf("Missing required lon argument")
+	}
+
+	args.Set("lon", a.Lon)
+
+	if a.Accuracy != "" {
+		args.Set("accuracy", a.Accuracy)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.Note != "" {
+		args.Set("note", a.Note)
+	}
+
+	return args, nil
+}
+
+// PhotosSuggestionsSuggestLocationResponse is a struct containing the response for the flickr.photos.suggestions.suggestLocation method.
+type PhotosSuggestionsSuggestLocationResponse struct {
+	*Response
+}
+
+// SuggestLocation calls the flickr.photos.suggestions.suggestLocation method. Suggest a geotagged location for a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosSuggestionsNamespace) SuggestLocation(ctx context.Context, cl client.Client, args PhotosSuggestionsSuggestLocationArgs) (*PhotosSuggestionsSuggestLocationResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.suggestions.suggestLocation", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosSuggestionsSuggestLocationResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosTransformRotateArgs is a struct containing the arguments for the flickr.photos.transform.rotate method.
+type PhotosTransformRotateArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The degrees argument. Required.
+	Degrees string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosTransformRotateArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.Degrees == "" {
+		return nil, fmt.Errorf("Missing required degrees argument")
+	}
+
+	args.Set("degrees", a.Degrees)
+
+	return args, nil
+}
+
+// PhotosTransformRotateResponse is a struct containing the response for the flickr.photos.transform.rotate method.
+type PhotosTransformRotateResponse struct {
+	*Response
+}
+
+// Rotate calls the flickr.photos.transform.rotate method. Rotate a photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosTransformNamespace) Rotate(ctx context.Context, cl client.Client, args PhotosTransformRotateArgs) (*PhotosTransformRotateResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.transform.rotate", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosTransformRotateResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosUploadCheckTicketsArgs is a struct containing the arguments for the flickr.photos.upload.checkTickets method.
+type PhotosUploadCheckTicketsArgs struct {
+	// A comma-delimited list of ticket ids Required.
+	Tickets string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosUploadCheckTicketsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Tickets == "" {
+		return nil, fmt.Errorf("Missing required tickets argument")
+	}
+
+	args.Set("tickets", a.Tickets)
+
+	return args, nil
+}
+
+// PhotosUploadCheckTicketsResponse is a struct containing the response for the flickr.photos.upload.checkTickets method.
+type PhotosUploadCheckTicketsResponse struct {
+	*Response
+	// The "uploader" element in the API response.
+	Uploader *response.Uploader `json:"uploader"`
+}
+
+// CheckTickets calls the flickr.photos.upload.checkTickets method. Checks the status of one or more asynchronous photo upload tickets.
+func (PhotosUploadNamespace) CheckTickets(ctx context.Context, cl client.Client, args PhotosUploadCheckTicketsArgs) (*PhotosUploadCheckTicketsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photos.upload.checkTickets", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosUploadCheckTicketsResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photos.upload.checkTickets response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosetsAddPhotoArgs is a struct containing the arguments for the flickr.photosets.addPhoto method.
+type PhotosetsAddPhotoArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+	// The id of the photo to add to the set. Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsAddPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosetsAddPhotoResponse is a struct containing the response for the flickr.photosets.addPhoto method.
+type PhotosetsAddPhotoResponse struct {
+	*Response
+}
+
+// AddPhoto calls the flickr.photosets.addPhoto method. Add a photo to the end of an existing photoset. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) AddPhoto(ctx context.Context, cl client.Client, args PhotosetsAddPhotoArgs) (*PhotosetsAddPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.addPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsAddPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsCommentsAddCommentArgs is a struct containing the arguments for the flickr.photosets.comments.addComment method.
+type PhotosetsCommentsAddCommentArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+	// Text of the comment. Required.
+	CommentText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsCommentsAddCommentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.CommentText == "" {
+		return nil, fmt.Errorf("Missing required comment_text argument")
+	}
+
+	args.Set("comment_text", a.CommentText)
+
+	return args, nil
+}
+
+// PhotosetsCommentsAddCommentResponse is a struct containing the response for the flickr.photosets.comments.addComment method.
+type PhotosetsCommentsAddCommentResponse struct {
+	*Response
+}
+
+// AddComment calls the flickr.photosets.comments.addComment method. Add a comment to a photoset. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsCommentsNamespace) AddComment(ctx context.Context, cl client.Client, args PhotosetsCommentsAddCommentArgs) (*PhotosetsCommentsAddCommentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.comments.addComment", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsCommentsAddCommentResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsCommentsDeleteCommentArgs is a struct containing the arguments for the flickr.photosets.comments.deleteComment method.
+type PhotosetsCommentsDeleteCommentArgs struct {
+	// The id of the comment. Required.
+	CommentId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsCommentsDeleteCommentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CommentId == "" {
+		return nil, fmt.Errorf("Missing required comment_id argument")
+	}
+
+	args.Set("comment_id", a.CommentId)
+
+	return args, nil
+}
+
+// PhotosetsCommentsDeleteCommentResponse is a struct containing the response for the flickr.photosets.comments.deleteComment method.
+type PhotosetsCommentsDeleteCommentResponse struct {
+	*Response
+}
+
+// DeleteComment calls the flickr.photosets.comments.deleteComment method. Delete a photoset comment as the currently authenticated user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsCommentsNamespace) DeleteComment(ctx context.Context, cl client.Client, args PhotosetsCommentsDeleteCommentArgs) (*PhotosetsCommentsDeleteCommentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.comments.deleteComment", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsCommentsDeleteCommentResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsCommentsEditCommentArgs is a struct containing the arguments for the flickr.photosets.comments.editComment method.
+type PhotosetsCommentsEditCommentArgs struct {
+	// The id of the comment. Required.
+	CommentId string
+	// Text of the comment. Required.
+	CommentText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsCommentsEditCommentArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.CommentId == "" {
+		return nil, fmt.Errorf("Missing required comment_id argument")
+	}
+
+	args.Set("comment_id", a.CommentId)
+
+	if a.CommentText == "" {
+		return nil, fmt.Errorf("Missing required comment_text argument")
+	}
+
+	args.Set("comment_text", a.CommentText)
+
+	return args, nil
+}
+
+// PhotosetsCommentsEditCommentResponse is a struct containing the response for the flickr.photosets.comments.editComment method.
+type PhotosetsCommentsEditCommentResponse struct {
+	*Response
+}
+
+// EditComment calls the flickr.photosets.comments.editComment method. Edit the text of a comment as the currently authenticated user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsCommentsNamespace) EditComment(ctx context.Context, cl client.Client, args PhotosetsCommentsEditCommentArgs) (*PhotosetsCommentsEditCommentResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.comments.editComment", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsCommentsEditCommentResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsCommentsGetListArgs is a struct containing the arguments for the flickr.photosets.comments.getList method.
+type PhotosetsCommentsGetListArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsCommentsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	return args, nil
+}
+
+// PhotosetsCommentsGetListResponse is a struct containing the response for the flickr.photosets.comments.getList method.
+type PhotosetsCommentsGetListResponse struct {
+	*Response
+}
+
+// GetList calls the flickr.photosets.comments.getList method. Returns the comments for a photoset.
+func (PhotosetsCommentsNamespace) GetList(ctx context.Context, cl client.Client, args PhotosetsCommentsGetListArgs) (*PhotosetsCommentsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.comments.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsCommentsGetListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsCreateArgs is a struct containing the arguments for the flickr.photosets.create method.
+type PhotosetsCreateArgs struct {
+	// A title for the photoset. Required.
+	Title string
+	// A description of the photoset. May contain limited html.
+	Description string
+	// The id of the photo to represent this set. The photo must belong to the calling user. Required.
+	PrimaryPhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsCreateArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Title == "" {
+		return nil, fmt.Errorf("Missing required title argument")
+	}
+
+	args.Set("title", a.Title)
+
+	if a.Description != "" {
+		args.Set("description", a.Description)
+	}
+
+	if a.PrimaryPhotoId == "" {
+		return nil, fmt.Errorf("Missing required primary_photo_id argument")
+	}
+
+	args.Set("primary_photo_id", a.PrimaryPhotoId)
+
+	return args, nil
+}
+
+// PhotosetsCreateResponse is a struct containing the response for the flickr.photosets.create method.
+type PhotosetsCreateResponse struct {
+	*Response
+	// The "photoset" element in the API response.
+	Photoset *response.Photoset `json:"photoset"`
+}
+
+// Create calls the flickr.photosets.create method. Create a new photoset for the calling user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) Create(ctx context.Context, cl client.Client, args PhotosetsCreateArgs) (*PhotosetsCreateResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.create", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsCreateResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photosets.create response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosetsDeleteArgs is a struct containing the arguments for the flickr.photosets.delete method.
+type PhotosetsDeleteArgs struct {
+	// The id of the photoset to delete. It must be owned by the calling user. Required.
+	PhotosetId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsDeleteArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	return args, nil
+}
+
+// PhotosetsDeleteResponse is a struct containing the response for the flickr.photosets.delete method.
+type PhotosetsDeleteResponse struct {
+	*Response
+}
+
+// Delete calls the flickr.photosets.delete method. Delete a photoset. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) Delete(ctx context.Context, cl client.Client, args PhotosetsDeleteArgs) (*PhotosetsDeleteResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.delete", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsDeleteResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsEditMetaArgs is a struct containing the arguments for the flickr.photosets.editMeta method.
+type PhotosetsEditMetaArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+	// The title for the photo. At least one of title or description must be set. Required.
+	Title string
+	// The description for the photo. At least one of title or description must be set.
+	Description string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsEditMetaArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.Title == "" {
+		return nil, fmt.Errorf("Missing required title argument")
+	}
+
+	args.Set("title", a.Title)
+
+	if a.Description != "" {
+		args.Set("description", a.Description)
+	}
+
+	return args, nil
+}
+
+// PhotosetsEditMetaResponse is a struct containing the response for the flickr.photosets.editMeta method.
+type PhotosetsEditMetaResponse struct {
+	*Response
+}
+
+// EditMeta calls the flickr.photosets.editMeta method. Modify the meta-data for a photoset. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) EditMeta(ctx context.Context, cl client.Client, args PhotosetsEditMetaArgs) (*PhotosetsEditMetaResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.editMeta", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsEditMetaResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsEditPhotosArgs is a struct containing the arguments for the flickr.photosets.editPhotos method.
+type PhotosetsEditPhotosArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+	// The id of the photo to represent this set. The photo must belong to the calling user. Required.
+	PrimaryPhotoId string
+	// A comma-separated list of photo ids. If specified, each returned set will include a list of these photo ids that are present in the set as "has_requested_photos" Required.
+	PhotoIds string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsEditPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.PrimaryPhotoId == "" {
+		return nil, fmt.Errorf("Missing required primary_photo_id argument")
+	}
+
+	args.Set("primary_photo_id", a.PrimaryPhotoId)
+
+	if a.PhotoIds == "" {
+		return nil, fmt.Errorf("Missing required photo_ids argument")
+	}
+
+	args.Set("photo_ids", a.PhotoIds)
+
+	return args, nil
+}
+
+// PhotosetsEditPhotosResponse is a struct containing the response for the flickr.photosets.editPhotos method.
+type PhotosetsEditPhotosResponse struct {
+	*Response
+}
+
+// EditPhotos calls the flickr.photosets.editPhotos method. Modify the photos in a photoset. Use this method to add, remove and re-order photos. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) EditPhotos(ctx context.Context, cl client.Client, args PhotosetsEditPhotosArgs) (*PhotosetsEditPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.editPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsEditPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsGetContextArgs is a struct containing the arguments for the flickr.photosets.getContext method.
+type PhotosetsGetContextArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsGetContextArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	return args, nil
+}
+
+// PhotosetsGetContextResponse is a struct containing the response for the flickr.photosets.getContext method.
+type PhotosetsGetContextResponse struct {
+	*Response
+}
+
+// GetContext calls the flickr.photosets.getContext method. Returns next and previous photos for a photo in a set.
+func (PhotosetsNamespace) GetContext(ctx context.Context, cl client.Client, args PhotosetsGetContextArgs) (*PhotosetsGetContextResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.getContext", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsGetContextResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsGetInfoArgs is a struct containing the arguments for the flickr.photosets.getInfo method.
+type PhotosetsGetInfoArgs struct {
+	// The ID of the photoset to fetch information for. Required.
+	PhotosetId string
+	// The user_id here is the owner of the set passed in photoset_id. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// PhotosetsGetInfoResponse is a struct containing the response for the flickr.photosets.getInfo method.
+type PhotosetsGetInfoResponse struct {
+	*Response
+	// The "photoset" element in the API response.
+	Photoset *response.Photoset `json:"photoset"`
+}
+
+// GetInfo calls the flickr.photosets.getInfo method. Gets information about a photoset.
+func (PhotosetsNamespace) GetInfo(ctx context.Context, cl client.Client, args PhotosetsGetInfoArgs) (*PhotosetsGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsGetInfoResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photosets.getInfo response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosetsGetListArgs is a struct containing the arguments for the flickr.photosets.getList method.
+type PhotosetsGetListArgs struct {
+	// The NSID of the user to get a photoset list for. If none is specified, the calling user is assumed.
+	UserId string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// The number of sets to get per page. If paging is enabled, the maximum number of sets per page is 500.
+	PerPage string
+	// A comma-delimited list of extra information to fetch for the primary photo.
+	PrimaryPhotoExtras string
+	// A comma-separated list of photo ids. If specified, each returned set will include a list of these photo ids that are present in the set as "has_requested_photos"
+	PhotoIds string
+	// A comma-separated list of groups used to sort the output sets.
+	SortGroups string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsGetListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.PrimaryPhotoExtras != "" {
+		args.Set("primary_photo_extras", a.PrimaryPhotoExtras)
+	}
+
+	if a.PhotoIds != "" {
+		args.Set("photo_ids", a.PhotoIds)
+	}
+
+	if a.SortGroups != "" {
+		args.Set("sort_groups", a.SortGroups)
+	}
+
+	return args, nil
+}
+
+// PhotosetsGetListResponse is a struct containing the response for the flickr.photosets.getList method.
+type PhotosetsGetListResponse struct {
+	*Response
+	// The "photosets" element in the API response.
+	Photosets *response.Photosets `json:"photosets"`
+}
+
+// GetList calls the flickr.photosets.getList method. Returns the photosets belonging to the specified user.
+func (PhotosetsNamespace) GetList(ctx context.Context, cl client.Client, args PhotosetsGetListArgs) (*PhotosetsGetListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.getList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsGetListResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photosets.getList response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosetsGetPhotosArgs is a struct containing the arguments for the flickr.photosets.getPhotos method.
+type PhotosetsGetPhotosArgs struct {
+	// The id of the photoset to return the photos for. Required.
+	PhotosetId string
+	// The user_id here is the owner of the set passed in photoset_id. Required.
+	UserId string
+	// A comma-delimited list of extra information to fetch for each returned record. Currently supported fields are: description, license, date_upload, date_taken, owner_name, icon_server, original_format, last_update, geo, tags, machine_tags, o_dims, views, media, path_alias, url_sq, url_t, url_s, url_q, url_m, url_n, url_z, url_c, url_l, url_o
+	Extras string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 500. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Return photos only matching a certain privacy level. This only applies when making an authenticated call to view a photoset you own. Valid values are: 1 public photos, 2 private photos visible to friends, 3 private photos visible to family, 4 private photos visible to friends & family, 5 completely private photos.
+	PrivacyFilter string
+	// Filter results by media type. Possible values are all (default), photos or videos
+	Media string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsGetPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.Extras != "" {
+		args.Set("extras", a.Extras)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PrivacyFilter != "" {
+		args.Set("privacy_filter", a.PrivacyFilter)
+	}
+
+	if a.Media != "" {
+		args.Set("media", a.Media)
+	}
+
+	return args, nil
+}
+
+// PhotosetsGetPhotosResponse is a struct containing the response for the flickr.photosets.getPhotos method.
+type PhotosetsGetPhotosResponse struct {
+	*Response
+	// The "photoset" element in the API response.
+	Photoset *response.PhotosetPhotos `json:"photoset"`
+}
+
+// GetPhotos calls the flickr.photosets.getPhotos method. Get the list of photos in a set.
+func (PhotosetsNamespace) GetPhotos(ctx context.Context, cl client.Client, args PhotosetsGetPhotosArgs) (*PhotosetsGetPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.getPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsGetPhotosResponse{Response: rsp}
+
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal flickr.photosets.getPhotos response, %w", err)
+	}
+
+	return r, nil
+}
+
+// PhotosetsOrderSetsArgs is a struct containing the arguments for the flickr.photosets.orderSets method.
+type PhotosetsOrderSetsArgs struct {
+	// The photoset_ids argument. Required.
+	PhotosetIds string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsOrderSetsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetIds == "" {
+		return nil, fmt.Errorf("Missing required photoset_ids argument")
+	}
+
+	args.Set("photoset_ids", a.PhotosetIds)
+
+	return args, nil
+}
+
+// PhotosetsOrderSetsResponse is a struct containing the response for the flickr.photosets.orderSets method.
+type PhotosetsOrderSetsResponse struct {
+	*Response
+}
+
+// OrderSets calls the flickr.photosets.orderSets method. Set the order of photosets for the calling user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) OrderSets(ctx context.Context, cl client.Client, args PhotosetsOrderSetsArgs) (*PhotosetsOrderSetsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.orderSets", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsOrderSetsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsRemovePhotoArgs is a struct containing the arguments for the flickr.photosets.removePhoto method.
+type PhotosetsRemovePhotoArgs struct {
+	// The id of the photoset to remove a photo from. Required.
+	PhotosetId string
+	// The id of the photo to remove from the set. Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsRemovePhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosetsRemovePhotoResponse is a struct containing the response for the flickr.photosets.removePhoto method.
+type PhotosetsRemovePhotoResponse struct {
+	*Response
+}
+
+// RemovePhoto calls the flickr.photosets.removePhoto method. Remove a photo from a photoset. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) RemovePhoto(ctx context.Context, cl client.Client, args PhotosetsRemovePhotoArgs) (*PhotosetsRemovePhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.removePhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsRemovePhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsRemovePhotosArgs is a struct containing the arguments for the flickr.photosets.removePhotos method.
+type PhotosetsRemovePhotosArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+	// A comma-separated list of photo ids. If specified, each returned set will include a list of these photo ids that are present in the set as "has_requested_photos" Required.
+	PhotoIds string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsRemovePhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.PhotoIds == "" {
+		return nil, fmt.Errorf("Missing required photo_ids argument")
+	}
+
+	args.Set("photo_ids", a.PhotoIds)
+
+	return args, nil
+}
+
+// PhotosetsRemovePhotosResponse is a struct containing the response for the flickr.photosets.removePhotos method.
+type PhotosetsRemovePhotosResponse struct {
+	*Response
+}
+
+// RemovePhotos calls the flickr.photosets.removePhotos method. Remove multiple photos from a photoset. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) RemovePhotos(ctx context.Context, cl client.Client, args PhotosetsRemovePhotosArgs) (*PhotosetsRemovePhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.removePhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsRemovePhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsReorderPhotosArgs is a struct containing the arguments for the flickr.photosets.reorderPhotos method.
+type PhotosetsReorderPhotosArgs struct {
+	// The id of the photoset to reorder. The photoset must belong to the calling user. Required.
+	PhotosetId string
+	// Ordered, comma-delimited list of photo ids. Photos that are not in the list will keep their original order Required.
+	PhotoIds string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsReorderPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.PhotoIds == "" {
+		return nil, fmt.Errorf("Missing required photo_ids argument")
+	}
+
+	args.Set("photo_ids", a.PhotoIds)
+
+	return args, nil
+}
+
+// PhotosetsReorderPhotosResponse is a struct containing the response for the flickr.photosets.reorderPhotos method.
+type PhotosetsReorderPhotosResponse struct {
+	*Response
+}
+
+// ReorderPhotos calls the flickr.photosets.reorderPhotos method. Reorder some or all of the photos in a set. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) ReorderPhotos(ctx context.Context, cl client.Client, args PhotosetsReorderPhotosArgs) (*PhotosetsReorderPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.reorderPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsReorderPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PhotosetsSetPrimaryPhotoArgs is a struct containing the arguments for the flickr.photosets.setPrimaryPhoto method.
+type PhotosetsSetPrimaryPhotoArgs struct {
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PhotosetsSetPrimaryPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// PhotosetsSetPrimaryPhotoResponse is a struct containing the response for the flickr.photosets.setPrimaryPhoto method.
+type PhotosetsSetPrimaryPhotoResponse struct {
+	*Response
+}
+
+// SetPrimaryPhoto calls the flickr.photosets.setPrimaryPhoto method. Set photoset primary photo. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (PhotosetsNamespace) SetPrimaryPhoto(ctx context.Context, cl client.Client, args PhotosetsSetPrimaryPhotoArgs) (*PhotosetsSetPrimaryPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.photosets.setPrimaryPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PhotosetsSetPrimaryPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesFindArgs is a struct containing the arguments for the flickr.places.find method.
+type PlacesFindArgs struct {
+	// The query argument. Required.
+	Query string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesFindArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Query == "" {
+		return nil, fmt.Errorf("Missing required query argument")
+	}
+
+	args.Set("query", a.Query)
+
+	return args, nil
+}
+
+// PlacesFindResponse is a struct containing the response for the flickr.places.find method.
+type PlacesFindResponse struct {
+	*Response
+}
+
+// Find calls the flickr.places.find method. Return a list of place IDs for a query string.
+func (PlacesNamespace) Find(ctx context.Context, cl client.Client, args PlacesFindArgs) (*PlacesFindResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.find", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesFindResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesFindByLatLonArgs is a struct containing the arguments for the flickr.places.findByLatLon method.
+type PlacesFindByLatLonArgs struct {
+	// The latitude whose valid range is -90 to 90. Anything more than 6 decimal places will be truncated. Required.
+	Lat string
+	// The longitude whose valid range is -180 to 180. Anything more than 6 decimal places will be truncated. Required.
+	Lon string
+	// Recorded accuracy level of the location information. World level is 1, Country is ~3, Region ~6, City ~11, Street ~16. Current range is 1-16. Defaults to 16 if not specified.
+	Accuracy string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesFindByLatLonArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Lat == "" {
+		return nil, fmt.Errorf("Missing required lat argument")
+	}
+
+	args.Set("lat", a.Lat)
+
+	if a.Lon == "" {
+		return nil, fmt.Errorf("Missing required lon argument")
+	}
+
+	args.Set("lon", a.Lon)
+
+	if a.Accuracy != "" {
+		args.Set("accuracy", a.Accuracy)
+	}
+
+	return args, nil
+}
+
+// PlacesFindByLatLonResponse is a struct containing the response for the flickr.places.findByLatLon method.
+type PlacesFindByLatLonResponse struct {
+	*Response
+}
+
+// FindByLatLon calls the flickr.places.findByLatLon method. Return a place ID for a latitude, longitude and accuracy triple.
+func (PlacesNamespace) FindByLatLon(ctx context.Context, cl client.Client, args PlacesFindByLatLonArgs) (*PlacesFindByLatLonResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.findByLatLon", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesFindByLatLonResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesGetChildrenWithPhotosPublicArgs is a struct containing the arguments for the flickr.places.getChildrenWithPhotosPublic method.
+type PlacesGetChildrenWithPhotosPublicArgs struct {
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesGetChildrenWithPhotosPublicArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	return args, nil
+}
+
+// PlacesGetChildrenWithPhotosPublicResponse is a struct containing the response for the flickr.places.getChildrenWithPhotosPublic method.
+type PlacesGetChildrenWithPhotosPublicResponse struct {
+	*Response
+}
+
+// GetChildrenWithPhotosPublic calls the flickr.places.getChildrenWithPhotosPublic method. Return a list of locations with public photos that are parented by a Where on Earth (WOE) or Places ID.
+func (PlacesNamespace) GetChildrenWithPhotosPublic(ctx context.Context, cl client.Client, args PlacesGetChildrenWithPhotosPublicArgs) (*PlacesGetChildrenWithPhotosPublicResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.getChildrenWithPhotosPublic", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesGetChildrenWithPhotosPublicResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesGetInfoArgs is a struct containing the arguments for the flickr.places.getInfo method.
+type PlacesGetInfoArgs struct {
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesGetInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	return args, nil
+}
+
+// PlacesGetInfoResponse is a struct containing the response for the flickr.places.getInfo method.
+type PlacesGetInfoResponse struct {
+	*Response
+}
+
+// GetInfo calls the flickr.places.getInfo method. Get information about a place.
+func (PlacesNamespace) GetInfo(ctx context.Context, cl client.Client, args PlacesGetInfoArgs) (*PlacesGetInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.getInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesGetInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesGetInfoByUrlArgs is a struct containing the arguments for the flickr.places.getInfoByUrl method.
+type PlacesGetInfoByUrlArgs struct {
+	// The url to look up. Required.
+	Url string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesGetInfoByUrlArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Url == "" {
+		return nil, fmt.Errorf("Missing required url argument")
+	}
+
+	args.Set("url", a.Url)
+
+	return args, nil
+}
+
+// PlacesGetInfoByUrlResponse is a struct containing the response for the flickr.places.getInfoByUrl method.
+type PlacesGetInfoByUrlResponse struct {
+	*Response
+}
+
+// GetInfoByUrl calls the flickr.places.getInfoByUrl method. Lookup information about a place, by its flickr.com/places URL.
+func (PlacesNamespace) GetInfoByUrl(ctx context.Context, cl client.Client, args PlacesGetInfoByUrlArgs) (*PlacesGetInfoByUrlResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.getInfoByUrl", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesGetInfoByUrlResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesGetPlaceTypesArgs is a struct containing the arguments for the flickr.places.getPlaceTypes method.
+type PlacesGetPlaceTypesArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesGetPlaceTypesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PlacesGetPlaceTypesResponse is a struct containing the response for the flickr.places.getPlaceTypes method.
+type PlacesGetPlaceTypesResponse struct {
+	*Response
+}
+
+// GetPlaceTypes calls the flickr.places.getPlaceTypes method. Fetches a list of available place types for Flickr.
+func (PlacesNamespace) GetPlaceTypes(ctx context.Context, cl client.Client, args PlacesGetPlaceTypesArgs) (*PlacesGetPlaceTypesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.getPlaceTypes", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesGetPlaceTypesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesGetShapeHistoryArgs is a struct containing the arguments for the flickr.places.getShapeHistory method.
+type PlacesGetShapeHistoryArgs struct {
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesGetShapeHistoryArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	return args, nil
+}
+
+// PlacesGetShapeHistoryResponse is a struct containing the response for the flickr.places.getShapeHistory method.
+type PlacesGetShapeHistoryResponse struct {
+	*Response
+}
+
+// GetShapeHistory calls the flickr.places.getShapeHistory method. Return an historical list of all the shape data generated for a Places or Where on Earth (WOE) ID.
+func (PlacesNamespace) GetShapeHistory(ctx context.Context, cl client.Client, args PlacesGetShapeHistoryArgs) (*PlacesGetShapeHistoryResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.getShapeHistory", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesGetShapeHistoryResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesGetTopPlacesListArgs is a struct containing the arguments for the flickr.places.getTopPlacesList method.
+type PlacesGetTopPlacesListArgs struct {
+	// The numeric ID for a specific place type. Required.
+	PlaceTypeId string
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format.
+	Date string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesGetTopPlacesListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceTypeId == "" {
+		return nil, fmt.Errorf("Missing required place_type_id argument")
+	}
+
+	args.Set("place_type_id", a.PlaceTypeId)
+
+	if a.Date != "" {
+		args.Set("date", a.Date)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	return args, nil
+}
+
+// PlacesGetTopPlacesListResponse is a struct containing the response for the flickr.places.getTopPlacesList method.
+type PlacesGetTopPlacesListResponse struct {
+	*Response
+}
+
+// GetTopPlacesList calls the flickr.places.getTopPlacesList method. Return the top 100 most geotagged places for a day.
+func (PlacesNamespace) GetTopPlacesList(ctx context.Context, cl client.Client, args PlacesGetTopPlacesListArgs) (*PlacesGetTopPlacesListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.getTopPlacesList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesGetTopPlacesListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesPlacesForBoundingBoxArgs is a struct containing the arguments for the flickr.places.placesForBoundingBox method.
+type PlacesPlacesForBoundingBoxArgs struct {
+	// A comma-delimited list of 4 values defining the Bounding Box of the area that will be searched. The 4 values represent the bottom-left corner of the box and the top-right corner, minimum_longitude, minimum_latitude, maximum_longitude, maximum_latitude. Required.
+	Bbox string
+	// The place_type argument.
+	PlaceType string
+	// The numeric ID for a specific place type.
+	PlaceTypeId string
+	// The recursive argument.
+	Recursive string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesPlacesForBoundingBoxArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Bbox == "" {
+		return nil, fmt.Errorf("Missing required bbox argument")
+	}
+
+	args.Set("bbox", a.Bbox)
+
+	if a.PlaceType != "" {
+		args.Set("place_type", a.PlaceType)
+	}
+
+	if a.PlaceTypeId != "" {
+		args.Set("place_type_id", a.PlaceTypeId)
+	}
+
+	if a.Recursive != "" {
+		args.Set("recursive", a.Recursive)
+	}
+
+	return args, nil
+}
+
+// PlacesPlacesForBoundingBoxResponse is a struct containing the response for the flickr.places.placesForBoundingBox method.
+type PlacesPlacesForBoundingBoxResponse struct {
+	*Response
+}
+
+// PlacesForBoundingBox calls the flickr.places.placesForBoundingBox method. Return all the locations of a matching place type for a bounding box.
+func (PlacesNamespace) PlacesForBoundingBox(ctx context.Context, cl client.Client, args PlacesPlacesForBoundingBoxArgs) (*PlacesPlacesForBoundingBoxResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.placesForBoundingBox", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesPlacesForBoundingBoxResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesPlacesForContactsArgs is a struct containing the arguments for the flickr.places.placesForContacts method.
+type PlacesPlacesForContactsArgs struct {
+	// The place_type argument.
+	PlaceType string
+	// The numeric ID for a specific place type.
+	PlaceTypeId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// The threshold argument.
+	Threshold string
+	// Search your contacts. Either 'all' or 'ff' for just friends and family. (Experimental)
+	Contacts string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesPlacesForContactsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceType != "" {
+		args.Set("place_type", a.PlaceType)
+	}
+
+	if a.PlaceTypeId != "" {
+		args.Set("place_type_id", a.PlaceTypeId)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.Threshold != "" {
+		args.Set("threshold", a.Threshold)
+	}
+
+	if a.Contacts != "" {
+		args.Set("contacts", a.Contacts)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	return args, nil
+}
+
+// PlacesPlacesForContactsResponse is a struct containing the response for the flickr.places.placesForContacts method.
+type PlacesPlacesForContactsResponse struct {
+	*Response
+}
+
+// PlacesForContacts calls the flickr.places.placesForContacts method. Return a list of the top 100 unique places clustered by a given placetype for a user's contacts. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PlacesNamespace) PlacesForContacts(ctx context.Context, cl client.Client, args PlacesPlacesForContactsArgs) (*PlacesPlacesForContactsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.placesForContacts", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesPlacesForContactsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesPlacesForTagsArgs is a struct containing the arguments for the flickr.places.placesForTags method.
+type PlacesPlacesForTagsArgs struct {
+	// The numeric ID for a specific place type. Required.
+	PlaceTypeId string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// The threshold argument.
+	Threshold string
+	// A tag to filter the pool with. At the moment only one tag at a time is supported.
+	Tags string
+	// Either 'any' for an OR combination of tags, or 'all' for an AND combination. Defaults to 'any' if not specified.
+	TagMode string
+	// Aside from passing in a fully formed machine tag, there is a special syntax for searching specific properties: find photos using the 'dc' namespace: "machine_tags" => "dc:"; find photos with a title in the 'dc' namespace: "machine_tags" => "dc:title=".
+	MachineTags string
+	// Either 'any' for an OR combination of tags, or 'all' for an AND combination. Defaults to 'any' if not specified.
+	MachineTagMode string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesPlacesForTagsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceTypeId == "" {
+		return nil, fmt.Errorf("Missing required place_type_id argument")
+	}
+
+	args.Set("place_type_id", a.PlaceTypeId)
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.Threshold != "" {
+		args.Set("threshold", a.Threshold)
+	}
+
+	if a.Tags != "" {
+		args.Set("tags", a.Tags)
+	}
+
+	if a.TagMode != "" {
+		args.Set("tag_mode", a.TagMode)
+	}
+
+	if a.MachineTags != "" {
+		args.Set("machine_tags", a.MachineTags)
+	}
+
+	if a.MachineTagMode != "" {
+		args.Set("machine_tag_mode", a.MachineTagMode)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	return args, nil
+}
+
+// PlacesPlacesForTagsResponse is a struct containing the response for the flickr.places.placesForTags method.
+type PlacesPlacesForTagsResponse struct {
+	*Response
+}
+
+// PlacesForTags calls the flickr.places.placesForTags method. Return a list of the top 100 unique places clustered by a given placetype for set of tags or machine tags.
+func (PlacesNamespace) PlacesForTags(ctx context.Context, cl client.Client, args PlacesPlacesForTagsArgs) (*PlacesPlacesForTagsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.placesForTags", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesPlacesForTagsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesPlacesForUserArgs is a struct containing the arguments for the flickr.places.placesForUser method.
+type PlacesPlacesForUserArgs struct {
+	// The numeric ID for a specific place type.
+	PlaceTypeId string
+	// The place_type argument.
+	PlaceType string
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// The threshold argument.
+	Threshold string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesPlacesForUserArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceTypeId != "" {
+		args.Set("place_type_id", a.PlaceTypeId)
+	}
+
+	if a.PlaceType != "" {
+		args.Set("place_type", a.PlaceType)
+	}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.Threshold != "" {
+		args.Set("threshold", a.Threshold)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	return args, nil
+}
+
+// PlacesPlacesForUserResponse is a struct containing the response for the flickr.places.placesForUser method.
+type PlacesPlacesForUserResponse struct {
+	*Response
+}
+
+// PlacesForUser calls the flickr.places.placesForUser method. Return a list of the top 100 unique places clustered by a given placetype for a user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PlacesNamespace) PlacesForUser(ctx context.Context, cl client.Client, args PlacesPlacesForUserArgs) (*PlacesPlacesForUserResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.placesForUser", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesPlacesForUserResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesResolvePlaceIdArgs is a struct containing the arguments for the flickr.places.resolvePlaceId method.
+type PlacesResolvePlaceIdArgs struct {
+	// A Flickr place id. (not used if bbox argument is present). Required.
+	PlaceId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesResolvePlaceIdArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PlaceId == "" {
+		return nil, fmt.Errorf("Missing required place_id argument")
+	}
+
+	args.Set("place_id", a.PlaceId)
+
+	return args, nil
+}
+
+// PlacesResolvePlaceIdResponse is a struct containing the response for the flickr.places.resolvePlaceId method.
+type PlacesResolvePlaceIdResponse struct {
+	*Response
+}
+
+// ResolvePlaceId calls the flickr.places.resolvePlaceId method. Find Flickr Places information by Place ID.
+func (PlacesNamespace) ResolvePlaceId(ctx context.Context, cl client.Client, args PlacesResolvePlaceIdArgs) (*PlacesResolvePlaceIdResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.resolvePlaceId", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesResolvePlaceIdResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesResolvePlaceURLArgs is a struct containing the arguments for the flickr.places.resolvePlaceURL method.
+type PlacesResolvePlaceURLArgs struct {
+	// The url to look up. Required.
+	Url string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesResolvePlaceURLArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Url == "" {
+		return nil, fmt.Errorf("Missing required url argument")
+	}
+
+	args.Set("url", a.Url)
+
+	return args, nil
+}
+
+// PlacesResolvePlaceURLResponse is a struct containing the response for the flickr.places.resolvePlaceURL method.
+type PlacesResolvePlaceURLResponse struct {
+	*Response
+}
+
+// ResolvePlaceURL calls the flickr.places.resolvePlaceURL method. Find Flickr Places information by Place URL.
+func (PlacesNamespace) ResolvePlaceURL(ctx context.Context, cl client.Client, args PlacesResolvePlaceURLArgs) (*PlacesResolvePlaceURLResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.resolvePlaceURL", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesResolvePlaceURLResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PlacesTagsForPlaceArgs is a struct containing the arguments for the flickr.places.tagsForPlace method.
+type PlacesTagsForPlaceArgs struct {
+	// A 32-bit identifier that uniquely represents spatial entities. (not used if bbox argument is present).
+	WoeId string
+	// A Flickr place id. (not used if bbox argument is present).
+	PlaceId string
+	// Minimum upload date. Photos with an upload date greater than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MinUploadDate string
+	// Maximum upload date. Photos with an upload date less than or equal to this value will be returned. The date should be in the form of a unix timestamp.
+	MaxUploadDate string
+	// Minimum taken date. Photos with an taken date greater than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MinTakenDate string
+	// Maximum taken date. Photos with an taken date less than or equal to this value will be returned. The date can be in the form of a mysql datetime or unix timestamp.
+	MaxTakenDate string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PlacesTagsForPlaceArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.WoeId != "" {
+		args.Set("woe_id", a.WoeId)
+	}
+
+	if a.PlaceId != "" {
+		args.Set("place_id", a.PlaceId)
+	}
+
+	if a.MinUploadDate != "" {
+		args.Set("min_upload_date", a.MinUploadDate)
+	}
+
+	if a.MaxUploadDate != "" {
+		args.Set("max_upload_date", a.MaxUploadDate)
+	}
+
+	if a.MinTakenDate != "" {
+		args.Set("min_taken_date", a.MinTakenDate)
+	}
+
+	if a.MaxTakenDate != "" {
+		args.Set("max_taken_date", a.MaxTakenDate)
+	}
+
+	return args, nil
+}
+
+// PlacesTagsForPlaceResponse is a struct containing the response for the flickr.places.tagsForPlace method.
+type PlacesTagsForPlaceResponse struct {
+	*Response
+}
+
+// TagsForPlace calls the flickr.places.tagsForPlace method. Return a list of the top 100 unique tags for a Flickr Places or Where on Earth (WOE) ID.
+func (PlacesNamespace) TagsForPlace(ctx context.Context, cl client.Client, args PlacesTagsForPlaceArgs) (*PlacesTagsForPlaceResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.places.tagsForPlace", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PlacesTagsForPlaceResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PrefsGetContentTypeArgs is a struct containing the arguments for the flickr.prefs.getContentType method.
+type PrefsGetContentTypeArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PrefsGetContentTypeArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PrefsGetContentTypeResponse is a struct containing the response for the flickr.prefs.getContentType method.
+type PrefsGetContentTypeResponse struct {
+	*Response
+}
+
+// GetContentType calls the flickr.prefs.getContentType method. Returns the default content type preference for the user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PrefsNamespace) GetContentType(ctx context.Context, cl client.Client, args PrefsGetContentTypeArgs) (*PrefsGetContentTypeResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.prefs.getContentType", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrefsGetContentTypeResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PrefsGetGeoPermsArgs is a struct containing the arguments for the flickr.prefs.getGeoPerms method.
+type PrefsGetGeoPermsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PrefsGetGeoPermsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PrefsGetGeoPermsResponse is a struct containing the response for the flickr.prefs.getGeoPerms method.
+type PrefsGetGeoPermsResponse struct {
+	*Response
+}
+
+// GetGeoPerms calls the flickr.prefs.getGeoPerms method. Returns the default privacy level for geographic information attached to the user's photos and whether or not the user has chosen to use geo-related EXIF information to automatically geotag their photos. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PrefsNamespace) GetGeoPerms(ctx context.Context, cl client.Client, args PrefsGetGeoPermsArgs) (*PrefsGetGeoPermsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.prefs.getGeoPerms", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrefsGetGeoPermsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PrefsGetHiddenArgs is a struct containing the arguments for the flickr.prefs.getHidden method.
+type PrefsGetHiddenArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PrefsGetHiddenArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PrefsGetHiddenResponse is a struct containing the response for the flickr.prefs.getHidden method.
+type PrefsGetHiddenResponse struct {
+	*Response
+}
+
+// GetHidden calls the flickr.prefs.getHidden method. Returns the default hidden preference for the user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PrefsNamespace) GetHidden(ctx context.Context, cl client.Client, args PrefsGetHiddenArgs) (*PrefsGetHiddenResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.prefs.getHidden", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrefsGetHiddenResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PrefsGetPrivacyArgs is a struct containing the arguments for the flickr.prefs.getPrivacy method.
+type PrefsGetPrivacyArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PrefsGetPrivacyArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PrefsGetPrivacyResponse is a struct containing the response for the flickr.prefs.getPrivacy method.
+type PrefsGetPrivacyResponse struct {
+	*Response
+}
+
+// GetPrivacy calls the flickr.prefs.getPrivacy method. Returns the default privacy level preference for the user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PrefsNamespace) GetPrivacy(ctx context.Context, cl client.Client, args PrefsGetPrivacyArgs) (*PrefsGetPrivacyResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.prefs.getPrivacy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrefsGetPrivacyResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PrefsGetSafetyLevelArgs is a struct containing the arguments for the flickr.prefs.getSafetyLevel method.
+type PrefsGetSafetyLevelArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PrefsGetSafetyLevelArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PrefsGetSafetyLevelResponse is a struct containing the response for the flickr.prefs.getSafetyLevel method.
+type PrefsGetSafetyLevelResponse struct {
+	*Response
+}
+
+// GetSafetyLevel calls the flickr.prefs.getSafetyLevel method. Returns the default safety level preference for the user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PrefsNamespace) GetSafetyLevel(ctx context.Context, cl client.Client, args PrefsGetSafetyLevelArgs) (*PrefsGetSafetyLevelResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.prefs.getSafetyLevel", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrefsGetSafetyLevelResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ProfileGetProfileArgs is a struct containing the arguments for the flickr.profile.getProfile method.
+type ProfileGetProfileArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ProfileGetProfileArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// ProfileGetProfileResponse is a struct containing the response for the flickr.profile.getProfile method.
+type ProfileGetProfileResponse struct {
+	*Response
+}
+
+// GetProfile calls the flickr.profile.getProfile method. Returns specified user's profile info, respecting profile privacy settings.
+func (ProfileNamespace) GetProfile(ctx context.Context, cl client.Client, args ProfileGetProfileArgs) (*ProfileGetProfileResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.profile.getProfile", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ProfileGetProfileResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PushGetSubscriptionsArgs is a struct containing the arguments for the flickr.push.getSubscriptions method.
+type PushGetSubscriptionsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PushGetSubscriptionsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PushGetSubscriptionsResponse is a struct containing the response for the flickr.push.getSubscriptions method.
+type PushGetSubscriptionsResponse struct {
+	*Response
+}
+
+// GetSubscriptions calls the flickr.push.getSubscriptions method. Returns a list of the subscriptions for the logged-in user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PushNamespace) GetSubscriptions(ctx context.Context, cl client.Client, args PushGetSubscriptionsArgs) (*PushGetSubscriptionsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.push.getSubscriptions", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PushGetSubscriptionsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PushGetTopicsArgs is a struct containing the arguments for the flickr.push.getTopics method.
+type PushGetTopicsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PushGetTopicsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// PushGetTopicsResponse is a struct containing the response for the flickr.push.getTopics method.
+type PushGetTopicsResponse struct {
+	*Response
+}
+
+// GetTopics calls the flickr.push.getTopics method. All the different flavours of anteater.
+func (PushNamespace) GetTopics(ctx context.Context, cl client.Client, args PushGetTopicsArgs) (*PushGetTopicsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.push.getTopics", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PushGetTopicsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PushSubscribeArgs is a struct containing the arguments for the flickr.push.subscribe method.
+type PushSubscribeArgs struct {
+	// The topic argument. Required.
+	Topic string
+	// The callback argument. Required.
+	Callback string
+	// The verify argument. Required.
+	Verify string
+	// The verify_token argument.
+	VerifyToken string
+	// The lease_seconds argument.
+	LeaseSeconds string
+	// The woe_ids argument.
+	WoeIds string
+	// The place_ids argument.
+	PlaceIds string
+	// The latitude whose valid range is -90 to 90. Anything more than 6 decimal places will be truncated.
+	Lat string
+	// The longitude whose valid range is -180 to 180. Anything more than 6 decimal places will be truncated.
+	Lon string
+	// A valid radius used for geo queries, greater than zero and less than 20 miles (or 32 kilometers), for use with point-based geo queries. The default value is 5 (km).
+	Radius string
+	// The unit of measure when doing radial geo queries. Valid options are "mi" (miles) and "km" (kilometers). The default is "km".
+	RadiusUnits string
+	// Recorded accuracy level of the location information. World level is 1, Country is ~3, Region ~6, City ~11, Street ~16. Current range is 1-16. Defaults to 16 if not specified.
+	Accuracy string
+	// The nsids argument.
+	Nsids string
+	// A tag to filter the pool with. At the moment only one tag at a time is supported.
+	Tags string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PushSubscribeArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Topic == "" {
+		return nil, fmt.Errorf("Missing required topic argument")
+	}
+
+	args.Set("topic", a.Topic)
+
+	if a.Callback == "" {
+		return nil, fmt.Errorf("Missing required callback argument")
+	}
+
+	args.Set("callback", a.Callback)
+
+	if a.Verify == "" {
+		return nil, fmt.Errorf("Missing required verify argument")
+	}
+
+	args.Set("verify", a.Verify)
+
+	if a.VerifyToken != "" {
+		args.Set("verify_token", a.VerifyToken)
+	}
+
+	if a.LeaseSeconds != "" {
+		args.Set("lease_seconds", a.LeaseSeconds)
+	}
+
+	if a.WoeIds != "" {
+		args.Set("woe_ids", a.WoeIds)
+	}
+
+	if a.PlaceIds != "" {
+		args.Set("place_ids", a.PlaceIds)
+	}
+
+	if a.Lat != "" {
+		args.Set("lat", a.Lat)
+	}
+
+	if a.Lon != "" {
+		args.Set("lon", a.Lon)
+	}
+
+	if a.Radius != "" {
+		args.Set("radius", a.Radius)
+	}
+
+	if a.RadiusUnits != "" {
+		args.Set("radius_units", a.RadiusUnits)
+	}
+
+	if a.Accuracy != "" {
+		args.Set("accuracy", a.Accuracy)
+	}
+
+	if a.Nsids != "" {
+		args.Set("nsids", a.Nsids)
+	}
+
+	if a.Tags != "" {
+		args.Set("tags", a.Tags)
+	}
+
+	return args, nil
+}
+
+// PushSubscribeResponse is a struct containing the response for the flickr.push.subscribe method.
+type PushSubscribeResponse struct {
+	*Response
+}
+
+// Subscribe calls the flickr.push.subscribe method. In ur pandas, tickling ur unicorn. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PushNamespace) Subscribe(ctx context.Context, cl client.Client, args PushSubscribeArgs) (*PushSubscribeResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.push.subscribe", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PushSubscribeResponse{Response: rsp}
+
+	return r, nil
+}
+
+// PushUnsubscribeArgs is a struct containing the arguments for the flickr.push.unsubscribe method.
+type PushUnsubscribeArgs struct {
+	// The topic argument. Required.
+	Topic string
+	// The callback argument. Required.
+	Callback string
+	// The verify argument. Required.
+	Verify string
+	// The verify_token argument.
+	VerifyToken string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a PushUnsubscribeArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Topic == "" {
+		return nil, fmt.Errorf("Missing required topic argument")
+	}
+
+	args.Set("topic", a.Topic)
+
+	if a.Callback == "" {
+		return nil, fmt.Errorf("Missing required callback argument")
+	}
+
+	args.Set("callback", a.Callback)
+
+	if a.Verify == "" {
+		return nil, fmt.Errorf("Missing required verify argument")
+	}
+
+	args.Set("verify", a.Verify)
+
+	if a.VerifyToken != "" {
+		args.Set("verify_token", a.VerifyToken)
+	}
+
+	return args, nil
+}
+
+// PushUnsubscribeResponse is a struct containing the response for the flickr.push.unsubscribe method.
+type PushUnsubscribeResponse struct {
+	*Response
+}
+
+// Unsubscribe calls the flickr.push.unsubscribe method. Why would you want to do this? Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (PushNamespace) Unsubscribe(ctx context.Context, cl client.Client, args PushUnsubscribeArgs) (*PushUnsubscribeResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.push.unsubscribe", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PushUnsubscribeResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ReflectionGetMethodInfoArgs is a struct containing the arguments for the flickr.reflection.getMethodInfo method.
+type ReflectionGetMethodInfoArgs struct {
+	// The name of the method to fetch information for. Required.
+	MethodName string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ReflectionGetMethodInfoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.MethodName == "" {
+		return nil, fmt.Errorf("Missing required method_name argument")
+	}
+
+	args.Set("method_name", a.MethodName)
+
+	return args, nil
+}
+
+// ReflectionGetMethodInfoResponse is a struct containing the response for the flickr.reflection.getMethodInfo method.
+type ReflectionGetMethodInfoResponse struct {
+	*Response
+}
+
+// GetMethodInfo calls the flickr.reflection.getMethodInfo method. Returns information for a given Flickr API method.
+func (ReflectionNamespace) GetMethodInfo(ctx context.Context, cl client.Client, args ReflectionGetMethodInfoArgs) (*ReflectionGetMethodInfoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.reflection.getMethodInfo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ReflectionGetMethodInfoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// ReflectionGetMethodsArgs is a struct containing the arguments for the flickr.reflection.getMethods method.
+type ReflectionGetMethodsArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a ReflectionGetMethodsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// ReflectionGetMethodsResponse is a struct containing the response for the flickr.reflection.getMethods method.
+type ReflectionGetMethodsResponse struct {
+	*Response
+}
+
+// GetMethods calls the flickr.reflection.getMethods method. Returns a list of available Flickr API methods.
+func (ReflectionNamespace) GetMethods(ctx context.Context, cl client.Client, args ReflectionGetMethodsArgs) (*ReflectionGetMethodsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.reflection.getMethods", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ReflectionGetMethodsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetCSVFilesArgs is a struct containing the arguments for the flickr.stats.getCSVFiles method.
+type StatsGetCSVFilesArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetCSVFilesArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// StatsGetCSVFilesResponse is a struct containing the response for the flickr.stats.getCSVFiles method.
+type StatsGetCSVFilesResponse struct {
+	*Response
+}
+
+// GetCSVFiles calls the flickr.stats.getCSVFiles method. Returns a list of URLs for text files containing all your stats data (from November 26th 2007 onwards) for the currently auth'd user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetCSVFiles(ctx context.Context, cl client.Client, args StatsGetCSVFilesArgs) (*StatsGetCSVFilesResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getCSVFiles", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetCSVFilesResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetCollectionDomainsArgs is a struct containing the arguments for the flickr.stats.getCollectionDomains method.
+type StatsGetCollectionDomainsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The ID of the collection.
+	CollectionId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetCollectionDomainsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.CollectionId != "" {
+		args.Set("collection_id", a.CollectionId)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetCollectionDomainsResponse is a struct containing the response for the flickr.stats.getCollectionDomains method.
+type StatsGetCollectionDomainsResponse struct {
+	*Response
+}
+
+// GetCollectionDomains calls the flickr.stats.getCollectionDomains method. Get a list of referring domains for a collection. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetCollectionDomains(ctx context.Context, cl client.Client, args StatsGetCollectionDomainsArgs) (*StatsGetCollectionDomainsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getCollectionDomains", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetCollectionDomainsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetCollectionReferrersArgs is a struct containing the arguments for the flickr.stats.getCollectionReferrers method.
+type StatsGetCollectionReferrersArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The domain to return referrers for. Required.
+	Domain string
+	// The ID of the collection.
+	CollectionId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetCollectionReferrersArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.Domain == "" {
+		return nil, fmt.Errorf("Missing required domain argument")
+	}
+
+	args.Set("domain", a.Domain)
+
+	if a.CollectionId != "" {
+		args.Set("collection_id", a.CollectionId)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetCollectionReferrersResponse is a struct containing the response for the flickr.stats.getCollectionReferrers method.
+type StatsGetCollectionReferrersResponse struct {
+	*Response
+}
+
+// GetCollectionReferrers calls the flickr.stats.getCollectionReferrers method. Get a list of referrers from a given domain to a collection. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetCollectionReferrers(ctx context.Context, cl client.Client, args StatsGetCollectionReferrersArgs) (*StatsGetCollectionReferrersResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getCollectionReferrers", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetCollectionReferrersResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetCollectionStatsArgs is a struct containing the arguments for the flickr.stats.getCollectionStats method.
+type StatsGetCollectionStatsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The ID of the collection. Required.
+	CollectionId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetCollectionStatsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.CollectionId == "" {
+		return nil, fmt.Errorf("Missing required collection_id argument")
+	}
+
+	args.Set("collection_id", a.CollectionId)
+
+	return args, nil
+}
+
+// StatsGetCollectionStatsResponse is a struct containing the response for the flickr.stats.getCollectionStats method.
+type StatsGetCollectionStatsResponse struct {
+	*Response
+}
+
+// GetCollectionStats calls the flickr.stats.getCollectionStats method. Get the number of views on a collection for a given date. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetCollectionStats(ctx context.Context, cl client.Client, args StatsGetCollectionStatsArgs) (*StatsGetCollectionStatsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getCollectionStats", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetCollectionStatsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotoDomainsArgs is a struct containing the arguments for the flickr.stats.getPhotoDomains method.
+type StatsGetPhotoDomainsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The photo ID to add to the gallery
+	PhotoId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotoDomainsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.PhotoId != "" {
+		args.Set("photo_id", a.PhotoId)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPhotoDomainsResponse is a struct containing the response for the flickr.stats.getPhotoDomains method.
+type StatsGetPhotoDomainsResponse struct {
+	*Response
+}
+
+// GetPhotoDomains calls the flickr.stats.getPhotoDomains method. Get a list of referring domains for a photo. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotoDomains(ctx context.Context, cl client.Client, args StatsGetPhotoDomainsArgs) (*StatsGetPhotoDomainsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotoDomains", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotoDomainsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotoReferrersArgs is a struct containing the arguments for the flickr.stats.getPhotoReferrers method.
+type StatsGetPhotoReferrersArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The domain to return referrers for. Required.
+	Domain string
+	// The photo ID to add to the gallery
+	PhotoId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotoReferrersArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.Domain == "" {
+		return nil, fmt.Errorf("Missing required domain argument")
+	}
+
+	args.Set("domain", a.Domain)
+
+	if a.PhotoId != "" {
+		args.Set("photo_id", a.PhotoId)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPhotoReferrersResponse is a struct containing the response for the flickr.stats.getPhotoReferrers method.
+type StatsGetPhotoReferrersResponse struct {
+	*Response
+}
+
+// GetPhotoReferrers calls the flickr.stats.getPhotoReferrers method. Get a list of referrers from a given domain to a photo. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotoReferrers(ctx context.Context, cl client.Client, args StatsGetPhotoReferrersArgs) (*StatsGetPhotoReferrersResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotoReferrers", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotoReferrersResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotoStatsArgs is a struct containing the arguments for the flickr.stats.getPhotoStats method.
+type StatsGetPhotoStatsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotoStatsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// StatsGetPhotoStatsResponse is a struct containing the response for the flickr.stats.getPhotoStats method.
+type StatsGetPhotoStatsResponse struct {
+	*Response
+}
+
+// GetPhotoStats calls the flickr.stats.getPhotoStats method. Get the number of views, comments and favorites on a photo for a given date. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotoStats(ctx context.Context, cl client.Client, args StatsGetPhotoStatsArgs) (*StatsGetPhotoStatsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotoStats", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotoStatsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotosetDomainsArgs is a struct containing the arguments for the flickr.stats.getPhotosetDomains method.
+type StatsGetPhotosetDomainsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The id of the photoset to add a photo to.
+	PhotosetId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotosetDomainsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.PhotosetId != "" {
+		args.Set("photoset_id", a.PhotosetId)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPhotosetDomainsResponse is a struct containing the response for the flickr.stats.getPhotosetDomains method.
+type StatsGetPhotosetDomainsResponse struct {
+	*Response
+}
+
+// GetPhotosetDomains calls the flickr.stats.getPhotosetDomains method. Get a list of referring domains for a photoset. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotosetDomains(ctx context.Context, cl client.Client, args StatsGetPhotosetDomainsArgs) (*StatsGetPhotosetDomainsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotosetDomains", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotosetDomainsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotosetReferrersArgs is a struct containing the arguments for the flickr.stats.getPhotosetReferrers method.
+type StatsGetPhotosetReferrersArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The domain to return referrers for. Required.
+	Domain string
+	// The id of the photoset to add a photo to.
+	PhotosetId string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotosetReferrersArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.Domain == "" {
+		return nil, fmt.Errorf("Missing required domain argument")
+	}
+
+	args.Set("domain", a.Domain)
+
+	if a.PhotosetId != "" {
+		args.Set("photoset_id", a.PhotosetId)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPhotosetReferrersResponse is a struct containing the response for the flickr.stats.getPhotosetReferrers method.
+type StatsGetPhotosetReferrersResponse struct {
+	*Response
+}
+
+// GetPhotosetReferrers calls the flickr.stats.getPhotosetReferrers method. Get a list of referrers from a given domain to a photoset. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotosetReferrers(ctx context.Context, cl client.Client, args StatsGetPhotosetReferrersArgs) (*StatsGetPhotosetReferrersResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotosetReferrers", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotosetReferrersResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotosetStatsArgs is a struct containing the arguments for the flickr.stats.getPhotosetStats method.
+type StatsGetPhotosetStatsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The id of the photoset to add a photo to. Required.
+	PhotosetId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotosetStatsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.PhotosetId == "" {
+		return nil, fmt.Errorf("Missing required photoset_id argument")
+	}
+
+	args.Set("photoset_id", a.PhotosetId)
+
+	return args, nil
+}
+
+// StatsGetPhotosetStatsResponse is a struct containing the response for the flickr.stats.getPhotosetStats method.
+type StatsGetPhotosetStatsResponse struct {
+	*Response
+}
+
+// GetPhotosetStats calls the flickr.stats.getPhotosetStats method. Get the number of views on a photoset for a given date. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotosetStats(ctx context.Context, cl client.Client, args StatsGetPhotosetStatsArgs) (*StatsGetPhotosetStatsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotosetStats", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotosetStatsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotostreamDomainsArgs is a struct containing the arguments for the flickr.stats.getPhotostreamDomains method.
+type StatsGetPhotostreamDomainsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotostreamDomainsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPhotostreamDomainsResponse is a struct containing the response for the flickr.stats.getPhotostreamDomains method.
+type StatsGetPhotostreamDomainsResponse struct {
+	*Response
+}
+
+// GetPhotostreamDomains calls the flickr.stats.getPhotostreamDomains method. Get a list of referring domains for a photostream. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotostreamDomains(ctx context.Context, cl client.Client, args StatsGetPhotostreamDomainsArgs) (*StatsGetPhotostreamDomainsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotostreamDomains", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotostreamDomainsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotostreamReferrersArgs is a struct containing the arguments for the flickr.stats.getPhotostreamReferrers method.
+type StatsGetPhotostreamReferrersArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+	// The domain to return referrers for. Required.
+	Domain string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotostreamReferrersArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	if a.Domain == "" {
+		return nil, fmt.Errorf("Missing required domain argument")
+	}
+
+	args.Set("domain", a.Domain)
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPhotostreamReferrersResponse is a struct containing the response for the flickr.stats.getPhotostreamReferrers method.
+type StatsGetPhotostreamReferrersResponse struct {
+	*Response
+}
+
+// GetPhotostreamReferrers calls the flickr.stats.getPhotostreamReferrers method. Get a list of referrers from a given domain to a user's photostream. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotostreamReferrers(ctx context.Context, cl client.Client, args StatsGetPhotostreamReferrersArgs) (*StatsGetPhotostreamReferrersResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotostreamReferrers", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotostreamReferrersResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPhotostreamStatsArgs is a struct containing the arguments for the flickr.stats.getPhotostreamStats method.
+type StatsGetPhotostreamStatsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format. Required.
+	Date string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPhotostreamStatsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date == "" {
+		return nil, fmt.Errorf("Missing required date argument")
+	}
+
+	args.Set("date", a.Date)
+
+	return args, nil
+}
+
+// StatsGetPhotostreamStatsResponse is a struct containing the response for the flickr.stats.getPhotostreamStats method.
+type StatsGetPhotostreamStatsResponse struct {
+	*Response
+}
+
+// GetPhotostreamStats calls the flickr.stats.getPhotostreamStats method. Get the number of views on a user's photostream for a given date. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPhotostreamStats(ctx context.Context, cl client.Client, args StatsGetPhotostreamStatsArgs) (*StatsGetPhotostreamStatsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPhotostreamStats", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPhotostreamStatsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetPopularPhotosArgs is a struct containing the arguments for the flickr.stats.getPopularPhotos method.
+type StatsGetPopularPhotosArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format.
+	Date string
+	// The order in which to sort returned photos. Defaults to date-posted-desc (unless you are doing a radial geo query, in which case the default sorting is by ascending distance from the point specified). The possible values are: date-posted-asc, date-posted-desc, date-taken-asc, date-taken-desc, interestingness-desc, interestingness-asc, and relevance.
+	Sort string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetPopularPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date != "" {
+		args.Set("date", a.Date)
+	}
+
+	if a.Sort != "" {
+		args.Set("sort", a.Sort)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	return args, nil
+}
+
+// StatsGetPopularPhotosResponse is a struct containing the response for the flickr.stats.getPopularPhotos method.
+type StatsGetPopularPhotosResponse struct {
+	*Response
+}
+
+// GetPopularPhotos calls the flickr.stats.getPopularPhotos method. List the photos with the most views, comments or favorites. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetPopularPhotos(ctx context.Context, cl client.Client, args StatsGetPopularPhotosArgs) (*StatsGetPopularPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getPopularPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetPopularPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// StatsGetTotalViewsArgs is a struct containing the arguments for the flickr.stats.getTotalViews method.
+type StatsGetTotalViewsArgs struct {
+	// Stats will be returned for this date. This should be in either be in YYYY-MM-DD or unix timestamp format.
+	Date string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a StatsGetTotalViewsArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Date != "" {
+		args.Set("date", a.Date)
+	}
+
+	return args, nil
+}
+
+// StatsGetTotalViewsResponse is a struct containing the response for the flickr.stats.getTotalViews method.
+type StatsGetTotalViewsResponse struct {
+	*Response
+}
+
+// GetTotalViews calls the flickr.stats.getTotalViews method. Get the overall view counts for an account. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (StatsNamespace) GetTotalViews(ctx context.Context, cl client.Client, args StatsGetTotalViewsArgs) (*StatsGetTotalViewsResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.stats.getTotalViews", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &StatsGetTotalViewsResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetClusterPhotosArgs is a struct containing the arguments for the flickr.tags.getClusterPhotos method.
+type TagsGetClusterPhotosArgs struct {
+	// The tag to fetch. Required.
+	Tag string
+	// The cluster_id argument. Required.
+	ClusterId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetClusterPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Tag == "" {
+		return nil, fmt.Errorf("Missing required tag argument")
+	}
+
+	args.Set("tag", a.Tag)
+
+	if a.ClusterId == "" {
+		return nil, fmt.Errorf("Missing required cluster_id argument")
+	}
+
+	args.Set("cluster_id", a.ClusterId)
+
+	return args, nil
+}
+
+// TagsGetClusterPhotosResponse is a struct containing the response for the flickr.tags.getClusterPhotos method.
+type TagsGetClusterPhotosResponse struct {
+	*Response
+}
+
+// GetClusterPhotos calls the flickr.tags.getClusterPhotos method. Returns the first 24 photos for a given tag cluster.
+func (TagsNamespace) GetClusterPhotos(ctx context.Context, cl client.Client, args TagsGetClusterPhotosArgs) (*TagsGetClusterPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getClusterPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetClusterPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetClustersArgs is a struct containing the arguments for the flickr.tags.getClusters method.
+type TagsGetClustersArgs struct {
+	// The tag to fetch. Required.
+	Tag string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetClustersArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Tag == "" {
+		return nil, fmt.Errorf("Missing required tag argument")
+	}
+
+	args.Set("tag", a.Tag)
+
+	return args, nil
+}
+
+// TagsGetClustersResponse is a struct containing the response for the flickr.tags.getClusters method.
+type TagsGetClustersResponse struct {
+	*Response
+}
+
+// GetClusters calls the flickr.tags.getClusters method. Gives you a list of tag clusters for the given tag.
+func (TagsNamespace) GetClusters(ctx context.Context, cl client.Client, args TagsGetClustersArgs) (*TagsGetClustersResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getClusters", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetClustersResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetHotListArgs is a struct containing the arguments for the flickr.tags.getHotList method.
+type TagsGetHotListArgs struct {
+	// The period argument.
+	Period string
+	// Number of results to return.
+	Count string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetHotListArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Period != "" {
+		args.Set("period", a.Period)
+	}
+
+	if a.Count != "" {
+		args.Set("count", a.Count)
+	}
+
+	return args, nil
+}
+
+// TagsGetHotListResponse is a struct containing the response for the flickr.tags.getHotList method.
+type TagsGetHotListResponse struct {
+	*Response
+}
+
+// GetHotList calls the flickr.tags.getHotList method. Returns a list of hot tags for the given period.
+func (TagsNamespace) GetHotList(ctx context.Context, cl client.Client, args TagsGetHotListArgs) (*TagsGetHotListResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getHotList", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetHotListResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetListPhotoArgs is a struct containing the arguments for the flickr.tags.getListPhoto method.
+type TagsGetListPhotoArgs struct {
+	// The photo ID to add to the gallery Required.
+	PhotoId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetListPhotoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.PhotoId == "" {
+		return nil, fmt.Errorf("Missing required photo_id argument")
+	}
+
+	args.Set("photo_id", a.PhotoId)
+
+	return args, nil
+}
+
+// TagsGetListPhotoResponse is a struct containing the response for the flickr.tags.getListPhoto method.
+type TagsGetListPhotoResponse struct {
+	*Response
+}
+
+// GetListPhoto calls the flickr.tags.getListPhoto method. Get the tag list for a given photo.
+func (TagsNamespace) GetListPhoto(ctx context.Context, cl client.Client, args TagsGetListPhotoArgs) (*TagsGetListPhotoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getListPhoto", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetListPhotoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetListUserArgs is a struct containing the arguments for the flickr.tags.getListUser method.
+type TagsGetListUserArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetListUserArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	return args, nil
+}
+
+// TagsGetListUserResponse is a struct containing the response for the flickr.tags.getListUser method.
+type TagsGetListUserResponse struct {
+	*Response
+}
+
+// GetListUser calls the flickr.tags.getListUser method. Get the tag list for a given user (or the currently logged in user).
+func (TagsNamespace) GetListUser(ctx context.Context, cl client.Client, args TagsGetListUserArgs) (*TagsGetListUserResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getListUser", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetListUserResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetListUserPopularArgs is a struct containing the arguments for the flickr.tags.getListUserPopular method.
+type TagsGetListUserPopularArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+	// Number of results to return.
+	Count string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetListUserPopularArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	if a.Count != "" {
+		args.Set("count", a.Count)
+	}
+
+	return args, nil
+}
+
+// TagsGetListUserPopularResponse is a struct containing the response for the flickr.tags.getListUserPopular method.
+type TagsGetListUserPopularResponse struct {
+	*Response
+}
+
+// GetListUserPopular calls the flickr.tags.getListUserPopular method. Get the popular tags for a given user (or the currently logged in user).
+func (TagsNamespace) GetListUserPopular(ctx context.Context, cl client.Client, args TagsGetListUserPopularArgs) (*TagsGetListUserPopularResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getListUserPopular", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetListUserPopularResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetListUserRawArgs is a struct containing the arguments for the flickr.tags.getListUserRaw method.
+type TagsGetListUserRawArgs struct {
+	// The tag to fetch.
+	Tag string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetListUserRawArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Tag != "" {
+		args.Set("tag", a.Tag)
+	}
+
+	return args, nil
+}
+
+// TagsGetListUserRawResponse is a struct containing the response for the flickr.tags.getListUserRaw method.
+type TagsGetListUserRawResponse struct {
+	*Response
+}
+
+// GetListUserRaw calls the flickr.tags.getListUserRaw method. Get the raw versions of a given tag (or all tags) for the currently logged-in user.
+func (TagsNamespace) GetListUserRaw(ctx context.Context, cl client.Client, args TagsGetListUserRawArgs) (*TagsGetListUserRawResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getListUserRaw", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetListUserRawResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetMostFrequentlyUsedArgs is a struct containing the arguments for the flickr.tags.getMostFrequentlyUsed method.
+type TagsGetMostFrequentlyUsedArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetMostFrequentlyUsedArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// TagsGetMostFrequentlyUsedResponse is a struct containing the response for the flickr.tags.getMostFrequentlyUsed method.
+type TagsGetMostFrequentlyUsedResponse struct {
+	*Response
+}
+
+// GetMostFrequentlyUsed calls the flickr.tags.getMostFrequentlyUsed method. Returns a list of most frequently used tags for a user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TagsNamespace) GetMostFrequentlyUsed(ctx context.Context, cl client.Client, args TagsGetMostFrequentlyUsedArgs) (*TagsGetMostFrequentlyUsedResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getMostFrequentlyUsed", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetMostFrequentlyUsedResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TagsGetRelatedArgs is a struct containing the arguments for the flickr.tags.getRelated method.
+type TagsGetRelatedArgs struct {
+	// The tag to fetch. Required.
+	Tag string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TagsGetRelatedArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Tag == "" {
+		return nil, fmt.Errorf("Missing required tag argument")
+	}
+
+	args.Set("tag", a.Tag)
+
+	return args, nil
+}
+
+// TagsGetRelatedResponse is a struct containing the response for the flickr.tags.getRelated method.
+type TagsGetRelatedResponse struct {
+	*Response
+}
+
+// GetRelated calls the flickr.tags.getRelated method. Returns a list of tags 'related' to the given tag, based on clustered usage analysis.
+func (TagsNamespace) GetRelated(ctx context.Context, cl client.Client, args TagsGetRelatedArgs) (*TagsGetRelatedResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.tags.getRelated", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TagsGetRelatedResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestEchoArgs is a struct containing the arguments for the flickr.test.echo method.
+type TestEchoArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestEchoArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// TestEchoResponse is a struct containing the response for the flickr.test.echo method.
+type TestEchoResponse struct {
+	*Response
+}
+
+// Echo calls the flickr.test.echo method. A testing method which echo's all parameters back in the response.
+func (TestNamespace) Echo(ctx context.Context, cl client.Client, args TestEchoArgs) (*TestEchoResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.test.echo", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestEchoResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestLoginArgs is a struct containing the arguments for the flickr.test.login method.
+type TestLoginArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestLoginArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// TestLoginResponse is a struct containing the response for the flickr.test.login method.
+type TestLoginResponse struct {
+	*Response
+}
+
+// Login calls the flickr.test.login method. A testing method which checks if the caller is logged in then returns their username. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestNamespace) Login(ctx context.Context, cl client.Client, args TestLoginArgs) (*TestLoginResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.test.login", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestLoginResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestNullArgs is a struct containing the arguments for the flickr.test.null method.
+type TestNullArgs struct {
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestNullArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	return args, nil
+}
+
+// TestNullResponse is a struct containing the response for the flickr.test.null method.
+type TestNullResponse struct {
+	*Response
+}
+
+// Null calls the flickr.test.null method. Null test Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestNamespace) Null(ctx context.Context, cl client.Client, args TestNullArgs) (*TestNullResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.test.null", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestNullResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsAddTestimonialArgs is a struct containing the arguments for the flickr.testimonials.addTestimonial method.
+type TestimonialsAddTestimonialArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The text of the testimonial. Required.
+	TestimonialText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsAddTestimonialArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.TestimonialText == "" {
+		return nil, fmt.Errorf("Missing required testimonial_text argument")
+	}
+
+	args.Set("testimonial_text", a.TestimonialText)
+
+	return args, nil
+}
+
+// TestimonialsAddTestimonialResponse is a struct containing the response for the flickr.testimonials.addTestimonial method.
+type TestimonialsAddTestimonialResponse struct {
+	*Response
+}
+
+// AddTestimonial calls the flickr.testimonials.addTestimonial method. Write a new testimonial. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) AddTestimonial(ctx context.Context, cl client.Client, args TestimonialsAddTestimonialArgs) (*TestimonialsAddTestimonialResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.addTestimonial", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsAddTestimonialResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsApproveTestimonialArgs is a struct containing the arguments for the flickr.testimonials.approveTestimonial method.
+type TestimonialsApproveTestimonialArgs struct {
+	// The ID of the testimonial. Required.
+	TestimonialId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsApproveTestimonialArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.TestimonialId == "" {
+		return nil, fmt.Errorf("Missing required testimonial_id argument")
+	}
+
+	args.Set("testimonial_id", a.TestimonialId)
+
+	return args, nil
+}
+
+// TestimonialsApproveTestimonialResponse is a struct containing the response for the flickr.testimonials.approveTestimonial method.
+type TestimonialsApproveTestimonialResponse struct {
+	*Response
+}
+
+// ApproveTestimonial calls the flickr.testimonials.approveTestimonial method. Approve a testimonial that has been written about the currently loggedin user. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) ApproveTestimonial(ctx context.Context, cl client.Client, args TestimonialsApproveTestimonialArgs) (*TestimonialsApproveTestimonialResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.approveTestimonial", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsApproveTestimonialResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsDeleteTestimonialArgs is a struct containing the arguments for the flickr.testimonials.deleteTestimonial method.
+type TestimonialsDeleteTestimonialArgs struct {
+	// The ID of the testimonial. Required.
+	TestimonialId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsDeleteTestimonialArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.TestimonialId == "" {
+		return nil, fmt.Errorf("Missing required testimonial_id argument")
+	}
+
+	args.Set("testimonial_id", a.TestimonialId)
+
+	return args, nil
+}
+
+// TestimonialsDeleteTestimonialResponse is a struct containing the response for the flickr.testimonials.deleteTestimonial method.
+type TestimonialsDeleteTestimonialResponse struct {
+	*Response
+}
+
+// DeleteTestimonial calls the flickr.testimonials.deleteTestimonial method. Permanently delete a testimonial. The loggedin user must be either the author or recipient of the testimonial. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) DeleteTestimonial(ctx context.Context, cl client.Client, args TestimonialsDeleteTestimonialArgs) (*TestimonialsDeleteTestimonialResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.deleteTestimonial", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsDeleteTestimonialResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsEditTestimonialArgs is a struct containing the arguments for the flickr.testimonials.editTestimonial method.
+type TestimonialsEditTestimonialArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The ID of the testimonial. Required.
+	TestimonialId string
+	// The text of the testimonial. Required.
+	TestimonialText string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsEditTestimonialArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.TestimonialId == "" {
+		return nil, fmt.Errorf("Missing required testimonial_id argument")
+	}
+
+	args.Set("testimonial_id", a.TestimonialId)
+
+	if a.TestimonialText == "" {
+		return nil, fmt.Errorf("Missing required testimonial_text argument")
+	}
+
+	args.Set("testimonial_text", a.TestimonialText)
+
+	return args, nil
+}
+
+// TestimonialsEditTestimonialResponse is a struct containing the response for the flickr.testimonials.editTestimonial method.
+type TestimonialsEditTestimonialResponse struct {
+	*Response
+}
+
+// EditTestimonial calls the flickr.testimonials.editTestimonial method. Change the text of a testimonial. The loggedin user must be the author of the existing testimonial. Requires "write" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) EditTestimonial(ctx context.Context, cl client.Client, args TestimonialsEditTestimonialArgs) (*TestimonialsEditTestimonialResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.editTestimonial", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsEditTestimonialResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetAllTestimonialsAboutArgs is a struct containing the arguments for the flickr.testimonials.getAllTestimonialsAbout method.
+type TestimonialsGetAllTestimonialsAboutArgs struct {
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetAllTestimonialsAboutArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// TestimonialsGetAllTestimonialsAboutResponse is a struct containing the response for the flickr.testimonials.getAllTestimonialsAbout method.
+type TestimonialsGetAllTestimonialsAboutResponse struct {
+	*Response
+}
+
+// GetAllTestimonialsAbout calls the flickr.testimonials.getAllTestimonialsAbout method. Get all testimonials (pending and approved) written about the given user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetAllTestimonialsAbout(ctx context.Context, cl client.Client, args TestimonialsGetAllTestimonialsAboutArgs) (*TestimonialsGetAllTestimonialsAboutResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getAllTestimonialsAbout", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetAllTestimonialsAboutResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetAllTestimonialsAboutByArgs is a struct containing the arguments for the flickr.testimonials.getAllTestimonialsAboutBy method.
+type TestimonialsGetAllTestimonialsAboutByArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetAllTestimonialsAboutByArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// TestimonialsGetAllTestimonialsAboutByResponse is a struct containing the response for the flickr.testimonials.getAllTestimonialsAboutBy method.
+type TestimonialsGetAllTestimonialsAboutByResponse struct {
+	*Response
+}
+
+// GetAllTestimonialsAboutBy calls the flickr.testimonials.getAllTestimonialsAboutBy method. Get the testimonial by the currently logged-in user about the given user, regardless of approval status. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetAllTestimonialsAboutBy(ctx context.Context, cl client.Client, args TestimonialsGetAllTestimonialsAboutByArgs) (*TestimonialsGetAllTestimonialsAboutByResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getAllTestimonialsAboutBy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetAllTestimonialsAboutByResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetAllTestimonialsByArgs is a struct containing the arguments for the flickr.testimonials.getAllTestimonialsBy method.
+type TestimonialsGetAllTestimonialsByArgs struct {
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetAllTestimonialsByArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// TestimonialsGetAllTestimonialsByResponse is a struct containing the response for the flickr.testimonials.getAllTestimonialsBy method.
+type TestimonialsGetAllTestimonialsByResponse struct {
+	*Response
+}
+
+// GetAllTestimonialsBy calls the flickr.testimonials.getAllTestimonialsBy method. Get all testimonials (pending and approved) written by the given user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetAllTestimonialsBy(ctx context.Context, cl client.Client, args TestimonialsGetAllTestimonialsByArgs) (*TestimonialsGetAllTestimonialsByResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getAllTestimonialsBy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetAllTestimonialsByResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetPendingTestimonialsAboutArgs is a struct containing the arguments for the flickr.testimonials.getPendingTestimonialsAbout method.
+type TestimonialsGetPendingTestimonialsAboutArgs struct {
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetPendingTestimonialsAboutArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// TestimonialsGetPendingTestimonialsAboutResponse is a struct containing the response for the flickr.testimonials.getPendingTestimonialsAbout method.
+type TestimonialsGetPendingTestimonialsAboutResponse struct {
+	*Response
+}
+
+// GetPendingTestimonialsAbout calls the flickr.testimonials.getPendingTestimonialsAbout method. Get all pending testimonials written about the given user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetPendingTestimonialsAbout(ctx context.Context, cl client.Client, args TestimonialsGetPendingTestimonialsAboutArgs) (*TestimonialsGetPendingTestimonialsAboutResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getPendingTestimonialsAbout", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetPendingTestimonialsAboutResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetPendingTestimonialsAboutByArgs is a struct containing the arguments for the flickr.testimonials.getPendingTestimonialsAboutBy method.
+type TestimonialsGetPendingTestimonialsAboutByArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetPendingTestimonialsAboutByArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// TestimonialsGetPendingTestimonialsAboutByResponse is a struct containing the response for the flickr.testimonials.getPendingTestimonialsAboutBy method.
+type TestimonialsGetPendingTestimonialsAboutByResponse struct {
+	*Response
+}
+
+// GetPendingTestimonialsAboutBy calls the flickr.testimonials.getPendingTestimonialsAboutBy method. Get the pending testimonial by the currently logged-in user about the given user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetPendingTestimonialsAboutBy(ctx context.Context, cl client.Client, args TestimonialsGetPendingTestimonialsAboutByArgs) (*TestimonialsGetPendingTestimonialsAboutByResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getPendingTestimonialsAboutBy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetPendingTestimonialsAboutByResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetPendingTestimonialsByArgs is a struct containing the arguments for the flickr.testimonials.getPendingTestimonialsBy method.
+type TestimonialsGetPendingTestimonialsByArgs struct {
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetPendingTestimonialsByArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// TestimonialsGetPendingTestimonialsByResponse is a struct containing the response for the flickr.testimonials.getPendingTestimonialsBy method.
+type TestimonialsGetPendingTestimonialsByResponse struct {
+	*Response
+}
+
+// GetPendingTestimonialsBy calls the flickr.testimonials.getPendingTestimonialsBy method. Get all pending testimonials written by the given user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetPendingTestimonialsBy(ctx context.Context, cl client.Client, args TestimonialsGetPendingTestimonialsByArgs) (*TestimonialsGetPendingTestimonialsByResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getPendingTestimonialsBy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetPendingTestimonialsByResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetTestimonialsAboutArgs is a struct containing the arguments for the flickr.testimonials.getTestimonialsAbout method.
+type TestimonialsGetTestimonialsAboutArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetTestimonialsAboutArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// TestimonialsGetTestimonialsAboutResponse is a struct containing the response for the flickr.testimonials.getTestimonialsAbout method.
+type TestimonialsGetTestimonialsAboutResponse struct {
+	*Response
+}
+
+// GetTestimonialsAbout calls the flickr.testimonials.getTestimonialsAbout method. Get approved testimonials about the given user.
+func (TestimonialsNamespace) GetTestimonialsAbout(ctx context.Context, cl client.Client, args TestimonialsGetTestimonialsAboutArgs) (*TestimonialsGetTestimonialsAboutResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getTestimonialsAbout", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetTestimonialsAboutResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetTestimonialsAboutByArgs is a struct containing the arguments for the flickr.testimonials.getTestimonialsAboutBy method.
+type TestimonialsGetTestimonialsAboutByArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetTestimonialsAboutByArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	return args, nil
+}
+
+// TestimonialsGetTestimonialsAboutByResponse is a struct containing the response for the flickr.testimonials.getTestimonialsAboutBy method.
+type TestimonialsGetTestimonialsAboutByResponse struct {
+	*Response
+}
+
+// GetTestimonialsAboutBy calls the flickr.testimonials.getTestimonialsAboutBy method. Get the approved testimonial by the currently logged-in user about the given user. Requires "read" permissions. Requires an authenticated user. Requires a signed request.
+func (TestimonialsNamespace) GetTestimonialsAboutBy(ctx context.Context, cl client.Client, args TestimonialsGetTestimonialsAboutByArgs) (*TestimonialsGetTestimonialsAboutByResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getTestimonialsAboutBy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetTestimonialsAboutByResponse{Response: rsp}
+
+	return r, nil
+}
+
+// TestimonialsGetTestimonialsByArgs is a struct containing the arguments for the flickr.testimonials.getTestimonialsBy method.
+type TestimonialsGetTestimonialsByArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned. Required.
+	UserId string
+	// The page of results to return. If this argument is omitted, it defaults to 1.
+	Page string
+	// Number of photos to return per page. If this argument is omitted, it defaults to 100. The maximum allowed value is 500.
+	PerPage string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a TestimonialsGetTestimonialsByArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId == "" {
+		return nil, fmt.Errorf("Missing required user_id argument")
+	}
+
+	args.Set("user_id", a.UserId)
+
+	if a.Page != "" {
+		args.Set("page", a.Page)
+	}
+
+	if a.PerPage != "" {
+		args.Set("per_page", a.PerPage)
+	}
+
+	return args, nil
+}
+
+// TestimonialsGetTestimonialsByResponse is a struct containing the response for the flickr.testimonials.getTestimonialsBy method.
+type TestimonialsGetTestimonialsByResponse struct {
+	*Response
+}
+
+// GetTestimonialsBy calls the flickr.testimonials.getTestimonialsBy method. Get approved testimonials written by the given user.
+func (TestimonialsNamespace) GetTestimonialsBy(ctx context.Context, cl client.Client, args TestimonialsGetTestimonialsByArgs) (*TestimonialsGetTestimonialsByResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.testimonials.getTestimonialsBy", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &TestimonialsGetTestimonialsByResponse{Response: rsp}
+
+	return r, nil
+}
+
+// UrlsGetGroupArgs is a struct containing the arguments for the flickr.urls.getGroup method.
+type UrlsGetGroupArgs struct {
+	// The NSID of the group who's pool the photo is to be added to. Required.
+	GroupId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a UrlsGetGroupArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.GroupId == "" {
+		return nil, fmt.Errorf("Missing required group_id argument")
+	}
+
+	args.Set("group_id", a.GroupId)
+
+	return args, nil
+}
+
+// UrlsGetGroupResponse is a struct containing the response for the flickr.urls.getGroup method.
+type UrlsGetGroupResponse struct {
+	*Response
+}
+
+// GetGroup calls the flickr.urls.getGroup method. Returns the url to a group's page.
+func (UrlsNamespace) GetGroup(ctx context.Context, cl client.Client, args UrlsGetGroupArgs) (*UrlsGetGroupResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.urls.getGroup", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &UrlsGetGroupResponse{Response: rsp}
+
+	return r, nil
+}
+
+// UrlsGetUserPhotosArgs is a struct containing the arguments for the flickr.urls.getUserPhotos method.
+type UrlsGetUserPhotosArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a UrlsGetUserPhotosArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	return args, nil
+}
+
+// UrlsGetUserPhotosResponse is a struct containing the response for the flickr.urls.getUserPhotos method.
+type UrlsGetUserPhotosResponse struct {
+	*Response
+}
+
+// GetUserPhotos calls the flickr.urls.getUserPhotos method. Returns the url to a user's photos.
+func (UrlsNamespace) GetUserPhotos(ctx context.Context, cl client.Client, args UrlsGetUserPhotosArgs) (*UrlsGetUserPhotosResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.urls.getUserPhotos", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &UrlsGetUserPhotosResponse{Response: rsp}
+
+	return r, nil
+}
+
+// UrlsGetUserProfileArgs is a struct containing the arguments for the flickr.urls.getUserProfile method.
+type UrlsGetUserProfileArgs struct {
+	// The NSID of the user to fetch the favorites list for. If this argument is omitted, the favorites list for the calling user is returned.
+	UserId string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a UrlsGetUserProfileArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.UserId != "" {
+		args.Set("user_id", a.UserId)
+	}
+
+	return args, nil
+}
+
+// UrlsGetUserProfileResponse is a struct containing the response for the flickr.urls.getUserProfile method.
+type UrlsGetUserProfileResponse struct {
+	*Response
+}
+
+// GetUserProfile calls the flickr.urls.getUserProfile method. Returns the url to a user's profile.
+func (UrlsNamespace) GetUserProfile(ctx context.Context, cl client.Client, args UrlsGetUserProfileArgs) (*UrlsGetUserProfileResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.urls.getUserProfile", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &UrlsGetUserProfileResponse{Response: rsp}
+
+	return r, nil
+}
+
+// UrlsLookupGalleryArgs is a struct containing the arguments for the flickr.urls.lookupGallery method.
+type UrlsLookupGalleryArgs struct {
+	// The url to look up. Required.
+	Url string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a UrlsLookupGalleryArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Url == "" {
+		return nil, fmt.Errorf("Missing required url argument")
+	}
+
+	args.Set("url", a.Url)
+
+	return args, nil
+}
+
+// UrlsLookupGalleryResponse is a struct containing the response for the flickr.urls.lookupGallery method.
+type UrlsLookupGalleryResponse struct {
+	*Response
+}
+
+// LookupGallery calls the flickr.urls.lookupGallery method. Returns gallery info, by url.
+func (UrlsNamespace) LookupGallery(ctx context.Context, cl client.Client, args UrlsLookupGalleryArgs) (*UrlsLookupGalleryResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.urls.lookupGallery", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &UrlsLookupGalleryResponse{Response: rsp}
+
+	return r, nil
+}
+
+// UrlsLookupGroupArgs is a struct containing the arguments for the flickr.urls.lookupGroup method.
+type UrlsLookupGroupArgs struct {
+	// The url to look up. Required.
+	Url string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a UrlsLookupGroupArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Url == "" {
+		return nil, fmt.Errorf("Missing required url argument")
+	}
+
+	args.Set("url", a.Url)
+
+	return args, nil
+}
+
+// UrlsLookupGroupResponse is a struct containing the response for the flickr.urls.lookupGroup method.
+type UrlsLookupGroupResponse struct {
+	*Response
+}
+
+// LookupGroup calls the flickr.urls.lookupGroup method. Returns a group NSID, given the url to a group's page or photo pool.
+func (UrlsNamespace) LookupGroup(ctx context.Context, cl client.Client, args UrlsLookupGroupArgs) (*UrlsLookupGroupResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.urls.lookupGroup", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &UrlsLookupGroupResponse{Response: rsp}
+
+	return r, nil
+}
+
+// UrlsLookupUserArgs is a struct containing the arguments for the flickr.urls.lookupUser method.
+type UrlsLookupUserArgs struct {
+	// The url to look up. Required.
+	Url string
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a UrlsLookupUserArgs) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+
+	if a.Url == "" {
+		return nil, fmt.Errorf("Missing required url argument")
+	}
+
+	args.Set("url", a.Url)
+
+	return args, nil
+}
+
+// UrlsLookupUserResponse is a struct containing the response for the flickr.urls.lookupUser method.
+type UrlsLookupUserResponse struct {
+	*Response
+}
+
+// LookupUser calls the flickr.urls.lookupUser method. Returns a user NSID, given the url to a user's photos or profile.
+func (UrlsNamespace) LookupUser(ctx context.Context, cl client.Client, args UrlsLookupUserArgs) (*UrlsLookupUserResponse, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "flickr.urls.lookupUser", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &UrlsLookupUserResponse{Response: rsp}
+
+	return r, nil
+}
@@ -0,0 +1,87 @@
+package methods
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/reflection"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// Ensure that generated.go is up to date with reflection.json.
+func TestGenerated(t *testing.T) {
+
+	r, err := os.Open("reflection.json")
+
+	if err != nil {
+		t.Fatalf("Failed to open reflection.json, %v", err)
+	}
+
+	defer r.Close()
+
+	snapshot, err := reflection.ReadSnapshot(r)
+
+	if err != nil {
+		t.Fatalf("Failed to read snapshot, %v", err)
+	}
+
+	opts := &reflection.GenerateOptions{
+		Package:   "methods",
+		Generator: "go-flickr-api/cmd/generate-methods",
+	}
+
+	src, err := reflection.Generate(snapshot, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to generate code, %v", err)
+	}
+
+	generated, err := os.ReadFile("generated.go")
+
+	if err != nil {
+		t.Fatalf("Failed to read generated.go, %v", err)
+	}
+
+	if !bytes.Equal(src, generated) {
+		t.Fatalf("generated.go is out of date, run 'go generate ./methods'")
+	}
+}
+
+// Ensure that the documented "not found" error codes in reflection.json match the response.ErrNotFound sentinel value.
+func TestNotFoundCodes(t *testing.T) {
+
+	r, err := os.Open("reflection.json")
+
+	if err != nil {
+		t.Fatalf("Failed to open reflection.json, %v", err)
+	}
+
+	defer r.Close()
+
+	snapshot, err := reflection.ReadSnapshot(r)
+
+	if err != nil {
+		t.Fatalf("Failed to read snapshot, %v", err)
+	}
+
+	for _, m := range snapshot.Methods {
+
+		if m.Errors == nil {
+			continue
+		}
+
+		for _, e := range m.Errors.Error {
+
+			api_err := &response.Error{Code: int(e.Code), Message: e.Message, Method: m.Method.Name}
+
+			expected := strings.Contains(strings.ToLower(e.Message), "not found")
+
+			if errors.Is(api_err, response.ErrNotFound) != expected {
+				t.Fatalf("Unexpected match for %s error %d (%s)", m.Method.Name, e.Code, e.Message)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// package methods provides typed bindings for Flickr API methods. The bindings are generated from a snapshot of
+// the output of the flickr.reflection.getMethods and flickr.reflection.getMethodInfo API methods (reflection.json)
+// using the cmd/generate-methods tool. All methods are built on top of the client.Client ExecuteMethod method and
+// return JSON-encoded responses. For example:
+//
+//	rsp, err := methods.Photos.GetInfo(ctx, cl, methods.PhotosGetInfoArgs{PhotoId: "6923069836"})
+//	title := rsp.Get("photo.title._content").String()
+//
+// Methods that return standard photo lists, photos, photosets or upload tickets also have typed fields, defined in the
+// response package, for those elements. For example:
+//
+//	title := rsp.Photo.Title.Value
+package methods
+
+//go:generate go run ../cmd/generate-methods/main.go -reflection reflection.json -output generated.go
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+// MethodInfo is a struct containing details, derived from the Flickr API reflection methods, about an API method.
+type MethodInfo struct {
+	// The name of the API method.
+	Name string
+	// A boolean flag indicating whether the method requires an authenticated user.
+	NeedsLogin bool
+	// A boolean flag indicating whether the method requires a signed request.
+	NeedsSigning bool
+	// The permissions required to call the method: "none", "read", "write" or "delete".
+	RequiredPerms string
+}
+
+// Response is a struct containing the body of a successful (JSON-encoded) Flickr API response.
+type Response struct {
+	// The body of the API response.
+	Body []byte `json:"-"`
+}
+
+// Get returns the value of 'path' in the response body. Paths are expected to be valid tidwall/gjson paths.
+func (r *Response) Get(path string) gjson.Result {
+	return gjson.GetBytes(r.Body, path)
+}
+
+// Unmarshal unmarshals the response body in to 'v'.
+func (r *Response) Unmarshal(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// execute calls the Flickr API method 'method' with 'args', using 'cl', ensuring that the response is JSON-encoded
+// and returning a *response.Error instance if the API call failed.
+func execute(ctx context.Context, cl client.Client, method string, args *url.Values) (*Response, error) {
+
+	args.Set("method", method)
+	args.Set("format", "json")
+	args.Set("nojsoncallback", "1")
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = response.CheckMethodStatusBytes(body, method)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &Response{
+		Body: body,
+	}
+
+	return rsp, nil
+}
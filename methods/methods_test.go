@@ -0,0 +1,95 @@
+package methods
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+)
+
+func TestTypedResponses(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	ph := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Title: "Hello world",
+		Tags:  []string{"cat"},
+	})
+
+	set := svr.Store.AddPhotoset(&flickrtest.Photoset{
+		Owner:   svr.UserId,
+		Title:   "Cats",
+		Primary: ph.Id,
+		Photos:  []int64{ph.Id},
+	})
+
+	str_id := strconv.FormatInt(ph.Id, 10)
+
+	info, err := Photos.GetInfo(ctx, cl, PhotosGetInfoArgs{PhotoId: str_id})
+
+	if err != nil {
+		t.Fatalf("Failed to get photo info, %v", err)
+	}
+
+	if info.Photo == nil || info.Photo.Id != str_id || info.Photo.Title.Value != "Hello world" {
+		t.Fatalf("Unexpected photo info, %s", string(info.Body))
+	}
+
+	if info.Photo.Tags == nil || len(info.Photo.Tags.Tag) != 1 || info.Photo.Tags.Tag[0].Value != "cat" {
+		t.Fatalf("Unexpected photo tags, %s", string(info.Body))
+	}
+
+	search, err := Photos.Search(ctx, cl, PhotosSearchArgs{UserId: svr.UserId})
+
+	if err != nil {
+		t.Fatalf("Failed to search photos, %v", err)
+	}
+
+	if search.Photos == nil || search.Photos.Total != 1 || len(search.Photos.Photo) != 1 || search.Photos.Photo[0].Id != str_id {
+		t.Fatalf("Unexpected search results, %s", string(search.Body))
+	}
+
+	photos, err := Photosets.GetPhotos(ctx, cl, PhotosetsGetPhotosArgs{PhotosetId: strconv.FormatInt(set.Id, 10), UserId: svr.UserId})
+
+	if err != nil {
+		t.Fatalf("Failed to get photoset photos, %v", err)
+	}
+
+	if photos.Photoset == nil || photos.Photoset.Title != "Cats" || len(photos.Photoset.Photo) != 1 {
+		t.Fatalf("Unexpected photoset photos, %s", string(photos.Body))
+	}
+
+	sets, err := Photosets.GetList(ctx, cl, PhotosetsGetListArgs{UserId: svr.UserId})
+
+	if err != nil {
+		t.Fatalf("Failed to list photosets, %v", err)
+	}
+
+	if sets.Photosets == nil || len(sets.Photosets.Photoset) != 1 || sets.Photosets.Photoset[0].Title.Value != "Cats" {
+		t.Fatalf("Unexpected photosets, %s", string(sets.Body))
+	}
+
+	// Methods without a typed response field still provide access to the response body
+
+	echo, err := Test.Echo(ctx, cl, TestEchoArgs{})
+
+	if err != nil {
+		t.Fatalf("Failed to call echo, %v", err)
+	}
+
+	if echo.Get("stat").String() != "ok" {
+		t.Fatalf("Unexpected echo response, %s", string(echo.Body))
+	}
+}
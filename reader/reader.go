@@ -6,12 +6,14 @@ import (
 	"io"
 	"net/url"
 	"path/filepath"
+	"strings"
 
 	"gocloud.dev/blob"
 )
 
 // Return an io.ReadCloser instance using the gocloud.dev/blob NewReader method.
 // If path is not fully qualified URI then assume the file:// scheme.
+// For other schemes the host is the name of the bucket and the path is the key of the file within that bucket.
 func NewReader(ctx context.Context, path string) (io.ReadCloser, error) {
 
 	u, err := url.Parse(path)
@@ -36,6 +38,19 @@ func NewReader(ctx context.Context, path string) (io.ReadCloser, error) {
 	root := filepath.Dir(path)
 	fname := filepath.Base(path)
 
+	// For schemes other than file:// the host is the name of the bucket and the path is the key of the file
+	// within that bucket, for example s3://bucket/photos/a.jpg.
+
+	if u.Scheme != "file" {
+
+		bucket_u := *u
+		bucket_u.Path = ""
+		bucket_u.RawPath = ""
+
+		root = bucket_u.String()
+		fname = strings.TrimPrefix(u.Path, "/")
+	}
+
 	b, err := blob.OpenBucket(ctx, root)
 
 	if err != nil {
@@ -0,0 +1,471 @@
+package reflection
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+var re_tags = regexp.MustCompile(`<[^>]+>`)
+var re_whitespace = regexp.MustCompile(`\s+`)
+var re_punctuation = regexp.MustCompile(`\s+([.,;:!?)])`)
+
+// The names of the permissions required to call a method, indexed by the Method.RequiredPerms value.
+var perms_names = []string{
+	"none",
+	"read",
+	"write",
+	"delete",
+}
+
+// response_fields maps API methods to the typed field, defined in the response package, that is added to their generated
+// "{PREFIX}Response" structs. Methods not listed here only provide access to the raw response body.
+var response_fields = map[string]*generateResponseField{
+	"flickr.favorites.getList":                    photos_field,
+	"flickr.favorites.getPublicList":              photos_field,
+	"flickr.galleries.getPhotos":                  photos_field,
+	"flickr.groups.pools.getPhotos":               photos_field,
+	"flickr.interestingness.getList":              photos_field,
+	"flickr.people.getPhotos":                     photos_field,
+	"flickr.people.getPhotosOf":                   photos_field,
+	"flickr.people.getPublicPhotos":               photos_field,
+	"flickr.photos.comments.getRecentForContacts": photos_field,
+	"flickr.photos.geo.photosForLocation":         photos_field,
+	"flickr.photos.getContactsPhotos":             photos_field,
+	"flickr.photos.getContactsPublicPhotos":       photos_field,
+	"flickr.photos.getNotInSet":                   photos_field,
+	"flickr.photos.getPopular":                    photos_field,
+	"flickr.photos.getRecent":                     photos_field,
+	"flickr.photos.getUntagged":                   photos_field,
+	"flickr.photos.getWithGeoData":                photos_field,
+	"flickr.photos.getWithoutGeoData":             photos_field,
+	"flickr.photos.recentlyUpdated":               photos_field,
+	"flickr.photos.search":                        photos_field,
+	"flickr.photos.getInfo":                       &generateResponseField{Name: "Photo", Key: "photo", Type: "response.PhotoInfo"},
+	"flickr.photos.upload.checkTickets":           &generateResponseField{Name: "Uploader", Key: "uploader", Type: "response.Uploader"},
+	"flickr.photosets.create":                     photoset_field,
+	"flickr.photosets.getInfo":                    photoset_field,
+	"flickr.photosets.getList":                    &generateResponseField{Name: "Photosets", Key: "photosets", Type: "response.Photosets"},
+	"flickr.photosets.getPhotos":                  &generateResponseField{Name: "Photoset", Key: "photoset", Type: "response.PhotosetPhotos"},
+}
+
+// The typed field for methods that return a "standard photo list" response.
+var photos_field = &generateResponseField{Name: "Photos", Key: "photos", Type: "response.Photos"}
+
+// The typed field for methods that return a single photoset.
+var photoset_field = &generateResponseField{Name: "Photoset", Key: "photoset", Type: "response.Photoset"}
+
+// GenerateOptions is a struct containing configuration details for the Generate method.
+type GenerateOptions struct {
+	// The name of the Go package for the generated code.
+	Package string
+	// The name of the application generating the code, used in the "Code generated" header.
+	Generator string
+}
+
+type generateVars struct {
+	Package    string
+	Generator  string
+	Namespaces []*generateNamespace
+	Methods    []*generateMethod
+	// Whether any methods have typed response fields, in which case the response package is imported.
+	HasResponseFields bool
+}
+
+type generateNamespace struct {
+	// The Flickr API namespace, for example "flickr.photos.geo"
+	Name string
+	// The Go type name for the namespace, for example "PhotosGeoNamespace"
+	TypeName string
+	// The Go field (or variable) name for the namespace, for example "Geo"
+	FieldName string
+	// Whether this is a top-level namespace (for example "flickr.photos")
+	TopLevel bool
+	Children []*generateNamespace
+	Methods  []*generateMethod
+}
+
+type generateMethod struct {
+	Name          string
+	GoName        string
+	Prefix        string
+	Namespace     string
+	Description   string
+	NeedsLogin    bool
+	NeedsSigning  bool
+	RequiredPerms string
+	Arguments     []*generateArgument
+	Response      *generateResponseField
+}
+
+type generateResponseField struct {
+	// The Go field name, for example "Photos"
+	Name string
+	// The top-level key in the JSON-encoded API response, for example "photos"
+	Key string
+	// The Go type, defined in the response package, for example "response.Photos"
+	Type string
+}
+
+type generateArgument struct {
+	Name        string
+	GoName      string
+	Required    bool
+	Description string
+}
+
+// Generate generates Go source code, formatted with go/format, containing typed bindings for all the methods in 's'.
+// For each method a "{PREFIX}Args" struct, a "{PREFIX}Response" struct and a method on the corresponding namespace
+// type are generated where {PREFIX} is the method name with the leading "flickr." removed and remaining segments
+// capitalized, for example "flickr.photos.getInfo" becomes "PhotosGetInfo". Output is deterministic for a given
+// Snapshot and GenerateOptions.
+func Generate(s *Snapshot, opts *GenerateOptions) ([]byte, error) {
+
+	namespaces := make(map[string]*generateNamespace)
+	methods := make([]*generateMethod, 0)
+
+	var ensureNamespace func(segments []string) *generateNamespace
+
+	ensureNamespace = func(segments []string) *generateNamespace {
+
+		name := "flickr." + strings.Join(segments, ".")
+
+		ns, exists := namespaces[name]
+
+		if exists {
+			return ns
+		}
+
+		type_name := ""
+
+		for _, seg := range segments {
+			type_name += exportedName(seg)
+		}
+
+		ns = &generateNamespace{
+			Name:      name,
+			TypeName:  type_name + "Namespace",
+			FieldName: exportedName(segments[len(segments)-1]),
+			TopLevel:  len(segments) == 1,
+			Children:  make([]*generateNamespace, 0),
+			Methods:   make([]*generateMethod, 0),
+		}
+
+		namespaces[name] = ns
+
+		if !ns.TopLevel {
+			parent := ensureNamespace(segments[:len(segments)-1])
+			parent.Children = append(parent.Children, ns)
+		}
+
+		return ns
+	}
+
+	for _, info := range s.Methods {
+
+		m := info.Method
+
+		segments := strings.Split(m.Name, ".")
+
+		if len(segments) < 3 || segments[0] != "flickr" {
+			return nil, fmt.Errorf("Invalid method name '%s'", m.Name)
+		}
+
+		segments = segments[1:]
+
+		ns := ensureNamespace(segments[:len(segments)-1])
+
+		prefix := ""
+
+		for _, seg := range segments {
+			prefix += exportedName(seg)
+		}
+
+		perms := int(m.RequiredPerms)
+
+		if perms < 0 || perms >= len(perms_names) {
+			return nil, fmt.Errorf("Invalid required permissions (%d) for method '%s'", perms, m.Name)
+		}
+
+		desc := ""
+
+		if m.Description != nil {
+			desc = cleanDescription(m.Description.Value)
+		}
+
+		gm := &generateMethod{
+			Name:          m.Name,
+			GoName:        exportedName(segments[len(segments)-1]),
+			Prefix:        prefix,
+			Namespace:     ns.TypeName,
+			Description:   desc,
+			NeedsLogin:    m.NeedsLogin == 1,
+			NeedsSigning:  m.NeedsSigning == 1,
+			RequiredPerms: perms_names[perms],
+			Arguments:     make([]*generateArgument, 0),
+			Response:      response_fields[m.Name],
+		}
+
+		seen := make(map[string]bool)
+
+		if info.Arguments != nil {
+
+			for _, a := range info.Arguments.Argument {
+
+				// The API key is added by the client, not the caller.
+
+				if a.Name == "api_key" {
+					continue
+				}
+
+				go_name := exportedName(a.Name)
+
+				if seen[go_name] {
+					return nil, fmt.Errorf("Duplicate argument '%s' for method '%s'", go_name, m.Name)
+				}
+
+				seen[go_name] = true
+
+				ga := &generateArgument{
+					Name:        a.Name,
+					GoName:      go_name,
+					Required:    a.Optional == 0,
+					Description: cleanDescription(a.Description),
+				}
+
+				gm.Arguments = append(gm.Arguments, ga)
+			}
+		}
+
+		ns.Methods = append(ns.Methods, gm)
+		methods = append(methods, gm)
+	}
+
+	// Ensure that methods and child namespaces don't collide
+
+	for _, ns := range namespaces {
+
+		for _, child := range ns.Children {
+
+			for _, m := range ns.Methods {
+
+				if m.GoName == child.FieldName {
+					return nil, fmt.Errorf("Method '%s' collides with namespace '%s'", m.Name, child.Name)
+				}
+			}
+		}
+	}
+
+	all_namespaces := make([]*generateNamespace, 0)
+
+	for _, ns := range namespaces {
+
+		sort.Slice(ns.Children, func(i, j int) bool {
+			return ns.Children[i].Name < ns.Children[j].Name
+		})
+
+		sort.Slice(ns.Methods, func(i, j int) bool {
+			return ns.Methods[i].Name < ns.Methods[j].Name
+		})
+
+		all_namespaces = append(all_namespaces, ns)
+	}
+
+	sort.Slice(all_namespaces, func(i, j int) bool {
+		return all_namespaces[i].Name < all_namespaces[j].Name
+	})
+
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+
+	has_response_fields := false
+
+	for _, m := range methods {
+
+		if m.Response != nil {
+			has_response_fields = true
+			break
+		}
+	}
+
+	vars := generateVars{
+		Package:           opts.Package,
+		Generator:         opts.Generator,
+		Namespaces:        all_namespaces,
+		Methods:           methods,
+		HasResponseFields: has_response_fields,
+	}
+
+	t, err := template.New("methods").Parse(methods_t)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse template, %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, vars)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to execute template, %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to format generated code, %w", err)
+	}
+
+	return src, nil
+}
+
+// exportedName converts a Flickr API method or argument name in to an exported Go identifier, for example
+// "getInfo" becomes "GetInfo" and "photo_id" becomes "PhotoId".
+func exportedName(name string) string {
+
+	var buf strings.Builder
+	upper := true
+
+	for _, r := range name {
+
+		if r == '_' || r == '-' || r == '.' {
+			upper = true
+			continue
+		}
+
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			continue
+		}
+
+		if upper {
+			buf.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+
+	str := buf.String()
+
+	if str == "" || unicode.IsDigit(rune(str[0])) {
+		str = "X" + str
+	}
+
+	return str
+}
+
+// cleanDescription removes HTML markup and extraneous whitespace from 'str' so that it can be used in a single line Go comment.
+func cleanDescription(str string) string {
+
+	str = re_tags.ReplaceAllString(str, " ")
+	str = html.UnescapeString(str)
+	str = re_whitespace.ReplaceAllString(str, " ")
+	str = re_punctuation.ReplaceAllString(str, "$1")
+	str = strings.TrimSpace(str)
+
+	return str
+}
+
+const methods_t string = `// Code generated by {{ .Generator }}. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/aaronland/go-flickr-api/client"
+{{- if .HasResponseFields }}
+	"github.com/aaronland/go-flickr-api/response"
+{{- end }}
+)
+
+// Catalogue maps Flickr API method names to their MethodInfo details.
+var Catalogue = map[string]*MethodInfo{
+{{- range .Methods }}
+	"{{ .Name }}": &MethodInfo{Name: "{{ .Name }}", NeedsLogin: {{ .NeedsLogin }}, NeedsSigning: {{ .NeedsSigning }}, RequiredPerms: "{{ .RequiredPerms }}"},
+{{- end }}
+}
+{{ range .Namespaces }}{{ if .TopLevel }}
+// {{ .FieldName }} provides methods in the {{ .Name }} namespace.
+var {{ .FieldName }} {{ .TypeName }}
+{{ end }}{{ end }}
+{{- range .Namespaces }}
+// {{ .TypeName }} provides methods in the {{ .Name }} namespace.
+type {{ .TypeName }} struct {
+{{- range .Children }}
+	// {{ .FieldName }} provides methods in the {{ .Name }} namespace.
+	{{ .FieldName }} {{ .TypeName }}
+{{- end }}
+}
+{{ end }}
+{{- range .Methods }}
+// {{ .Prefix }}Args is a struct containing the arguments for the {{ .Name }} method.
+type {{ .Prefix }}Args struct {
+{{- range .Arguments }}
+	// {{ if .Description }}{{ .Description }}{{ else }}The {{ .Name }} argument.{{ end }}{{ if .Required }} Required.{{ end }}
+	{{ .GoName }} string
+{{- end }}
+}
+
+// Values returns the arguments as a url.Values instance, returning an error if any required arguments are missing.
+func (a {{ .Prefix }}Args) Values() (*url.Values, error) {
+
+	args := &url.Values{}
+{{ range .Arguments }}{{ if .Required }}
+	if a.{{ .GoName }} == "" {
+		return nil, fmt.Errorf("Missing required {{ .Name }} argument")
+	}
+
+	args.Set("{{ .Name }}", a.{{ .GoName }})
+{{ else }}
+	if a.{{ .GoName }} != "" {
+		args.Set("{{ .Name }}", a.{{ .GoName }})
+	}
+{{ end }}{{ end }}
+	return args, nil
+}
+
+// {{ .Prefix }}Response is a struct containing the response for the {{ .Name }} method.
+type {{ .Prefix }}Response struct {
+	*Response
+{{- if .Response }}
+	// The "{{ .Response.Key }}" element in the API response.
+	{{ .Response.Name }} *{{ .Response.Type }} ` + "`" + `json:"{{ .Response.Key }}"` + "`" + `
+{{- end }}
+}
+
+// {{ .GoName }} calls the {{ .Name }} method.{{ if .Description }} {{ .Description }}{{ end }}{{ if ne .RequiredPerms "none" }} Requires "{{ .RequiredPerms }}" permissions.{{ end }}{{ if .NeedsLogin }} Requires an authenticated user.{{ end }}{{ if .NeedsSigning }} Requires a signed request.{{ end }}
+func ({{ .Namespace }}) {{ .GoName }}(ctx context.Context, cl client.Client, args {{ .Prefix }}Args) (*{{ .Prefix }}Response, error) {
+
+	q, err := args.Values()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := execute(ctx, cl, "{{ .Name }}", q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &{{ .Prefix }}Response{Response: rsp}
+{{ if .Response }}
+	err = rsp.Unmarshal(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal {{ .Name }} response, %w", err)
+	}
+{{ end }}
+	return r, nil
+}
+{{ end }}`
@@ -0,0 +1,96 @@
+package reflection
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+
+	snapshot := `{"methods":[
+{"method":{"name":"flickr.photos.geo.setLocation","needslogin":1,"needssigning":1,"requiredperms":2,"description":{"_content":"Sets the geo data for a <b>photo</b>."}},
+ "arguments":{"argument":[{"name":"api_key","optional":0,"_content":"Your API key"},{"name":"photo_id","optional":0,"_content":"The id of the photo."},{"name":"accuracy","optional":"1","_content":"Recorded accuracy level."}]}},
+{"method":{"name":"flickr.photos.getInfo","needslogin":0,"needssigning":0,"requiredperms":0,"description":{"_content":"Get information about a photo."}},
+ "arguments":{"argument":[{"name":"photo_id","optional":0,"_content":"The id of the photo."}]}}
+]}`
+
+	s, err := ReadSnapshot(strings.NewReader(snapshot))
+
+	if err != nil {
+		t.Fatalf("Failed to read snapshot, %v", err)
+	}
+
+	if s.Methods[0].Method.Name != "flickr.photos.geo.setLocation" || s.Methods[1].Method.Name != "flickr.photos.getInfo" {
+		t.Fatalf("Snapshot methods are not sorted")
+	}
+
+	opts := &GenerateOptions{
+		Package:   "methods",
+		Generator: "test",
+	}
+
+	src, err := Generate(s, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to generate code, %v", err)
+	}
+
+	_, err = parser.ParseFile(token.NewFileSet(), "generated.go", src, 0)
+
+	if err != nil {
+		t.Fatalf("Failed to parse generated code, %v", err)
+	}
+
+	expected := []string{
+		"func (PhotosGeoNamespace) SetLocation(ctx context.Context, cl client.Client, args PhotosGeoSetLocationArgs) (*PhotosGeoSetLocationResponse, error)",
+		"func (PhotosNamespace) GetInfo(ctx context.Context, cl client.Client, args PhotosGetInfoArgs) (*PhotosGetInfoResponse, error)",
+		"Geo PhotosGeoNamespace",
+		"Sets the geo data for a photo. Requires \"write\" permissions. Requires an authenticated user. Requires a signed request.",
+		"The id of the photo. Required.",
+		"Photo *response.PhotoInfo `json:\"photo\"`",
+		"\"github.com/aaronland/go-flickr-api/response\"",
+	}
+
+	for _, str := range expected {
+
+		if !bytes.Contains(src, []byte(str)) {
+			t.Fatalf("Generated code is missing '%s'", str)
+		}
+	}
+
+	if bytes.Contains(src, []byte("ApiKey")) {
+		t.Fatalf("Generated code should not contain api_key argument")
+	}
+
+	src2, err := Generate(s, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to generate code a second time, %v", err)
+	}
+
+	if !bytes.Equal(src, src2) {
+		t.Fatalf("Generated code is not deterministic")
+	}
+}
+
+func TestExportedName(t *testing.T) {
+
+	tests := map[string]string{
+		"getInfo":         "GetInfo",
+		"photo_id":        "PhotoId",
+		"min_upload_date": "MinUploadDate",
+		"3gp":             "X3gp",
+	}
+
+	for input, expected := range tests {
+
+		v := exportedName(input)
+
+		if v != expected {
+			t.Fatalf("Unexpected name for '%s', expected '%s' but got '%s'", input, expected, v)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// package reflection provides methods for fetching, storing and reading the output of the Flickr API
+// reflection methods and for generating typed Go bindings from that output.
+package reflection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+// Flag is an integer value that can be unmarshaled from either a JSON number or a JSON string since the
+// Flickr API is not always consistent about how numeric flags are encoded.
+type Flag int
+
+// UnmarshalJSON unmarshals a JSON number or string in to a Flag instance.
+func (f *Flag) UnmarshalJSON(b []byte) error {
+
+	var v any
+
+	err := json.Unmarshal(b, &v)
+
+	if err != nil {
+		return err
+	}
+
+	switch v.(type) {
+	case float64:
+		*f = Flag(int(v.(float64)))
+	case string:
+
+		i, err := strconv.Atoi(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("Invalid flag value '%s', %w", v.(string), err)
+		}
+
+		*f = Flag(i)
+	case nil:
+		*f = 0
+	default:
+		return fmt.Errorf("Invalid flag value '%v'", v)
+	}
+
+	return nil
+}
+
+// Content is a struct that maps to the "_content" property used by the Flickr API for text values in JSON responses.
+type Content struct {
+	// The text value.
+	Value string `json:"_content"`
+}
+
+// Snapshot is a struct containing the output of the flickr.reflection.getMethodInfo API method for zero or more methods.
+type Snapshot struct {
+	// The list of MethodInfo instances, sorted by method name.
+	Methods []*MethodInfo `json:"methods"`
+}
+
+// MethodInfo is a struct that maps to the output of the flickr.reflection.getMethodInfo API method.
+type MethodInfo struct {
+	// The Method instance that maps to the "method" element in the API response.
+	Method *Method `json:"method"`
+	// The Arguments instance that maps to the "arguments" element in the API response.
+	Arguments *Arguments `json:"arguments,omitempty"`
+	// The Errors instance that maps to the "errors" element in the API response.
+	Errors *Errors `json:"errors,omitempty"`
+}
+
+// Method is a struct that maps to the "method" element in a flickr.reflection.getMethodInfo API response.
+type Method struct {
+	// The name of the API method, for example "flickr.photos.getInfo".
+	Name string `json:"name"`
+	// A flag (1 or 0) indicating whether the method requires an authenticated user.
+	NeedsLogin Flag `json:"needslogin"`
+	// A flag (1 or 0) indicating whether the method requires a signed request.
+	NeedsSigning Flag `json:"needssigning"`
+	// The permissions required to call the method: 0 (none), 1 (read), 2 (write) or 3 (delete).
+	RequiredPerms Flag `json:"requiredperms"`
+	// A description of the method, which may contain HTML markup.
+	Description *Content `json:"description,omitempty"`
+}
+
+// Arguments is a struct that maps to the "arguments" element in a flickr.reflection.getMethodInfo API response.
+type Arguments struct {
+	// The list of arguments for a method.
+	Argument []*Argument `json:"argument"`
+}
+
+// Argument is a struct that maps to the "argument" elements in a flickr.reflection.getMethodInfo API response.
+type Argument struct {
+	// The name of the argument.
+	Name string `json:"name"`
+	// A flag (1 or 0) indicating whether the argument is optional.
+	Optional Flag `json:"optional"`
+	// A description of the argument, which may contain HTML markup.
+	Description string `json:"_content"`
+}
+
+// Errors is a struct that maps to the "errors" element in a flickr.reflection.getMethodInfo API response.
+type Errors struct {
+	// The list of method-specific errors.
+	Error []*Error `json:"error"`
+}
+
+// Error is a struct that maps to the "error" elements in a flickr.reflection.getMethodInfo API response.
+type Error struct {
+	// The numeric code for the error.
+	Code Flag `json:"code"`
+	// The message associated with the error.
+	Message string `json:"message"`
+	// A description of the error, which may contain HTML markup.
+	Description string `json:"_content"`
+}
+
+// ReadSnapshot unmarshals a JSON-encoded Snapshot from 'r'. Methods are sorted by name.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+
+	var s *Snapshot
+
+	dec := json.NewDecoder(r)
+	err := dec.Decode(&s)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode snapshot, %w", err)
+	}
+
+	for i, m := range s.Methods {
+
+		if m.Method == nil || m.Method.Name == "" {
+			return nil, fmt.Errorf("Method at offset %d is missing a name", i)
+		}
+	}
+
+	s.sort()
+	return s, nil
+}
+
+// WriteSnapshot writes a JSON-encoded representation of 's' to 'wr'. The output is deterministic for a given Snapshot.
+func WriteSnapshot(wr io.Writer, s *Snapshot) error {
+
+	s.sort()
+
+	enc := json.NewEncoder(wr)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+
+	return enc.Encode(s)
+}
+
+// FetchSnapshot calls the flickr.reflection.getMethods API method, using 'cl', and then calls the
+// flickr.reflection.getMethodInfo for each method returned, returning the results as a Snapshot instance.
+func FetchSnapshot(ctx context.Context, cl client.Client) (*Snapshot, error) {
+
+	args := &url.Values{}
+	args.Set("method", "flickr.reflection.getMethods")
+
+	body, err := executeMethod(ctx, cl, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve methods, %w", err)
+	}
+
+	methods_rsp := gjson.GetBytes(body, "methods.method.#._content")
+
+	if !methods_rsp.Exists() {
+		return nil, fmt.Errorf("Failed to derive methods from response")
+	}
+
+	s := &Snapshot{
+		Methods: make([]*MethodInfo, 0),
+	}
+
+	for _, m := range methods_rsp.Array() {
+
+		args := &url.Values{}
+		args.Set("method", "flickr.reflection.getMethodInfo")
+		args.Set("method_name", m.String())
+
+		body, err := executeMethod(ctx, cl, args)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve method info for %s, %w", m.String(), err)
+		}
+
+		var info *MethodInfo
+
+		err = json.Unmarshal(body, &info)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to unmarshal method info for %s, %w", m.String(), err)
+		}
+
+		s.Methods = append(s.Methods, info)
+	}
+
+	s.sort()
+	return s, nil
+}
+
+func (s *Snapshot) sort() {
+
+	sort.Slice(s.Methods, func(i, j int) bool {
+		return s.Methods[i].Method.Name < s.Methods[j].Method.Name
+	})
+}
+
+func executeMethod(ctx context.Context, cl client.Client, args *url.Values) ([]byte, error) {
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = response.CheckMethodStatusBytes(body, args.Get("method"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
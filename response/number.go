@@ -0,0 +1,55 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Number is an integer value that can be unmarshaled from either a JSON number or a JSON string since the
+// Flickr API is not always consistent about how numeric properties are encoded. Empty strings and null values
+// are unmarshaled as 0.
+type Number int64
+
+// UnmarshalJSON unmarshals a JSON number or string in to a Number instance.
+func (n *Number) UnmarshalJSON(b []byte) error {
+
+	var v any
+
+	err := json.Unmarshal(b, &v)
+
+	if err != nil {
+		return err
+	}
+
+	switch v.(type) {
+	case float64:
+		*n = Number(int64(v.(float64)))
+	case string:
+
+		if v.(string) == "" {
+			*n = 0
+			return nil
+		}
+
+		i, err := strconv.ParseInt(v.(string), 10, 64)
+
+		if err != nil {
+			return fmt.Errorf("Invalid number '%s', %w", v.(string), err)
+		}
+
+		*n = Number(i)
+	case nil:
+		*n = 0
+	default:
+		return fmt.Errorf("Invalid number '%v'", v)
+	}
+
+	return nil
+}
+
+// Content is a struct that maps to the "_content" property used by the Flickr API for text values in JSON responses.
+type Content struct {
+	// The text value.
+	Value string `json:"_content"`
+}
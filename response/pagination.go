@@ -29,6 +29,13 @@ func DerivePagination(ctx context.Context, fh io.ReadSeekCloser) (*Pagination, e
 		return nil, err
 	}
 
+	return DerivePaginationBytes(body)
+}
+
+// DerivePaginationBytes tries to derive pagination metrics from the body of an API response. Numeric properties may be
+// encoded as either JSON numbers or strings. If a response does not have a "perpage" property then "per_page" is used.
+func DerivePaginationBytes(body []byte) (*Pagination, error) {
+
 	page_rsp := gjson.GetBytes(body, "*.page")
 
 	if !page_rsp.Exists() {
@@ -43,6 +50,10 @@ func DerivePagination(ctx context.Context, fh io.ReadSeekCloser) (*Pagination, e
 
 	perpage_rsp := gjson.GetBytes(body, "*.perpage")
 
+	if !perpage_rsp.Exists() {
+		perpage_rsp = gjson.GetBytes(body, "*.per_page")
+	}
+
 	if !perpage_rsp.Exists() {
 		return nil, fmt.Errorf("Unable to determine pagination properties (perpage) in response")
 	}
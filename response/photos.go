@@ -0,0 +1,147 @@
+package response
+
+// Photos is a struct that maps to the "photos" element in a Flickr API "standard photo list" response, for example
+// the response for the flickr.photos.search or flickr.people.getPhotos methods.
+type Photos struct {
+	// The current page of results.
+	Page Number `json:"page"`
+	// The total number of pages of results.
+	Pages Number `json:"pages"`
+	// The number of results per page.
+	PerPage Number `json:"perpage"`
+	// The total number of results, across all pages.
+	Total Number `json:"total"`
+	// An array of Photo instances that map to the "photo" array in the API response.
+	Photo []*Photo `json:"photo"`
+}
+
+// Photo is a struct that maps to the elements of the "photo" array in a Flickr API "standard photo list" response.
+// Properties for "extras" are only populated if they were requested and only the commonly used extras are included.
+type Photo struct {
+	// The Flickr photo ID.
+	Id string `json:"id"`
+	// The NSID of the user who owns the photo.
+	Owner string `json:"owner,omitempty"`
+	// The secret used to derive the URLs for the photo.
+	Secret string `json:"secret"`
+	// The server ID used to derive the URLs for the photo.
+	Server string `json:"server"`
+	// The farm ID used to derive the URLs for the photo.
+	Farm Number `json:"farm"`
+	// The title of the photo.
+	Title string `json:"title"`
+	// A numeric flag (1 or 0) indicating whether the photo is public.
+	IsPublic Number `json:"ispublic"`
+	// A numeric flag (1 or 0) indicating whether the photo is visible to friends.
+	IsFriend Number `json:"isfriend"`
+	// A numeric flag (1 or 0) indicating whether the photo is visible to family.
+	IsFamily Number `json:"isfamily"`
+	// A numeric flag (1 or 0) indicating whether the photo is the primary photo of a photoset. Only included in photoset responses.
+	IsPrimary Number `json:"isprimary,omitempty"`
+	// The date the photo was uploaded (Unix timestamp). Requires the "date_upload" extra.
+	DateUpload string `json:"dateupload,omitempty"`
+	// The date the photo was taken. Requires the "date_taken" extra.
+	DateTaken string `json:"datetaken,omitempty"`
+	// The date the photo was last updated (Unix timestamp). Requires the "last_update" extra.
+	LastUpdate string `json:"lastupdate,omitempty"`
+	// The original format (extension) of the photo. Requires the "original_format" extra.
+	OriginalFormat string `json:"originalformat,omitempty"`
+	// The media type of the photo, "photo" or "video". Requires the "media" extra.
+	Media string `json:"media,omitempty"`
+	// A space-separated list of the photo's tags. Requires the "tags" extra.
+	Tags string `json:"tags,omitempty"`
+	// A space-separated list of the photo's machine tags. Requires the "machine_tags" extra.
+	MachineTags string `json:"machine_tags,omitempty"`
+}
+
+// PhotoInfo is a struct that maps to the "photo" element in a Flickr API flickr.photos.getInfo method response.
+type PhotoInfo struct {
+	// The Flickr photo ID.
+	Id string `json:"id"`
+	// The secret used to derive the URLs for the photo.
+	Secret string `json:"secret"`
+	// The server ID used to derive the URLs for the photo.
+	Server string `json:"server"`
+	// The farm ID used to derive the URLs for the photo.
+	Farm Number `json:"farm"`
+	// The date the photo was uploaded (Unix timestamp).
+	DateUploaded string `json:"dateuploaded"`
+	// The ID of the photo's license.
+	License string `json:"license"`
+	// The rotation, in degrees, applied to the photo.
+	Rotation Number `json:"rotation"`
+	// The secret used to derive the URL for the original photo. Only included if the caller has permission to see it.
+	OriginalSecret string `json:"originalsecret,omitempty"`
+	// The original format (extension) of the photo. Only included if the caller has permission to see it.
+	OriginalFormat string `json:"originalformat,omitempty"`
+	// The media type of the photo, "photo" or "video".
+	Media string `json:"media"`
+	// A PhotoOwner instance that maps to the "owner" element in the API response.
+	Owner *PhotoOwner `json:"owner"`
+	// The title of the photo.
+	Title *Content `json:"title"`
+	// The description of the photo.
+	Description *Content `json:"description"`
+	// A PhotoVisibility instance that maps to the "visibility" element in the API response.
+	Visibility *PhotoVisibility `json:"visibility"`
+	// A PhotoDates instance that maps to the "dates" element in the API response.
+	Dates *PhotoDates `json:"dates"`
+	// The number of times the photo has been viewed.
+	Views Number `json:"views"`
+	// A PhotoTags instance that maps to the "tags" element in the API response.
+	Tags *PhotoTags `json:"tags"`
+}
+
+// PhotoOwner is a struct that maps to the "owner" element in a Flickr API flickr.photos.getInfo method response.
+type PhotoOwner struct {
+	// The NSID of the user who owns the photo.
+	NSID string `json:"nsid"`
+	// The username of the user who owns the photo.
+	Username string `json:"username"`
+	// The real name of the user who owns the photo.
+	RealName string `json:"realname"`
+	// The path alias of the user who owns the photo.
+	PathAlias string `json:"path_alias"`
+}
+
+// PhotoVisibility is a struct that maps to the "visibility" element in a Flickr API flickr.photos.getInfo method response.
+type PhotoVisibility struct {
+	// A numeric flag (1 or 0) indicating whether the photo is public.
+	IsPublic Number `json:"ispublic"`
+	// A numeric flag (1 or 0) indicating whether the photo is visible to friends.
+	IsFriend Number `json:"isfriend"`
+	// A numeric flag (1 or 0) indicating whether the photo is visible to family.
+	IsFamily Number `json:"isfamily"`
+}
+
+// PhotoDates is a struct that maps to the "dates" element in a Flickr API flickr.photos.getInfo method response.
+type PhotoDates struct {
+	// The date the photo was posted (Unix timestamp).
+	Posted string `json:"posted"`
+	// The date the photo was taken.
+	Taken string `json:"taken"`
+	// The granularity of the date the photo was taken.
+	TakenGranularity Number `json:"takengranularity"`
+	// The date the photo was last updated (Unix timestamp).
+	LastUpdate string `json:"lastupdate"`
+}
+
+// PhotoTags is a struct that maps to the "tags" element in a Flickr API flickr.photos.getInfo method response.
+type PhotoTags struct {
+	// An array of PhotoTag instances that map to the "tag" array in the API response.
+	Tag []*PhotoTag `json:"tag"`
+}
+
+// PhotoTag is a struct that maps to the elements of the "tag" array in a Flickr API flickr.photos.getInfo method response.
+type PhotoTag struct {
+	// The ID of the tag, used to remove it with the flickr.photos.removeTag method.
+	Id string `json:"id"`
+	// The NSID of the user who added the tag.
+	Author string `json:"author"`
+	// The tag as it was entered by the user.
+	Raw string `json:"raw"`
+	// The normalized tag.
+	Value string `json:"_content"`
+	// A numeric flag (1 or 0) indicating whether the tag is a machine tag.
+	MachineTag Number `json:"machine_tag"`
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalPhotos(t *testing.T) {
+
+	// Numeric properties are not consistently encoded as numbers by the Flickr API
+
+	body := `{"photos":{"page":1,"pages":"2","perpage":100,"total":"101","photo":[{"id":"123","owner":"1@N01","secret":"abc","server":"65535","farm":66,"title":"Hello","ispublic":1,"isfriend":0,"isfamily":"0"}]},"stat":"ok"}`
+
+	var rsp struct {
+		Photos *Photos `json:"photos"`
+	}
+
+	err := json.Unmarshal([]byte(body), &rsp)
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal photos, %v", err)
+	}
+
+	if rsp.Photos.Pages != 2 || rsp.Photos.Total != 101 || len(rsp.Photos.Photo) != 1 {
+		t.Fatalf("Unexpected pagination, %v", rsp.Photos)
+	}
+
+	ph := rsp.Photos.Photo[0]
+
+	if ph.Id != "123" || ph.Farm != 66 || ph.IsPublic != 1 || ph.IsFamily != 0 {
+		t.Fatalf("Unexpected photo, %v", ph)
+	}
+
+	var n Number
+
+	err = json.Unmarshal([]byte(`"abc"`), &n)
+
+	if err == nil {
+		t.Fatalf("Expected invalid number to fail")
+	}
+}
@@ -0,0 +1,73 @@
+package response
+
+// Photosets is a struct that maps to the "photosets" element in a Flickr API flickr.photosets.getList method response.
+type Photosets struct {
+	// The current page of results.
+	Page Number `json:"page"`
+	// The total number of pages of results.
+	Pages Number `json:"pages"`
+	// The number of results per page.
+	PerPage Number `json:"perpage"`
+	// The total number of results, across all pages.
+	Total Number `json:"total"`
+	// An array of Photoset instances that map to the "photoset" array in the API response.
+	Photoset []*Photoset `json:"photoset"`
+}
+
+// Photoset is a struct that maps to the "photoset" element in a Flickr API flickr.photosets.getInfo or
+// flickr.photosets.create method response or the elements of the "photoset" array in a flickr.photosets.getList
+// method response. The flickr.photosets.create method only returns the Id and URL properties.
+type Photoset struct {
+	// The Flickr photoset ID.
+	Id string `json:"id"`
+	// The URL of the photoset. Only included in flickr.photosets.create responses.
+	URL string `json:"url,omitempty"`
+	// The NSID of the user who owns the photoset.
+	Owner string `json:"owner,omitempty"`
+	// The username of the user who owns the photoset.
+	Username string `json:"username,omitempty"`
+	// The ID of the photoset's primary photo.
+	Primary string `json:"primary,omitempty"`
+	// The secret used to derive the URLs for the primary photo.
+	Secret string `json:"secret,omitempty"`
+	// The server ID used to derive the URLs for the primary photo.
+	Server string `json:"server,omitempty"`
+	// The farm ID used to derive the URLs for the primary photo.
+	Farm Number `json:"farm,omitempty"`
+	// The number of photos in the photoset.
+	Photos Number `json:"photos,omitempty"`
+	// The number of videos in the photoset.
+	Videos Number `json:"videos,omitempty"`
+	// The title of the photoset.
+	Title *Content `json:"title,omitempty"`
+	// The description of the photoset.
+	Description *Content `json:"description,omitempty"`
+	// The date the photoset was created (Unix timestamp).
+	DateCreate string `json:"date_create,omitempty"`
+	// The date the photoset was last updated (Unix timestamp).
+	DateUpdate string `json:"date_update,omitempty"`
+}
+
+// PhotosetPhotos is a struct that maps to the "photoset" element in a Flickr API flickr.photosets.getPhotos method response.
+type PhotosetPhotos struct {
+	// The Flickr photoset ID.
+	Id string `json:"id"`
+	// The ID of the photoset's primary photo.
+	Primary string `json:"primary"`
+	// The NSID of the user who owns the photoset.
+	Owner string `json:"owner"`
+	// The username of the user who owns the photoset.
+	OwnerName string `json:"ownername"`
+	// The title of the photoset.
+	Title string `json:"title"`
+	// The current page of results.
+	Page Number `json:"page"`
+	// The total number of pages of results.
+	Pages Number `json:"pages"`
+	// The number of results per page.
+	PerPage Number `json:"perpage"`
+	// The total number of results, across all pages.
+	Total Number `json:"total"`
+	// An array of Photo instances that map to the "photo" array in the API response.
+	Photo []*Photo `json:"photo"`
+}
@@ -5,18 +5,104 @@
 package response
 
 import (
-	_ "encoding/json"
+	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
 )
 
+// ErrInvalidAuth is a sentinel error for API requests that failed because of invalid or missing credentials, signatures or API keys.
+var ErrInvalidAuth = errors.New("Invalid authentication")
+
+// ErrNotFound is a sentinel error for API requests that failed because the thing being requested does not exist.
+var ErrNotFound = errors.New("Not found")
+
+// ErrPermissionDenied is a sentinel error for API requests that failed because of insufficient permissions.
+var ErrPermissionDenied = errors.New("Permission denied")
+
+// ErrRateLimited is a sentinel error for API requests that failed because too many requests have been made.
+var ErrRateLimited = errors.New("Rate limited")
+
+// not_found_codes maps API methods to their documented (method-specific) error codes for things that do not exist.
+// https://www.flickr.com/services/api/
+var not_found_codes = map[string][]int{
+	"flickr.favorites.getList":          {1},
+	"flickr.groups.pools.add":           {1, 2},
+	"flickr.groups.pools.getPhotos":     {1},
+	"flickr.people.findByUsername":      {1},
+	"flickr.people.getInfo":             {1},
+	"flickr.people.getPhotos":           {1},
+	"flickr.photos.addTags":             {1},
+	"flickr.photos.comments.getList":    {1},
+	"flickr.photos.delete":              {1},
+	"flickr.photos.geo.getLocation":     {1},
+	"flickr.photos.geo.setLocation":     {1},
+	"flickr.photos.getExif":             {1},
+	"flickr.photos.getInfo":             {1},
+	"flickr.photos.getSizes":            {1},
+	"flickr.photos.licenses.setLicense": {1, 2},
+	"flickr.photos.removeTag":           {1, 2},
+	"flickr.photos.setContentType":      {1},
+	"flickr.photos.setDates":            {1},
+	"flickr.photos.setMeta":             {1},
+	"flickr.photos.setPerms":            {1},
+	"flickr.photos.setSafetyLevel":      {1},
+	"flickr.photos.setTags":             {1},
+	"flickr.photosets.addPhoto":         {1, 2},
+	"flickr.photosets.create":           {2},
+	"flickr.photosets.delete":           {1},
+	"flickr.photosets.getInfo":          {1},
+	"flickr.photosets.getList":          {1},
+	"flickr.photosets.getPhotos":        {1},
+	"flickr.photosets.removePhoto":      {1, 2},
+	"flickr.photosets.reorderPhotos":    {1},
+	"flickr.reflection.getMethodInfo":   {1},
+}
+
+// permission_denied_codes maps API methods to their documented (method-specific) error codes for insufficient permissions.
+var permission_denied_codes = map[string][]int{
+	"flickr.photos.getExif": {2},
+}
+
+// ErrServiceUnavailable is a sentinel error for API requests that failed because the Flickr API is (temporarily) unavailable.
+var ErrServiceUnavailable = errors.New("Service unavailable")
+
 // Error is a struct containing information about a failed API request.
 type Error struct {
 	// The numeric code for the error.
 	Code int `xml:"code,attr" json:"code"`
 	// The message associated with the error.
 	Message string `xml:"msg,attr" json:"msg"`
+	// The name of the API method that returned the error, if known. Method-specific error codes are only matched if it is present.
+	Method string `xml:"-" json:"-"`
+}
+
+// UnmarshalJSON decodes a JSON-encoded Flickr API error. The Flickr API uses a "message" property for the error
+// message in JSON responses, rather than the "msg" attribute used in XML responses, so both are accepted.
+func (e *Error) UnmarshalJSON(b []byte) error {
+
+	var raw struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Msg     string `json:"msg"`
+	}
+
+	err := json.Unmarshal(b, &raw)
+
+	if err != nil {
+		return err
+	}
+
+	e.Code = raw.Code
+	e.Message = raw.Message
+
+	if e.Message == "" {
+		e.Message = raw.Msg
+	}
+
+	return nil
 }
 
 // Return a Flickr API error as a string containing both the error code and message.
@@ -24,6 +110,38 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%d %s", e.Code, e.Message)
 }
 
+// Is reports whether the Error matches 'target' so that it can be used with errors.Is. Errors match
+// the ErrInvalidAuth, ErrPermissionDenied and ErrServiceUnavailable sentinel values using the Flickr API's standard
+// error codes and the ErrNotFound and ErrPermissionDenied sentinel values using the documented error codes for the
+// method that returned the error, if known. The Flickr API does not define an error code for rate limiting so
+// ErrRateLimited is only matched by HTTP 429 Too Many Requests responses (see client.StatusError).
+// Errors also match other *Error instances with the same code.
+// https://www.flickr.com/services/api/response.rest.html
+func (e *Error) Is(target error) bool {
+
+	switch target {
+	case ErrInvalidAuth:
+		// 96 Invalid signature, 97 Missing signature, 98 Login failed / Invalid auth token, 100 Invalid API Key
+		return e.Code == 96 || e.Code == 97 || e.Code == 98 || e.Code == 100
+	case ErrPermissionDenied:
+		// 99 User not logged in / Insufficient permissions
+		return e.Code == 99 || slices.Contains(permission_denied_codes[e.Method], e.Code)
+	case ErrNotFound:
+		return slices.Contains(not_found_codes[e.Method], e.Code)
+	case ErrServiceUnavailable:
+		// 105 Service currently unavailable
+		return e.Code == 105
+	}
+
+	other, ok := target.(*Error)
+
+	if ok {
+		return other.Code == e.Code
+	}
+
+	return false
+}
+
 // Response is a struct containing only minimal information about an API request, notably it's Status and optionally an Error associated with the request.
 type Response struct {
 	XMLName xml.Name `xml:"rsp" json:"-"`
@@ -1,6 +1,8 @@
 package response
 
 import (
+	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -40,3 +42,28 @@ func TestUnmarshalErrorResponse(t *testing.T) {
 		t.Fatal("Failed to parse error")
 	}
 }
+
+func TestUnmarshalJSONError(t *testing.T) {
+
+	tests := map[string]error{
+		`{"code":1,"message":"Photo \"123\" not found (invalid ID)"}`: ErrNotFound,
+		`{"code":1,"msg":"Photo \"123\" not found (invalid ID)"}`:     ErrNotFound,
+	}
+
+	for body, expected := range tests {
+
+		var e *Error
+
+		err := json.Unmarshal([]byte(body), &e)
+
+		if err != nil {
+			t.Fatalf("Failed to unmarshal %s, %v", body, err)
+		}
+
+		e.Method = "flickr.photos.getInfo"
+
+		if e.Message == "" || !errors.Is(e, expected) {
+			t.Fatalf("Expected %s to match %v", body, expected)
+		}
+	}
+}
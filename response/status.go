@@ -0,0 +1,131 @@
+package response
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tidwall/gjson"
+)
+
+// The status label for successful Flickr API responses.
+const STATUS_OK string = "ok"
+
+// CheckStatus inspects the body of a Flickr API response, encoded as either JSON or XML, and returns an *Error
+// instance if the response does not have an "ok" status. 'fh' is rewound to the beginning before returning.
+func CheckStatus(fh io.ReadSeeker) error {
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return fmt.Errorf("Failed to read response, %w", err)
+	}
+
+	_, err = fh.Seek(0, 0)
+
+	if err != nil {
+		return fmt.Errorf("Failed to rewind response, %w", err)
+	}
+
+	return CheckStatusBytes(body)
+}
+
+// CheckMethodStatus is identical to CheckStatus but assigns 'method', the name of the API method that returned the
+// response, to any *Error instance that is returned so that method-specific error codes can be matched using errors.Is.
+func CheckMethodStatus(fh io.ReadSeeker, method string) error {
+	return withMethod(CheckStatus(fh), method)
+}
+
+// CheckMethodStatusBytes is identical to CheckStatusBytes but assigns 'method', the name of the API method that returned
+// the response, to any *Error instance that is returned so that method-specific error codes can be matched using errors.Is.
+func CheckMethodStatusBytes(body []byte, method string) error {
+	return withMethod(CheckStatusBytes(body), method)
+}
+
+// withMethod assigns 'method' to 'err' if it is an *Error instance.
+func withMethod(err error, method string) error {
+
+	var api_err *Error
+
+	if errors.As(err, &api_err) {
+		api_err.Method = method
+	}
+
+	return err
+}
+
+// CheckStatusBytes inspects the body of a Flickr API response, encoded as either JSON or XML, and returns an *Error
+// instance if the response does not have an "ok" status. Responses in other formats are not inspected and return nil.
+func CheckStatusBytes(body []byte) error {
+
+	body = bytes.TrimSpace(body)
+
+	if len(body) == 0 {
+		return fmt.Errorf("Empty response")
+	}
+
+	// Account for JSON responses wrapped in the default jsonFlickrApi() callback function.
+
+	body = bytes.TrimPrefix(body, []byte("jsonFlickrApi("))
+	body = bytes.TrimSuffix(body, []byte(")"))
+
+	switch body[0] {
+	case '{':
+		return checkJSONStatus(body)
+	case '<':
+		return checkXMLStatus(body)
+	default:
+		return nil
+	}
+}
+
+// checkJSONStatus inspects a JSON-encoded API response, where failures take the form of:
+// {"stat":"fail","code":98,"message":"Invalid auth token"}
+func checkJSONStatus(body []byte) error {
+
+	if !gjson.ValidBytes(body) {
+		return fmt.Errorf("Failed to parse response, invalid JSON")
+	}
+
+	stat_rsp := gjson.GetBytes(body, "stat")
+
+	if !stat_rsp.Exists() {
+		return fmt.Errorf("Failed to parse response, missing stat property")
+	}
+
+	if stat_rsp.String() == STATUS_OK {
+		return nil
+	}
+
+	e := &Error{
+		Code:    int(gjson.GetBytes(body, "code").Int()),
+		Message: gjson.GetBytes(body, "message").String(),
+	}
+
+	return e
+}
+
+// checkXMLStatus inspects a XML-encoded API response, where failures take the form of:
+// <rsp stat="fail"><err code="98" msg="Invalid auth token" /></rsp>
+func checkXMLStatus(body []byte) error {
+
+	var rsp *Response
+
+	err := xml.Unmarshal(body, &rsp)
+
+	if err != nil {
+		return fmt.Errorf("Failed to parse response, %w", err)
+	}
+
+	if rsp.Status == STATUS_OK {
+		return nil
+	}
+
+	if rsp.Error == nil {
+		return &Error{Message: fmt.Sprintf("API call failed with status '%s'", rsp.Status)}
+	}
+
+	return rsp.Error
+}
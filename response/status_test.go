@@ -0,0 +1,98 @@
+package response
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCheckStatus(t *testing.T) {
+
+	ok := []string{
+		`{"stat":"ok"}`,
+		`jsonFlickrApi({"stat":"ok"})`,
+		`<?xml version="1.0" encoding="utf-8" ?><rsp stat="ok"></rsp>`,
+	}
+
+	for _, str := range ok {
+
+		fh := strings.NewReader(str)
+		err := CheckStatus(fh)
+
+		if err != nil {
+			t.Fatalf("Unexpected error for '%s', %v", str, err)
+		}
+
+		body, _ := io.ReadAll(fh)
+
+		if string(body) != str {
+			t.Fatalf("Failed to rewind response for '%s'", str)
+		}
+	}
+
+	fail := map[string]error{
+		`{"stat":"fail","code":98,"message":"Invalid auth token"}`:                                                 ErrInvalidAuth,
+		`jsonFlickrApi({"stat":"fail","code":1,"message":"Photo \"123\" not found (invalid ID)"})`:                 ErrNotFound,
+		`{"stat":"fail","code":99,"message":"Insufficient permissions. Method requires write privileges"}`:         ErrPermissionDenied,
+		`{"stat":"fail","code":105,"message":"Service currently unavailable"}`:                                     ErrServiceUnavailable,
+		`<?xml version="1.0" encoding="utf-8" ?><rsp stat="fail"><err code="98" msg="Invalid auth token" /></rsp>`: ErrInvalidAuth,
+	}
+
+	for str, expected := range fail {
+
+		err := CheckMethodStatus(strings.NewReader(str), "flickr.photos.getInfo")
+
+		if err == nil {
+			t.Fatalf("Expected error for '%s'", str)
+		}
+
+		var api_err *Error
+
+		if !errors.As(err, &api_err) {
+			t.Fatalf("Expected *Error for '%s', %T", str, err)
+		}
+
+		if !errors.Is(err, expected) {
+			t.Fatalf("Expected '%s' to match '%v'", str, expected)
+		}
+
+		if errors.Is(err, ErrRateLimited) {
+			t.Fatalf("Did not expect '%s' to match '%v'", str, ErrRateLimited)
+		}
+	}
+
+	err := CheckStatus(strings.NewReader(`{"stat":"fail","code":98,"message":"Invalid auth token"}`))
+
+	if !errors.Is(err, &Error{Code: 98}) {
+		t.Fatalf("Expected error to match code 98")
+	}
+
+	// Method-specific error codes are only matched if the method is known and has documented the code, regardless of the message
+
+	not_found := `{"stat":"fail","code":1,"message":"Photo not found"}`
+
+	err = CheckStatusBytes([]byte(not_found))
+
+	if errors.Is(err, ErrNotFound) {
+		t.Fatalf("Did not expect error without method to match '%v'", ErrNotFound)
+	}
+
+	err = CheckMethodStatusBytes([]byte(not_found), "flickr.photosets.create")
+
+	if errors.Is(err, ErrNotFound) {
+		t.Fatalf("Did not expect code 1 for flickr.photosets.create to match '%v'", ErrNotFound)
+	}
+
+	err = CheckMethodStatusBytes([]byte(`{"stat":"fail","code":2,"message":"Permission denied"}`), "flickr.photos.getExif")
+
+	if !errors.Is(err, ErrPermissionDenied) || errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected code 2 for flickr.photos.getExif to match '%v'", ErrPermissionDenied)
+	}
+
+	err = CheckMethodStatusBytes([]byte(`{"stat":"fail","code":3,"message":"Rate limit exceeded"}`), "flickr.photos.getInfo")
+
+	if errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Did not expect API error to match '%v'", ErrRateLimited)
+	}
+}
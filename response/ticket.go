@@ -2,7 +2,10 @@ package response
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"strconv"
+	"time"
 )
 
 // CheckTicket is a struct that maps to the Flickr API flickr.photos.upload.checkTickets
@@ -24,14 +27,28 @@ type Uploader struct {
 type UploaderTicket struct {
 	// A Flickr API upload ticket ID.
 	TicketId string `json:"id"`
-	// A numeric flag (1 or 0) indicating whether an upload ticket has been completed.
+	// A numeric flag indicating whether an upload ticket has been completed (1), is still being processed (0) or has failed (2).
 	Complete int `json:"complete"`
+	// A numeric flag (1 or 0) indicating whether the upload ticket ID is invalid (not found).
+	Invalid int `json:"invalid,omitempty"`
 	// The Flickr photo ID for a successful upload. Note that this is encoded (by the Flickr API) a string.
 	PhotoId string `json:"photoid"`
 	// The creation time (Unix timestamp) for a successful upload. Note that this is encoded (by the Flickr API) a string.
 	Imported string `json:"imported"`
 }
 
+// ImportedTime returns the Imported (Unix timestamp) property as a time.Time instance.
+func (t *UploaderTicket) ImportedTime() (time.Time, error) {
+
+	ts, err := strconv.ParseInt(t.Imported, 10, 64)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid imported time '%s', %w", t.Imported, err)
+	}
+
+	return time.Unix(ts, 0), nil
+}
+
 // Unmarshal Flickr API flickr.photos.upload method response, for asynchronous uploads, in to a UploadTicket instance.
 func UmarshalUploadTicketResponse(fh io.Reader) (*UploadTicket, error) {
 
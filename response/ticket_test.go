@@ -1,5 +1,53 @@
 package response
 
 import (
-	_ "testing"
+	"strings"
+	"testing"
 )
+
+func TestUnmarshalCheckTicketResponse(t *testing.T) {
+
+	rsp := `{"uploader":{"ticket":[{"id":"1","complete":1,"photoid":"51111590154","imported":"1620000000"},{"id":"2","complete":2},{"id":"3","invalid":1}]},"stat":"ok"}`
+
+	fh := strings.NewReader(rsp)
+
+	ct, err := UnmarshalCheckTicketResponse(fh)
+
+	if err != nil {
+		t.Fatalf("Failed to unmarshal check tickets response, %v", err)
+	}
+
+	if len(ct.Uploader.Tickets) != 3 {
+		t.Fatalf("Unexpected number of tickets, %d", len(ct.Uploader.Tickets))
+	}
+
+	complete := ct.Uploader.Tickets[0]
+
+	if complete.Complete != 1 || complete.PhotoId != "51111590154" {
+		t.Fatalf("Unexpected ticket, %v", complete)
+	}
+
+	imported, err := complete.ImportedTime()
+
+	if err != nil {
+		t.Fatalf("Failed to parse imported time, %v", err)
+	}
+
+	if imported.Unix() != 1620000000 {
+		t.Fatalf("Unexpected imported time, %v", imported)
+	}
+
+	if ct.Uploader.Tickets[1].Complete != 2 {
+		t.Fatalf("Expected failed ticket")
+	}
+
+	if ct.Uploader.Tickets[2].Invalid != 1 {
+		t.Fatalf("Expected invalid ticket")
+	}
+
+	_, err = ct.Uploader.Tickets[1].ImportedTime()
+
+	if err == nil {
+		t.Fatalf("Expected missing imported time to fail")
+	}
+}
@@ -0,0 +1,798 @@
+// package syncer provides methods for mirroring the files in a gocloud.dev/blob bucket (or prefix) to a Flickr photoset.
+// Files are matched to the photos in the photoset by the machine tag recording their SHA-256 hash (see uploader.HashMachineTag)
+// or, failing that, by their file name (minus its extension) and the title of the photo. A Plan listing every action needed to
+// bring the photoset in line with the bucket is derived first and can be inspected before it is applied.
+package syncer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/reader"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/aaronland/go-flickr-api/uploader"
+	"github.com/tidwall/gjson"
+)
+
+// The action for files whose photo is already up to date.
+const ACTION_UNCHANGED string = "unchanged"
+
+// The action for files which have no matching photo and will be uploaded and added to the photoset.
+const ACTION_UPLOAD string = "upload"
+
+// The action for files whose matching photo has different contents and will be replaced.
+const ACTION_REPLACE string = "replace"
+
+// The action for files whose matching photo has the same contents but no hash machine tag, which will be added.
+const ACTION_TAG string = "tag"
+
+// The action for photos which have no matching file and will be removed from the photoset.
+const ACTION_REMOVE string = "remove"
+
+// The action for photos which have no matching file and will be deleted.
+const ACTION_DELETE string = "delete"
+
+// The action for reordering the photos in the photoset to match the (sorted) order of the files.
+const ACTION_REORDER string = "reorder"
+
+// Photos were matched to files using the machine tag recording the file's SHA-256 hash.
+const MATCH_HASH string = "hash"
+
+// Photos were matched to files using the file's name (minus its extension) and the photo's title.
+const MATCH_TITLE string = "title"
+
+// Leave photos which have no matching file in the photoset.
+const REMOVED_IGNORE string = "ignore"
+
+// Remove photos which have no matching file from the photoset.
+const REMOVED_REMOVE string = "remove"
+
+// Delete photos which have no matching file.
+const REMOVED_DELETE string = "delete"
+
+// SyncAction is a struct containing an individual action in a Plan and, once applied, its result.
+type SyncAction struct {
+	// The name of the action, for example ACTION_UPLOAD.
+	Action string `json:"action"`
+	// The URI of the file the action applies to, if any.
+	Path string `json:"path,omitempty"`
+	// The ID of the photo the action applies to. For uploads this is the ID of the new photo once it has been uploaded.
+	PhotoId int64 `json:"photoid,omitempty"`
+	// The (hex-encoded) SHA-256 hash of the file the action applies to, if any.
+	Hash string `json:"sha256,omitempty"`
+	// How the file was matched to the photo, MATCH_HASH or MATCH_TITLE, for files with matching photos.
+	Match string `json:"match,omitempty"`
+	// The URIs of the files, in order, for ACTION_REORDER actions.
+	Paths []string `json:"paths,omitempty"`
+	// A SyncError instance if the action could not be applied.
+	Error *SyncError `json:"error,omitempty"`
+}
+
+// SyncError is a custom error type that can be JSON-serialized.
+type SyncError struct {
+	error
+}
+
+// The error message associated with this instance.
+func (e *SyncError) Error() string {
+	return e.error.Error()
+}
+
+// The error message associated with this instance.
+func (e *SyncError) String() string {
+	return e.Error()
+}
+
+// Return the underlying error so that SyncError instances can be used with errors.Is and errors.As.
+func (e *SyncError) Unwrap() error {
+	return e.error
+}
+
+// This error instance serialized as a string for JSON-marshaling.
+func (e *SyncError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Error())
+}
+
+// Plan is a struct containing the list of actions needed to mirror a bucket to a photoset.
+type Plan struct {
+	// The ID of the photoset being synced.
+	PhotosetId string `json:"photoset_id"`
+	// The actions to apply. There is an action for each file, in sorted order, followed by actions for photos without
+	// a matching file and finally an optional ACTION_REORDER action.
+	Actions []*SyncAction `json:"actions"`
+}
+
+// Changes returns the number of actions in the plan which are not ACTION_UNCHANGED actions.
+func (p *Plan) Changes() int {
+
+	count := 0
+
+	for _, a := range p.Actions {
+
+		if a.Action != ACTION_UNCHANGED {
+			count += 1
+		}
+	}
+
+	return count
+}
+
+// SyncerOptions is a struct containing configuration details for a new Syncer instance.
+type SyncerOptions struct {
+	// The Client used to call the Flickr API.
+	Client client.Client
+	// The number of files to upload or replace concurrently. If 0 uploader.DEFAULT_WORKERS is used.
+	Workers int
+	// Zero or more Flickr API parameters to include with each upload.
+	Args *url.Values
+	// What to do with photos in the photoset which have no matching file: REMOVED_IGNORE, REMOVED_REMOVE or REMOVED_DELETE.
+	// If empty REMOVED_IGNORE is used.
+	Removed string
+	// Optional uploader.InputOptions used to filter the files in the bucket.
+	InputOptions *uploader.InputOptions
+	// The maximum number of bytes per second to send, shared by all the workers. If 0 bandwidth is not limited.
+	MaxBandwidth int64
+	// An optional http.Client used to download the originals of photos which are matched to files by their title, but
+	// which have no hash machine tag, in order to compare their contents. If nil http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// Syncer mirrors the files in a gocloud.dev/blob bucket to a Flickr photoset.
+type Syncer struct {
+	client        client.Client
+	workers       int
+	args          *url.Values
+	removed       string
+	input_options *uploader.InputOptions
+	max_bandwidth int64
+	http_client   *http.Client
+}
+
+// NewSyncer returns a new Syncer instance configured by 'opts'.
+func NewSyncer(ctx context.Context, opts *SyncerOptions) (*Syncer, error) {
+
+	if opts.Client == nil {
+		return nil, fmt.Errorf("Missing client")
+	}
+
+	removed := opts.Removed
+
+	switch removed {
+	case "":
+		removed = REMOVED_IGNORE
+	case REMOVED_IGNORE, REMOVED_REMOVE, REMOVED_DELETE:
+		// pass
+	default:
+		return nil, fmt.Errorf("Invalid removed option '%s'", removed)
+	}
+
+	args := opts.Args
+
+	if args == nil {
+		args = &url.Values{}
+	}
+
+	http_client := opts.HTTPClient
+
+	if http_client == nil {
+		http_client = http.DefaultClient
+	}
+
+	s := &Syncer{
+		client:        opts.Client,
+		workers:       opts.Workers,
+		args:          args,
+		removed:       removed,
+		input_options: opts.InputOptions,
+		max_bandwidth: opts.MaxBandwidth,
+		http_client:   http_client,
+	}
+
+	return s, nil
+}
+
+// setPhoto is a struct containing the details of a photo in a photoset used to match it to a file.
+type setPhoto struct {
+	id       int64
+	title    string
+	hash     string
+	original string
+	matched  bool
+}
+
+// Plan returns the Plan for mirroring the files in the bucket 'uri' to the photoset 'photoset_id'. Files are matched to
+// photos with the same hash machine tag first and then, for any remaining files, to photos whose title is the same as the
+// file name minus its extension. Files matched by title are replaced if their photo has a different hash machine tag. If
+// the photo has no hash machine tag the file is compared with the photo's original and the photo is either replaced or, if
+// they are the same, just tagged with the file's hash. Unmatched files are uploaded and photos without a matching file are
+// handled according to the Syncer's removed option. If the order of
+// the photos in the photoset, once files have been uploaded, will not match the sorted order of the files the photoset
+// is reordered.
+func (s *Syncer) Plan(ctx context.Context, uri string, photoset_id string) (*Plan, error) {
+
+	if !strings.HasSuffix(uri, "/") {
+
+		u, err := url.Parse(uri)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse bucket URI, %w", err)
+		}
+
+		if u.Scheme != "" && !strings.HasSuffix(u.Path, "/") {
+			u.Path = u.Path + "/"
+			uri = u.String()
+		}
+	}
+
+	inputs, err := uploader.ExpandPaths(ctx, []string{uri}, s.input_options)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list files, %w", err)
+	}
+
+	slices.SortFunc(inputs, func(a *uploader.Input, b *uploader.Input) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	photos, err := s.photosetPhotos(ctx, photoset_id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	file_actions := make([]*SyncAction, len(inputs))
+
+	for i, in := range inputs {
+
+		hash, err := hashFile(ctx, in.Path)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to hash '%s', %w", in.Path, err)
+		}
+
+		file_actions[i] = &SyncAction{
+			Action: ACTION_UPLOAD,
+			Path:   in.Path,
+			Hash:   hash,
+		}
+	}
+
+	// Match by hash for all the files before matching by title so that a file whose title matches a photo
+	// doesn't claim the photo that an identical file has already been uploaded as.
+
+	for _, a := range file_actions {
+
+		for _, ph := range photos {
+
+			if !ph.matched && ph.hash == a.Hash {
+				ph.matched = true
+				a.Action = ACTION_UNCHANGED
+				a.PhotoId = ph.id
+				a.Match = MATCH_HASH
+				break
+			}
+		}
+	}
+
+	for _, a := range file_actions {
+
+		if a.Action != ACTION_UPLOAD {
+			continue
+		}
+
+		title := fileTitle(a.Path)
+
+		for _, ph := range photos {
+
+			if ph.matched || ph.title != title {
+				continue
+			}
+
+			ph.matched = true
+			a.Action = ACTION_REPLACE
+			a.PhotoId = ph.id
+			a.Match = MATCH_TITLE
+
+			// Photos without a hash machine tag may have been uploaded by some other means so rather than replacing
+			// them with an identical file compare the file with the photo's original.
+
+			if ph.hash == "" && ph.original != "" {
+
+				hash, err := s.hashOriginal(ctx, ph.original)
+
+				if err != nil {
+					return nil, fmt.Errorf("Failed to hash original for photo %d, %w", ph.id, err)
+				}
+
+				if hash == a.Hash {
+					a.Action = ACTION_TAG
+				}
+			}
+
+			break
+		}
+	}
+
+	plan := &Plan{
+		PhotosetId: photoset_id,
+		Actions:    file_actions,
+	}
+
+	if s.removed != REMOVED_IGNORE {
+
+		for _, ph := range photos {
+
+			if ph.matched {
+				continue
+			}
+
+			a := &SyncAction{
+				Action:  ACTION_REMOVE,
+				PhotoId: ph.id,
+			}
+
+			if s.removed == REMOVED_DELETE {
+				a.Action = ACTION_DELETE
+			}
+
+			plan.Actions = append(plan.Actions, a)
+		}
+	}
+
+	// Uploaded photos are added to the end of the photoset so the expected order is the order of the matched
+	// photos in the photoset followed by the uploaded files.
+
+	photo_paths := make(map[int64]string)
+	uploads := make([]string, 0)
+	paths := make([]string, 0)
+
+	for _, a := range file_actions {
+
+		paths = append(paths, a.Path)
+
+		if a.Action == ACTION_UPLOAD {
+			uploads = append(uploads, a.Path)
+		} else {
+			photo_paths[a.PhotoId] = a.Path
+		}
+	}
+
+	expected := make([]string, 0)
+
+	for _, ph := range photos {
+
+		p, exists := photo_paths[ph.id]
+
+		if exists {
+			expected = append(expected, p)
+		}
+	}
+
+	expected = append(expected, uploads...)
+
+	if !slices.Equal(expected, paths) {
+
+		plan.Actions = append(plan.Actions, &SyncAction{
+			Action: ACTION_REORDER,
+			Paths:  paths,
+		})
+	}
+
+	return plan, nil
+}
+
+// Apply returns an iterator that applies each of the actions in 'plan', except ACTION_UNCHANGED actions, and yields
+// each action with its result. Files are uploaded (and tagged with their hash) first, then replaced (updating their
+// hash machine tag), followed by photos being tagged with their hash, photos being removed or deleted and finally the
+// photoset being reordered. Failed
+// actions are reported in their result rather than stopping the remaining actions. Breaking out of the loop stops
+// any further actions.
+func (s *Syncer) Apply(ctx context.Context, plan *Plan) iter.Seq[*SyncAction] {
+
+	return func(yield func(*SyncAction) bool) {
+
+		photo_ids := make(map[string]int64)
+		by_path := make(map[string]*SyncAction)
+
+		uploads := make([]*uploader.Input, 0)
+		replacements := make([]*uploader.Input, 0)
+		others := make([]*SyncAction, 0)
+
+		for _, a := range plan.Actions {
+
+			a = copyAction(a)
+
+			switch a.Action {
+			case ACTION_UNCHANGED:
+				photo_ids[a.Path] = a.PhotoId
+			case ACTION_UPLOAD:
+
+				args := &url.Values{}
+				args.Set("tags", strings.TrimSpace(s.args.Get("tags")+" "+uploader.HashMachineTag(a.Hash)))
+
+				uploads = append(uploads, &uploader.Input{Path: a.Path, Args: args, PhotosetId: plan.PhotosetId})
+				by_path[a.Path] = a
+
+			case ACTION_REPLACE:
+
+				args := &url.Values{}
+				args.Set("photo_id", strconv.FormatInt(a.PhotoId, 10))
+
+				replacements = append(replacements, &uploader.Input{Path: a.Path, Args: args})
+				by_path[a.Path] = a
+				photo_ids[a.Path] = a.PhotoId
+
+			case ACTION_TAG:
+				photo_ids[a.Path] = a.PhotoId
+				others = append(others, a)
+
+			default:
+				others = append(others, a)
+			}
+		}
+
+		up, err := uploader.NewUploader(ctx, &uploader.UploaderOptions{
+			Client:       s.client,
+			Workers:      s.workers,
+			Args:         s.args,
+			MaxBandwidth: s.max_bandwidth,
+		})
+
+		if err != nil {
+			yield(&SyncAction{Error: &SyncError{fmt.Errorf("Failed to create uploader, %w", err)}})
+			return
+		}
+
+		if len(uploads) > 0 {
+
+			for rsp := range up.UploadInputs(ctx, uploads) {
+
+				a, exists := by_path[rsp.Path]
+
+				if !exists {
+					a = &SyncAction{Action: ACTION_UPLOAD}
+				}
+
+				a.PhotoId = rsp.PhotoId
+
+				switch {
+				case rsp.Error != nil:
+					a.Error = &SyncError{rsp.Error}
+				default:
+
+					photo_ids[a.Path] = rsp.PhotoId
+
+					for _, action_rsp := range rsp.Actions {
+
+						if action_rsp.Error != nil {
+							a.Error = &SyncError{fmt.Errorf("Failed to add photo to photoset, %w", action_rsp.Error)}
+						}
+					}
+				}
+
+				if !yield(a) {
+					return
+				}
+			}
+		}
+
+		if len(replacements) > 0 {
+
+			for rsp := range up.ReplaceInputs(ctx, replacements) {
+
+				a, exists := by_path[rsp.Path]
+
+				if !exists {
+					a = &SyncAction{Action: ACTION_REPLACE}
+				}
+
+				switch {
+				case rsp.Error != nil:
+					a.Error = &SyncError{rsp.Error}
+				default:
+
+					err := s.setHashTag(ctx, a.PhotoId, a.Hash)
+
+					if err != nil {
+						a.Error = &SyncError{fmt.Errorf("Failed to update hash machine tag, %w", err)}
+					}
+				}
+
+				if !yield(a) {
+					return
+				}
+			}
+		}
+
+		for _, a := range others {
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			switch a.Action {
+			case ACTION_TAG:
+
+				err := s.setHashTag(ctx, a.PhotoId, a.Hash)
+
+				if err != nil {
+					a.Error = &SyncError{fmt.Errorf("Failed to add hash machine tag, %w", err)}
+				}
+
+			case ACTION_REMOVE:
+
+				args := &url.Values{}
+				args.Set("method", "flickr.photosets.removePhoto")
+				args.Set("photoset_id", plan.PhotosetId)
+				args.Set("photo_id", strconv.FormatInt(a.PhotoId, 10))
+
+				_, err := s.executeMethod(ctx, args)
+
+				if err != nil {
+					a.Error = &SyncError{fmt.Errorf("Failed to remove photo from photoset, %w", err)}
+				}
+
+			case ACTION_DELETE:
+
+				args := &url.Values{}
+				args.Set("method", "flickr.photos.delete")
+				args.Set("photo_id", strconv.FormatInt(a.PhotoId, 10))
+
+				_, err := s.executeMethod(ctx, args)
+
+				if err != nil {
+					a.Error = &SyncError{fmt.Errorf("Failed to delete photo, %w", err)}
+				}
+
+			case ACTION_REORDER:
+
+				// Files which failed to upload are left out of the new order.
+
+				ordered := make([]string, 0)
+
+				for _, p := range a.Paths {
+
+					id, exists := photo_ids[p]
+
+					if exists && id != 0 {
+						ordered = append(ordered, strconv.FormatInt(id, 10))
+					}
+				}
+
+				if len(ordered) == 0 {
+					break
+				}
+
+				args := &url.Values{}
+				args.Set("method", "flickr.photosets.reorderPhotos")
+				args.Set("photoset_id", plan.PhotosetId)
+				args.Set("photo_ids", strings.Join(ordered, ","))
+
+				_, err := s.executeMethod(ctx, args)
+
+				if err != nil {
+					a.Error = &SyncError{fmt.Errorf("Failed to reorder photoset, %w", err)}
+				}
+
+			default:
+				a.Error = &SyncError{fmt.Errorf("Unsupported action '%s'", a.Action)}
+			}
+
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// photosetPhotos returns the photos in the photoset 'photoset_id', in the order they appear in the photoset.
+func (s *Syncer) photosetPhotos(ctx context.Context, photoset_id string) ([]*setPhoto, error) {
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photosets.getPhotos")
+	args.Set("photoset_id", photoset_id)
+	args.Set("extras", "machine_tags,url_o")
+	args.Set("per_page", "500")
+
+	photos := make([]*setPhoto, 0)
+
+	for ph, err := range client.ExecuteMethodItemsWithClient(ctx, s.client, args, nil) {
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve photos for photoset, %w", err)
+		}
+
+		set_ph := &setPhoto{
+			id:       ph.Get("id").Int(),
+			title:    ph.Get("title").String(),
+			original: ph.Get("url_o").String(),
+		}
+
+		for _, tag := range strings.Fields(ph.Get("machine_tags").String()) {
+
+			if strings.HasPrefix(tag, uploader.HASH_MACHINE_TAG_PREFIX) {
+				set_ph.hash = strings.TrimPrefix(tag, uploader.HASH_MACHINE_TAG_PREFIX)
+				break
+			}
+		}
+
+		photos = append(photos, set_ph)
+	}
+
+	return photos, nil
+}
+
+// setHashTag replaces any hash machine tags assigned to 'photo_id' with the hash machine tag for 'hash'.
+func (s *Syncer) setHashTag(ctx context.Context, photo_id int64, hash string) error {
+
+	str_id := strconv.FormatInt(photo_id, 10)
+	new_tag := uploader.HashMachineTag(hash)
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.getInfo")
+	args.Set("photo_id", str_id)
+
+	body, err := s.executeMethod(ctx, args)
+
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve photo info, %w", err)
+	}
+
+	has_tag := false
+
+	for _, tag := range gjson.GetBytes(body, "photo.tags.tag").Array() {
+
+		raw := tag.Get("raw").String()
+
+		if !strings.HasPrefix(raw, uploader.HASH_MACHINE_TAG_PREFIX) {
+			continue
+		}
+
+		if raw == new_tag {
+			has_tag = true
+			continue
+		}
+
+		remove_args := &url.Values{}
+		remove_args.Set("method", "flickr.photos.removeTag")
+		remove_args.Set("tag_id", tag.Get("id").String())
+
+		_, err := s.executeMethod(ctx, remove_args)
+
+		if err != nil {
+			return fmt.Errorf("Failed to remove tag '%s', %w", raw, err)
+		}
+	}
+
+	if has_tag {
+		return nil
+	}
+
+	add_args := &url.Values{}
+	add_args.Set("method", "flickr.photos.addTags")
+	add_args.Set("photo_id", str_id)
+	add_args.Set("tags", new_tag)
+
+	_, err = s.executeMethod(ctx, add_args)
+
+	if err != nil {
+		return fmt.Errorf("Failed to add tag, %w", err)
+	}
+
+	return nil
+}
+
+// executeMethod calls the API method defined by 'args' and returns the body of the response if it was successful.
+func (s *Syncer) executeMethod(ctx context.Context, args *url.Values) ([]byte, error) {
+
+	fh, err := s.client.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response, %w", err)
+	}
+
+	err = response.CheckMethodStatusBytes(body, args.Get("method"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// hashFile returns the (hex-encoded) SHA-256 hash of the file 'uri'.
+func hashFile(ctx context.Context, uri string) (string, error) {
+
+	fh, err := reader.NewReader(ctx, uri)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to create reader, %w", err)
+	}
+
+	defer fh.Close()
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, fh)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to read file, %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashOriginal returns the (hex-encoded) SHA-256 hash of the photo original at 'photo_url'.
+func (s *Syncer) hashOriginal(ctx context.Context, photo_url string) (string, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, photo_url, nil)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to create request, %w", err)
+	}
+
+	http_rsp, err := s.http_client.Do(req)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to download original, %w", err)
+	}
+
+	defer http_rsp.Body.Close()
+
+	if http_rsp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to download original, %s", http_rsp.Status)
+	}
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, http_rsp.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to read original, %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fileTitle returns the file name, minus its extension, for 'uri' which is the default title Flickr assigns to uploads.
+func fileTitle(uri string) string {
+
+	u, err := url.Parse(uri)
+
+	if err == nil && u.Scheme != "" {
+		uri = u.Path
+	}
+
+	fname := path.Base(filepath.ToSlash(uri))
+	return strings.TrimSuffix(fname, path.Ext(fname))
+}
+
+// copyAction returns a copy of 'a' so that applying a Plan does not modify it.
+func copyAction(a *SyncAction) *SyncAction {
+
+	copy_a := *a
+	copy_a.Paths = slices.Clone(a.Paths)
+
+	return &copy_a
+}
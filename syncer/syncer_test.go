@@ -0,0 +1,248 @@
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/uploader"
+	_ "gocloud.dev/blob/fileblob"
+)
+
+func TestSyncer(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+
+	files := map[string][]byte{
+		"a.jpg": []byte("photo a"),
+		"b.jpg": []byte("photo b, updated"),
+		"c.jpg": []byte("photo c"),
+		"d.jpg": []byte("photo d"),
+		"e.jpg": []byte("photo e, updated"),
+	}
+
+	for fname, body := range files {
+
+		err := os.WriteFile(filepath.Join(root, fname), body, 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", fname, err)
+		}
+	}
+
+	hash := func(body []byte) string {
+		h := sha256.Sum256(body)
+		return hex.EncodeToString(h[:])
+	}
+
+	// "a" is up to date, "b" has changed, "c" is new and "z" has been removed. "d" and "e" have no hash machine tag
+	// and are compared with their originals: "d" is up to date and "e" has changed.
+
+	ph_a := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Title: "Something else",
+		Body:  files["a.jpg"],
+		Tags:  []string{uploader.HashMachineTag(hash(files["a.jpg"]))},
+	})
+
+	ph_b := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Title: "b",
+		Body:  []byte("photo b"),
+		Tags:  []string{"cat", uploader.HashMachineTag(hash([]byte("photo b")))},
+	})
+
+	ph_z := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Title: "z",
+		Body:  []byte("photo z"),
+	})
+
+	ph_d := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Title: "d",
+		Body:  files["d.jpg"],
+	})
+
+	ph_e := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Title: "e",
+		Body:  []byte("photo e"),
+	})
+
+	set := svr.Store.AddPhotoset(&flickrtest.Photoset{
+		Owner:   svr.UserId,
+		Title:   "Mirror",
+		Primary: ph_b.Id,
+		Photos:  []int64{ph_b.Id, ph_a.Id, ph_z.Id, ph_d.Id, ph_e.Id},
+	})
+
+	photoset_id := strconv.FormatInt(set.Id, 10)
+
+	s, err := NewSyncer(ctx, &SyncerOptions{
+		Client:  cl,
+		Removed: REMOVED_REMOVE,
+	})
+
+	if err != nil {
+		t.Fatalf("Failed to create syncer, %v", err)
+	}
+
+	plan, err := s.Plan(ctx, root, photoset_id)
+
+	if err != nil {
+		t.Fatalf("Failed to derive plan, %v", err)
+	}
+
+	actions := make(map[string]*SyncAction)
+
+	for _, a := range plan.Actions {
+
+		key := filepath.Base(a.Path)
+
+		if a.Path == "" {
+			key = a.Action
+		}
+
+		actions[key] = a
+	}
+
+	expected := map[string]string{
+		"a.jpg":        ACTION_UNCHANGED,
+		"b.jpg":        ACTION_REPLACE,
+		"c.jpg":        ACTION_UPLOAD,
+		"d.jpg":        ACTION_TAG,
+		"e.jpg":        ACTION_REPLACE,
+		ACTION_REMOVE:  ACTION_REMOVE,
+		ACTION_REORDER: ACTION_REORDER,
+	}
+
+	if len(actions) != len(expected) || plan.Changes() != 6 {
+		t.Fatalf("Unexpected plan, %d actions (%d changes)", len(plan.Actions), plan.Changes())
+	}
+
+	for k, v := range expected {
+
+		a, exists := actions[k]
+
+		if !exists || a.Action != v {
+			t.Fatalf("Expected %s action for %s", v, k)
+		}
+	}
+
+	if actions["a.jpg"].PhotoId != ph_a.Id || actions["a.jpg"].Match != MATCH_HASH {
+		t.Fatalf("Unexpected match for a.jpg, %d (%s)", actions["a.jpg"].PhotoId, actions["a.jpg"].Match)
+	}
+
+	if actions["b.jpg"].PhotoId != ph_b.Id || actions["b.jpg"].Match != MATCH_TITLE {
+		t.Fatalf("Unexpected match for b.jpg, %d (%s)", actions["b.jpg"].PhotoId, actions["b.jpg"].Match)
+	}
+
+	if actions["d.jpg"].PhotoId != ph_d.Id || actions["e.jpg"].PhotoId != ph_e.Id {
+		t.Fatalf("Unexpected matches for d.jpg (%d) or e.jpg (%d)", actions["d.jpg"].PhotoId, actions["e.jpg"].PhotoId)
+	}
+
+	if actions[ACTION_REMOVE].PhotoId != ph_z.Id {
+		t.Fatalf("Unexpected photo to remove, %d", actions[ACTION_REMOVE].PhotoId)
+	}
+
+	var ph_c int64
+
+	for a := range s.Apply(ctx, plan) {
+
+		if a.Error != nil {
+			t.Fatalf("Failed to apply %s action for '%s', %v", a.Action, a.Path, a.Error)
+		}
+
+		if a.Action == ACTION_UPLOAD {
+			ph_c = a.PhotoId
+		}
+	}
+
+	if actions["c.jpg"].PhotoId != 0 {
+		t.Fatalf("Applying plan modified its actions")
+	}
+
+	set, _ = svr.Store.GetPhotoset(set.Id)
+
+	if !slices.Equal(set.Photos, []int64{ph_a.Id, ph_b.Id, ph_c, ph_d.Id, ph_e.Id}) {
+		t.Fatalf("Unexpected photoset order, %v", set.Photos)
+	}
+
+	replaced, _ := svr.Store.GetPhoto(ph_b.Id)
+
+	if !bytes.Equal(replaced.Body, files["b.jpg"]) {
+		t.Fatalf("Photo was not replaced")
+	}
+
+	if !replaced.HasTag("cat") || !replaced.HasTag(uploader.HashMachineTag(hash(files["b.jpg"]))) || len(replaced.Tags) != 2 {
+		t.Fatalf("Unexpected tags for replaced photo, %v", replaced.Tags)
+	}
+
+	tagged, _ := svr.Store.GetPhoto(ph_d.Id)
+
+	if !tagged.HasTag(uploader.HashMachineTag(hash(files["d.jpg"]))) {
+		t.Fatalf("Unexpected tags for tagged photo, %v", tagged.Tags)
+	}
+
+	// Replacing a photo assigns it a new original secret
+
+	if tagged.OriginalSecret != ph_d.OriginalSecret {
+		t.Fatalf("Tagged photo should not have been replaced")
+	}
+
+	replaced, _ = svr.Store.GetPhoto(ph_e.Id)
+
+	if !bytes.Equal(replaced.Body, files["e.jpg"]) {
+		t.Fatalf("Photo without hash machine tag was not replaced")
+	}
+
+	uploaded, _ := svr.Store.GetPhoto(ph_c)
+
+	if uploaded.Title != "c" || !uploaded.HasTag(uploader.HashMachineTag(hash(files["c.jpg"]))) {
+		t.Fatalf("Unexpected title or tags for uploaded photo, %s %v", uploaded.Title, uploaded.Tags)
+	}
+
+	_, exists := svr.Store.GetPhoto(ph_z.Id)
+
+	if !exists {
+		t.Fatalf("Removed photo should not have been deleted")
+	}
+
+	// Once applied the photoset is in sync with the bucket.
+
+	plan, err = s.Plan(ctx, root, photoset_id)
+
+	if err != nil {
+		t.Fatalf("Failed to derive plan, %v", err)
+	}
+
+	if plan.Changes() != 0 {
+		t.Fatalf("Expected no changes, %d", plan.Changes())
+	}
+
+	_, err = NewSyncer(ctx, &SyncerOptions{Client: cl, Removed: "archive"})
+
+	if err == nil {
+		t.Fatalf("Expected invalid removed option to fail")
+	}
+}
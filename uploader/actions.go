@@ -0,0 +1,313 @@
+package uploader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+// Action is the interface that defines methods for post-upload actions which are applied to a photo once it has been uploaded.
+// Implementations must be safe to use from multiple goroutines.
+type Action interface {
+	// Apply the action to a photo ID using a client.Client instance.
+	Apply(context.Context, client.Client, int64) error
+	// Return a short description of the action.
+	String() string
+}
+
+// ActionResult is a struct containing the result of applying an Action to an uploaded photo.
+type ActionResult struct {
+	// The description of the action that was applied.
+	Action string `json:"action"`
+	// An UploadError instance if the action failed.
+	Error *UploadError `json:"error,omitempty"`
+}
+
+// MethodAction implements the Action interface by calling a Flickr API method with the ID of the photo that was uploaded
+// assigned to the "photo_id" parameter.
+type MethodAction struct {
+	// The parameters, including "method", for the API method to call.
+	Args *url.Values
+}
+
+// NewAddToPhotosetAction returns an Action that adds photos to the photoset 'photoset_id' using the flickr.photosets.addPhoto method.
+func NewAddToPhotosetAction(photoset_id string) Action {
+	return newMethodAction("flickr.photosets.addPhoto", "photoset_id", photoset_id)
+}
+
+// NewAddToGroupAction returns an Action that adds photos to the pool of the group 'group_id' using the flickr.groups.pools.add method.
+func NewAddToGroupAction(group_id string) Action {
+	return newMethodAction("flickr.groups.pools.add", "group_id", group_id)
+}
+
+// NewAddToGalleryAction returns an Action that adds photos to the gallery 'gallery_id' using the flickr.galleries.addPhoto method.
+func NewAddToGalleryAction(gallery_id string) Action {
+	return newMethodAction("flickr.galleries.addPhoto", "gallery_id", gallery_id)
+}
+
+// NewSetLicenseAction returns an Action that assigns the license 'license_id' to photos using the flickr.photos.licenses.setLicense method.
+func NewSetLicenseAction(license_id string) Action {
+	return newMethodAction("flickr.photos.licenses.setLicense", "license_id", license_id)
+}
+
+// NewSetPermsAction returns an Action that assigns the permissions derived from the privacy label 'perms' ("public",
+// "private", "friends", "family" or "friends,family") to photos using the flickr.photos.setPerms method.
+func NewSetPermsAction(perms string) (Action, error) {
+
+	args, err := parsePrivacy(perms)
+
+	if err != nil {
+		return nil, err
+	}
+
+	args.Set("method", "flickr.photos.setPerms")
+
+	a := &MethodAction{
+		Args: args,
+	}
+
+	return a, nil
+}
+
+func newMethodAction(method string, k string, v string) Action {
+
+	args := &url.Values{}
+	args.Set("method", method)
+	args.Set(k, v)
+
+	a := &MethodAction{
+		Args: args,
+	}
+
+	return a
+}
+
+// Call the API method defined by the MethodAction for 'photo_id'.
+func (a *MethodAction) Apply(ctx context.Context, cl client.Client, photo_id int64) error {
+
+	args := &url.Values{}
+
+	for k, v := range *a.Args {
+		(*args)[k] = v
+	}
+
+	args.Set("photo_id", strconv.FormatInt(photo_id, 10))
+
+	return executeMethod(ctx, cl, args)
+}
+
+// Return the API method and its parameters, sorted by name.
+func (a *MethodAction) String() string {
+
+	keys := make([]string, 0)
+
+	for k := range *a.Args {
+
+		if k != "method" {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	parts := []string{
+		a.Args.Get("method"),
+	}
+
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, a.Args.Get(k)))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// CreatePhotosetAction implements the Action interface by creating a new photoset, using the first photo it is applied
+// to as the primary photo, and then adding each subsequent photo to that photoset. If the authenticated user already has a
+// photoset with the same title, for example one created by a previous (interrupted) upload, photos are added to that
+// photoset instead.
+type CreatePhotosetAction struct {
+	// The title of the photoset to create.
+	Title string
+	// The description of the photoset to create.
+	Description string
+	mu          sync.Mutex
+	photoset_id string
+}
+
+// NewCreatePhotosetAction returns a new CreatePhotosetAction instance for a photoset titled 'title'.
+func NewCreatePhotosetAction(title string, description string) *CreatePhotosetAction {
+
+	a := &CreatePhotosetAction{
+		Title:       title,
+		Description: description,
+	}
+
+	return a
+}
+
+// Create the photoset, if it has not been created yet and does not already exist, with 'photo_id' as its primary photo or
+// otherwise add 'photo_id' to it.
+func (a *CreatePhotosetAction) Apply(ctx context.Context, cl client.Client, photo_id int64) error {
+
+	// The lock is held while the photoset is looked up or created so that concurrent uploads don't create more than one photoset.
+
+	a.mu.Lock()
+
+	if a.photoset_id == "" {
+
+		photoset_id, err := a.findPhotoset(ctx, cl)
+
+		if err != nil {
+			a.mu.Unlock()
+			return fmt.Errorf("Failed to find existing photoset, %w", err)
+		}
+
+		a.photoset_id = photoset_id
+	}
+
+	if a.photoset_id != "" {
+		photoset_id := a.photoset_id
+		a.mu.Unlock()
+		return addToPhotoset(ctx, cl, photoset_id, photo_id)
+	}
+
+	defer a.mu.Unlock()
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photosets.create")
+	args.Set("title", a.Title)
+	args.Set("primary_photo_id", strconv.FormatInt(photo_id, 10))
+
+	if a.Description != "" {
+		args.Set("description", a.Description)
+	}
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return err
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return fmt.Errorf("Failed to read response, %w", err)
+	}
+
+	err = response.CheckMethodStatusBytes(body, args.Get("method"))
+
+	if err != nil {
+		return err
+	}
+
+	photoset_id := gjson.GetBytes(body, "photoset.id").String()
+
+	if photoset_id == "" {
+		return fmt.Errorf("Failed to derive photoset ID from response")
+	}
+
+	a.photoset_id = photoset_id
+	return nil
+}
+
+// findPhotoset returns the ID of the authenticated user's first photoset whose title matches the CreatePhotosetAction's
+// title, or an empty string if there is no such photoset.
+func (a *CreatePhotosetAction) findPhotoset(ctx context.Context, cl client.Client) (string, error) {
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photosets.getList")
+
+	opts := &client.PaginateOptions{
+		ItemsPath: "photosets.photoset",
+	}
+
+	for set, err := range client.ExecuteMethodItemsWithClient(ctx, cl, args, opts) {
+
+		if err != nil {
+			return "", err
+		}
+
+		if set.Get("title._content").String() == a.Title {
+			return set.Get("id").String(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// Return the ID of the photoset that was created, or an empty string if it has not been created yet.
+func (a *CreatePhotosetAction) PhotosetId() string {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.photoset_id
+}
+
+// Return a description of the action.
+func (a *CreatePhotosetAction) String() string {
+	return fmt.Sprintf("flickr.photosets.create title=%s", a.Title)
+}
+
+// applyActions applies each of 'actions' to 'photo_id', regardless of whether previous actions failed, and returns their results.
+func applyActions(ctx context.Context, cl client.Client, actions []Action, photo_id int64) []*ActionResult {
+
+	results := make([]*ActionResult, len(actions))
+
+	for i, a := range actions {
+
+		r := &ActionResult{
+			Action: a.String(),
+		}
+
+		err := a.Apply(ctx, cl, photo_id)
+
+		if err != nil {
+			r.Error = &UploadError{err}
+		}
+
+		results[i] = r
+	}
+
+	return results
+}
+
+// addToPhotoset adds 'photo_id' to the photoset 'photoset_id' using the flickr.photosets.addPhoto method. Photos that are
+// already in the photoset, for example because a previous upload was retried, are not considered an error.
+func addToPhotoset(ctx context.Context, cl client.Client, photoset_id string, photo_id int64) error {
+
+	err := NewAddToPhotosetAction(photoset_id).Apply(ctx, cl, photo_id)
+
+	// 3 Photo already in set
+	if errors.Is(err, &response.Error{Code: 3}) {
+		return nil
+	}
+
+	return err
+}
+
+// executeMethod calls the API method defined by 'args' and returns an error if it was not successful.
+func executeMethod(ctx context.Context, cl client.Client, args *url.Values) error {
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return err
+	}
+
+	defer fh.Close()
+
+	return response.CheckMethodStatus(fh, args.Get("method"))
+}
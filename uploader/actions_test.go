@@ -0,0 +1,234 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/ledger"
+)
+
+func TestUploaderActions(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+	paths := make([]string, 0)
+
+	for i := 0; i < 3; i++ {
+
+		path := filepath.Join(root, fmt.Sprintf("%d.jpg", i))
+
+		err := os.WriteFile(path, []byte(fmt.Sprintf("photo %d", i)), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", path, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	perms_action, err := NewSetPermsAction("friends,family")
+
+	if err != nil {
+		t.Fatalf("Failed to create perms action, %v", err)
+	}
+
+	create_action := NewCreatePhotosetAction("Test", "")
+
+	opts := &UploaderOptions{
+		Client: cl,
+		Actions: []Action{
+			create_action,
+			NewSetLicenseAction("4"),
+			perms_action,
+			// flickrtest does not implement flickr.groups.pools.add so this action is expected to fail
+			NewAddToGroupAction("1234@N01"),
+		},
+	}
+
+	up, err := NewUploader(ctx, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	for rsp := range up.Upload(ctx, paths) {
+
+		// The failed group action means the result is reported as failed even though the file was uploaded
+
+		if rsp.Error == nil || rsp.PhotoId == 0 {
+			t.Fatalf("Expected upload of %s to fail with a photo ID, %v", rsp.Path, rsp)
+		}
+
+		if len(rsp.Actions) != 4 {
+			t.Fatalf("Unexpected number of action results, %d", len(rsp.Actions))
+		}
+
+		for i, r := range rsp.Actions[0:3] {
+
+			if r.Error != nil {
+				t.Fatalf("Action %d (%s) failed, %v", i, r.Action, r.Error)
+			}
+		}
+
+		if rsp.Actions[3].Error == nil || rsp.Actions[3].Action != "flickr.groups.pools.add group_id=1234@N01" {
+			t.Fatalf("Expected group action to fail, %v", rsp.Actions[3])
+		}
+
+		ph, exists := svr.Store.GetPhoto(rsp.PhotoId)
+
+		if !exists {
+			t.Fatalf("Failed to retrieve photo %d", rsp.PhotoId)
+		}
+
+		if ph.License != "4" || ph.IsPublic != 0 || ph.IsFriend != 1 || ph.IsFamily != 1 {
+			t.Fatalf("Unexpected license or permissions, %v", ph)
+		}
+	}
+
+	sets := svr.Store.Photosets()
+
+	if len(sets) != 1 {
+		t.Fatalf("Expected exactly one photoset to be created, %d", len(sets))
+	}
+
+	if fmt.Sprintf("%d", sets[0].Id) != create_action.PhotosetId() || len(sets[0].Photos) != 3 {
+		t.Fatalf("Unexpected photoset, %v", sets[0])
+	}
+}
+
+func TestUploaderActionsRetry(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+
+	led, err := ledger.NewLedger(ctx, "jsonl://"+filepath.Join(root, "ledger.jsonl"))
+
+	if err != nil {
+		t.Fatalf("Failed to create ledger, %v", err)
+	}
+
+	defer led.Close()
+
+	paths := make([]string, 0)
+
+	for i := 0; i < 2; i++ {
+
+		path := filepath.Join(root, fmt.Sprintf("%d.jpg", i))
+
+		err := os.WriteFile(path, []byte(fmt.Sprintf("photo %d", i)), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", path, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	upload := func(expect_error bool, actions ...Action) map[string]int64 {
+
+		opts := &UploaderOptions{
+			Client:  cl,
+			Ledger:  led,
+			Actions: actions,
+			Workers: 1,
+		}
+
+		up, err := NewUploader(ctx, opts)
+
+		if err != nil {
+			t.Fatalf("Failed to create uploader, %v", err)
+		}
+
+		photo_ids := make(map[string]int64)
+
+		for rsp := range up.Upload(ctx, paths) {
+
+			if (rsp.Error != nil) != expect_error {
+				t.Fatalf("Unexpected error uploading %s, %v", rsp.Path, rsp.Error)
+			}
+
+			photo_ids[rsp.Path] = rsp.PhotoId
+		}
+
+		return photo_ids
+	}
+
+	// flickrtest does not implement flickr.groups.pools.add so the first run leaves each upload with a failed action
+
+	first_ids := upload(true, NewCreatePhotosetAction("Retry", ""), NewAddToGroupAction("1234@N01"))
+
+	for _, path := range paths {
+
+		e, err := led.Get(ctx, path)
+
+		if err != nil {
+			t.Fatalf("Failed to retrieve ledger entry for %s, %v", path, err)
+		}
+
+		if e.Status != ledger.STATUS_FAILED || e.PhotoId != first_ids[path] || e.Error == "" {
+			t.Fatalf("Expected failed ledger entry with photo ID for %s, %v", path, e)
+		}
+	}
+
+	// The second run, with a new CreatePhotosetAction, retries the actions without uploading the files again or creating a second photoset
+
+	second_ids := upload(false, NewCreatePhotosetAction("Retry", ""), NewSetLicenseAction("4"))
+
+	if len(svr.Store.Photos()) != 2 {
+		t.Fatalf("Expected files not to be uploaded again, %d photos", len(svr.Store.Photos()))
+	}
+
+	for _, path := range paths {
+
+		if second_ids[path] != first_ids[path] {
+			t.Fatalf("Unexpected photo ID for %s, %d (expected %d)", path, second_ids[path], first_ids[path])
+		}
+
+		e, err := led.Get(ctx, path)
+
+		if err != nil {
+			t.Fatalf("Failed to retrieve ledger entry for %s, %v", path, err)
+		}
+
+		if e.Status != ledger.STATUS_COMPLETE {
+			t.Fatalf("Expected complete ledger entry for %s, %v", path, e)
+		}
+
+		ph, _ := svr.Store.GetPhoto(e.PhotoId)
+
+		if ph.License != "4" {
+			t.Fatalf("Expected license to be assigned to %s, %v", path, ph)
+		}
+	}
+
+	sets := svr.Store.Photosets()
+
+	if len(sets) != 1 || len(sets[0].Photos) != 2 {
+		t.Fatalf("Expected exactly one photoset with two photos, %v", sets)
+	}
+}
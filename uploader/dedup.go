@@ -0,0 +1,88 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/ledger"
+	"github.com/aaronland/go-flickr-api/response"
+	"github.com/tidwall/gjson"
+)
+
+// The namespace and predicate of the machine tag used to record the (hex-encoded) SHA-256 hash of an uploaded file.
+const HASH_MACHINE_TAG_PREFIX string = "file:sha256="
+
+// HashMachineTag returns the machine tag used to record the (hex-encoded) SHA-256 hash 'hash' of an uploaded file.
+func HashMachineTag(hash string) string {
+	return HASH_MACHINE_TAG_PREFIX + hash
+}
+
+// FindPhotoByHashWithClient returns the ID of a photo belonging to the authenticated user that has been tagged with
+// the machine tag for 'hash' (see HashMachineTag), or 0 if there is no such photo.
+func FindPhotoByHashWithClient(ctx context.Context, cl client.Client, hash string) (int64, error) {
+
+	args := &url.Values{}
+	args.Set("method", "flickr.photos.search")
+	args.Set("user_id", "me")
+	args.Set("machine_tags", HashMachineTag(hash))
+	args.Set("per_page", "1")
+
+	fh, err := cl.ExecuteMethod(ctx, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("Failed to search photos, %w", err)
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return 0, fmt.Errorf("Failed to read search response, %w", err)
+	}
+
+	err = response.CheckMethodStatusBytes(body, args.Get("method"))
+
+	if err != nil {
+		return 0, err
+	}
+
+	return gjson.GetBytes(body, "photos.photo.0.id").Int(), nil
+}
+
+// findDuplicate returns the ID of a photo that has already been uploaded with the same (hex-encoded) SHA-256 hash,
+// checking the Uploader's ledger (if present) before searching for the hash machine tag, or 0 if there is none.
+func (u *Uploader) findDuplicate(ctx context.Context, hash string) (int64, error) {
+
+	if u.ledger != nil {
+
+		e, err := u.ledger.GetByHash(ctx, hash)
+
+		switch {
+		case err == ledger.ErrNotFound:
+			// pass
+		case err != nil:
+			return 0, fmt.Errorf("Failed to retrieve ledger entry for hash, %w", err)
+		default:
+			return e.PhotoId, nil
+		}
+	}
+
+	return FindPhotoByHashWithClient(ctx, u.client, hash)
+}
+
+// appendTag appends 'tag' to the (space-separated) "tags" parameter in 'args'.
+func appendTag(args *url.Values, tag string) {
+
+	tags := strings.TrimSpace(args.Get("tags"))
+
+	if tags != "" {
+		tags = tags + " "
+	}
+
+	args.Set("tags", tags+tag)
+}
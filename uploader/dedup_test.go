@@ -0,0 +1,119 @@
+package uploader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/ledger"
+)
+
+func TestUploaderDedup(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+
+	for _, fname := range []string{"a.jpg", "b.jpg", "c.jpg"} {
+
+		err := os.WriteFile(filepath.Join(root, fname), []byte("photo"), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", fname, err)
+		}
+	}
+
+	led, err := ledger.NewLedger(ctx, "jsonl://"+filepath.Join(root, "ledger.jsonl"))
+
+	if err != nil {
+		t.Fatalf("Failed to create ledger, %v", err)
+	}
+
+	defer led.Close()
+
+	upload := func(opts *UploaderOptions, path string) *UploadResult {
+
+		up, err := NewUploader(ctx, opts)
+
+		if err != nil {
+			t.Fatalf("Failed to create uploader, %v", err)
+		}
+
+		var rsp *UploadResult
+
+		for r := range up.Upload(ctx, []string{filepath.Join(root, path)}) {
+
+			if r.Error != nil {
+				t.Fatalf("Failed to upload %s, %v", r.Path, r.Error)
+			}
+
+			rsp = r
+		}
+
+		return rsp
+	}
+
+	a_rsp := upload(&UploaderOptions{Client: cl, Ledger: led, Dedup: true, Args: &url.Values{"tags": []string{"hello"}}}, "a.jpg")
+
+	if a_rsp.Duplicate {
+		t.Fatalf("Did not expect first upload to be a duplicate")
+	}
+
+	ph, exists := svr.Store.GetPhoto(a_rsp.PhotoId)
+
+	if !exists {
+		t.Fatalf("Failed to retrieve photo %d", a_rsp.PhotoId)
+	}
+
+	if !ph.HasTag("hello") || !ph.HasTag(HashMachineTag(hashOf("photo"))) {
+		t.Fatalf("Unexpected tags, %v", ph.Tags)
+	}
+
+	// Duplicate according to the ledger
+
+	b_rsp := upload(&UploaderOptions{Client: cl, Ledger: led, Dedup: true}, "b.jpg")
+
+	if !b_rsp.Duplicate || b_rsp.PhotoId != a_rsp.PhotoId {
+		t.Fatalf("Expected duplicate of %d, %v", a_rsp.PhotoId, b_rsp)
+	}
+
+	// Duplicate according to the machine tag
+
+	c_rsp := upload(&UploaderOptions{Client: cl, Dedup: true}, "c.jpg")
+
+	if !c_rsp.Duplicate || c_rsp.PhotoId != a_rsp.PhotoId {
+		t.Fatalf("Expected duplicate of %d, %v", a_rsp.PhotoId, c_rsp)
+	}
+
+	if len(svr.Store.Photos()) != 1 {
+		t.Fatalf("Expected only one photo to be uploaded")
+	}
+
+	// Without deduplication
+
+	c_rsp = upload(&UploaderOptions{Client: cl}, "c.jpg")
+
+	if c_rsp.Duplicate || c_rsp.PhotoId == a_rsp.PhotoId {
+		t.Fatalf("Did not expect duplicate, %v", c_rsp)
+	}
+}
+
+func hashOf(body string) string {
+	hash := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(hash[:])
+}
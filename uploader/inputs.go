@@ -0,0 +1,559 @@
+package uploader
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aaronland/go-flickr-api/reader"
+	"gocloud.dev/blob"
+)
+
+// Include all files when walking directories.
+const MEDIA_ALL string = "all"
+
+// Only include photos when walking directories.
+const MEDIA_PHOTOS string = "photos"
+
+// Only include videos when walking directories.
+const MEDIA_VIDEOS string = "videos"
+
+// The file extensions (lower-cased) treated as photos when walking directories.
+var PHOTO_EXTENSIONS = []string{".jpg", ".jpeg", ".png", ".gif", ".tif", ".tiff", ".heic", ".heif", ".webp"}
+
+// The file extensions (lower-cased) treated as videos when walking directories.
+var VIDEO_EXTENSIONS = []string{".mp4", ".mov", ".m4v", ".avi", ".mpg", ".mpeg", ".wmv", ".3gp", ".mts", ".ogv"}
+
+// The manifest column containing the URI of the file to upload.
+const MANIFEST_PATH string = "path"
+
+// The manifest column containing the ID of the photoset to add an uploaded photo to.
+const MANIFEST_PHOTOSET string = "photoset_id"
+
+// The manifest column containing a privacy label ("public", "private", "friends", "family" or "friends,family")
+// which is converted in to the "is_public", "is_friend" and "is_family" API parameters.
+const MANIFEST_PRIVACY string = "privacy"
+
+// Input is a struct containing a file to upload and any parameters specific to that file.
+type Input struct {
+	// The URI of the file to upload.
+	Path string
+	// Flickr API parameters specific to the file which override any global parameters.
+	Args *url.Values
+	// The ID of the photoset to add the photo to once it has been uploaded.
+	PhotosetId string
+}
+
+// InputOptions is a struct containing options for filtering the files found when walking directories.
+type InputOptions struct {
+	// Zero or more glob patterns; if present only files matching at least one pattern are included. Patterns without
+	// a "/" are matched against the file name, otherwise against the path relative to the directory being walked.
+	Include []string
+	// Zero or more glob patterns, matched the same way as Include, for files to exclude.
+	Exclude []string
+	// The kinds of files to include: MEDIA_ALL, MEDIA_PHOTOS or MEDIA_VIDEOS. If empty MEDIA_ALL is used.
+	Media string
+}
+
+// ExpandPaths returns the list of Inputs for 'paths'. Paths without a URI scheme are resolved to absolute file://
+// URIs. Paths that are local directories, or URIs ending in "/", are treated as gocloud.dev/blob buckets which are
+// walked recursively and filtered according to 'opts'. All other paths are included as-is. 'opts' may be nil.
+func ExpandPaths(ctx context.Context, paths []string, opts *InputOptions) ([]*Input, error) {
+
+	if opts == nil {
+		opts = &InputOptions{}
+	}
+
+	inputs := make([]*Input, 0)
+
+	for _, p := range paths {
+
+		uri, is_dir, err := resolvePath(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !is_dir {
+			inputs = append(inputs, &Input{Path: p, Args: &url.Values{}})
+			continue
+		}
+
+		dir_inputs, err := walkBucket(ctx, uri, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to walk '%s', %w", p, err)
+		}
+
+		inputs = append(inputs, dir_inputs...)
+	}
+
+	return inputs, nil
+}
+
+// ReadManifest returns the list of Inputs defined in the CSV or JSONL (determined by the file extension) manifest
+// at 'uri'. CSV manifests must have a header row. Each row must have a MANIFEST_PATH column; the MANIFEST_PHOTOSET and
+// MANIFEST_PRIVACY columns are handled specially and all other (non-empty) columns, for example "title", "description",
+// "tags", "safety_level", "content_type" or "photo_id", are used as Flickr API parameters for that file. Relative
+// paths in local manifests are resolved relative to the manifest itself.
+func ReadManifest(ctx context.Context, uri string) ([]*Input, error) {
+
+	fh, err := reader.NewReader(ctx, uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open manifest, %w", err)
+	}
+
+	defer fh.Close()
+
+	var rows []map[string]string
+
+	switch strings.ToLower(path.Ext(strings.SplitN(uri, "?", 2)[0])) {
+	case ".csv":
+		rows, err = readCSVManifest(fh)
+	case ".jsonl", ".ndjson":
+		rows, err = readJSONLManifest(fh)
+	default:
+		return nil, fmt.Errorf("Unsupported manifest type for '%s', expected .csv or .jsonl", uri)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	root := ""
+
+	u, err := url.Parse(uri)
+
+	if err == nil && (u.Scheme == "" || u.Scheme == "file") {
+
+		abs_path, err := filepath.Abs(filepath.Dir(u.Path))
+
+		if err == nil {
+			root = abs_path
+		}
+	}
+
+	inputs := make([]*Input, len(rows))
+
+	for i, row := range rows {
+
+		in, err := manifestInput(row, root)
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid manifest row %d, %w", i+1, err)
+		}
+
+		inputs[i] = in
+	}
+
+	return inputs, nil
+}
+
+// MergeArgs returns a new url.Values instance containing 'args' overridden by the parameters in 'in'.
+func (in *Input) MergeArgs(args *url.Values) *url.Values {
+
+	merged := &url.Values{}
+
+	if args != nil {
+
+		for k, v := range *args {
+			(*merged)[k] = v
+		}
+	}
+
+	if in.Args != nil {
+
+		for k, v := range *in.Args {
+			(*merged)[k] = v
+		}
+	}
+
+	return merged
+}
+
+// manifestInput returns a new Input derived from a manifest row. If 'root' is not empty relative paths are resolved against it.
+func manifestInput(row map[string]string, root string) (*Input, error) {
+
+	p := strings.TrimSpace(row[MANIFEST_PATH])
+
+	if p == "" {
+		return nil, fmt.Errorf("Missing %s column", MANIFEST_PATH)
+	}
+
+	if root != "" && !strings.Contains(p, "://") && !filepath.IsAbs(p) {
+		p = filepath.Join(root, p)
+	}
+
+	in := &Input{
+		Path:       p,
+		Args:       &url.Values{},
+		PhotosetId: strings.TrimSpace(row[MANIFEST_PHOTOSET]),
+	}
+
+	for k, v := range row {
+
+		v = strings.TrimSpace(v)
+
+		if v == "" {
+			continue
+		}
+
+		switch k {
+		case MANIFEST_PATH, MANIFEST_PHOTOSET:
+			// pass
+		case MANIFEST_PRIVACY:
+
+			privacy_args, err := parsePrivacy(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for pk := range *privacy_args {
+				in.Args.Set(pk, privacy_args.Get(pk))
+			}
+
+		default:
+			in.Args.Set(k, v)
+		}
+	}
+
+	return in, nil
+}
+
+// parsePrivacy converts a privacy label ("public", "private", "friends", "family" or a comma-separated combination of
+// "friends" and "family") in to the "is_public", "is_friend" and "is_family" API parameters.
+func parsePrivacy(v string) (*url.Values, error) {
+
+	is_public := "0"
+	is_friend := "0"
+	is_family := "0"
+
+	for _, label := range strings.Split(strings.ToLower(v), ",") {
+
+		switch strings.TrimSpace(label) {
+		case "public":
+			is_public = "1"
+		case "friends":
+			is_friend = "1"
+		case "family":
+			is_family = "1"
+		case "private":
+			// pass
+		default:
+			return nil, fmt.Errorf("Invalid privacy value '%s'", v)
+		}
+	}
+
+	args := &url.Values{}
+	args.Set("is_public", is_public)
+	args.Set("is_friend", is_friend)
+	args.Set("is_family", is_family)
+
+	return args, nil
+}
+
+// readCSVManifest reads the rows of a CSV manifest with a header row.
+func readCSVManifest(r io.Reader) ([]map[string]string, error) {
+
+	csv_r := csv.NewReader(r)
+	csv_r.FieldsPerRecord = -1
+
+	header, err := csv_r.Read()
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read manifest header, %w", err)
+	}
+
+	for i, k := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(k))
+	}
+
+	rows := make([]map[string]string, 0)
+
+	for {
+
+		record, err := csv_r.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read manifest, %w", err)
+		}
+
+		row := make(map[string]string)
+
+		for i, v := range record {
+
+			if i < len(header) {
+				row[header[i]] = v
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// readJSONLManifest reads the rows of a JSONL manifest where each line is a JSON object whose values are strings,
+// numbers or booleans.
+func readJSONLManifest(r io.Reader) ([]map[string]string, error) {
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	rows := make([]map[string]string, 0)
+	lineno := 0
+
+	for scanner.Scan() {
+
+		lineno += 1
+
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		var obj map[string]any
+
+		err := json.Unmarshal([]byte(line), &obj)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to unmarshal manifest at line %d, %w", lineno, err)
+		}
+
+		row := make(map[string]string)
+
+		for k, v := range obj {
+
+			switch v := v.(type) {
+			case string:
+				row[strings.ToLower(k)] = v
+			case float64, bool:
+				row[strings.ToLower(k)] = fmt.Sprintf("%v", v)
+			case []any:
+
+				// For example a list of tags
+
+				values := make([]string, len(v))
+
+				for i, item := range v {
+					values[i] = fmt.Sprintf("%v", item)
+				}
+
+				row[strings.ToLower(k)] = strings.Join(values, " ")
+
+			case nil:
+				// pass
+			default:
+				return nil, fmt.Errorf("Invalid value for '%s' at line %d", k, lineno)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	err := scanner.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read manifest, %w", err)
+	}
+
+	return rows, nil
+}
+
+// resolvePath returns the URI for 'p' and a boolean flag indicating whether it should be treated as a directory.
+func resolvePath(p string) (string, bool, error) {
+
+	u, err := url.Parse(p)
+
+	if err != nil {
+		return "", false, fmt.Errorf("Failed to parse '%s', %w", p, err)
+	}
+
+	if u.Scheme == "" {
+
+		abs_path, err := filepath.Abs(p)
+
+		if err != nil {
+			return "", false, fmt.Errorf("Failed to derive absolute path for '%s', %w", p, err)
+		}
+
+		info, err := os.Stat(abs_path)
+
+		if err != nil || !info.IsDir() {
+			return p, false, nil
+		}
+
+		u = &url.URL{Scheme: "file", Path: abs_path}
+		return u.String(), true, nil
+	}
+
+	if strings.HasSuffix(u.Path, "/") {
+		return p, true, nil
+	}
+
+	if u.Scheme == "file" {
+
+		info, err := os.Stat(u.Path)
+
+		if err == nil && info.IsDir() {
+			return p, true, nil
+		}
+	}
+
+	return p, false, nil
+}
+
+// walkBucket returns the list of Inputs for all the files, matching 'opts', in the gocloud.dev/blob bucket 'uri'. For
+// file:// URIs the path is the directory to walk. For all other schemes the host is the name of the bucket and the path
+// is the prefix within that bucket to walk, for example s3://bucket/photos/.
+func walkBucket(ctx context.Context, uri string, opts *InputOptions) ([]*Input, error) {
+
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket_u := *u
+	prefix := ""
+
+	if u.Scheme != "file" {
+		bucket_u.Path = ""
+		bucket_u.RawPath = ""
+		prefix = strings.TrimPrefix(u.Path, "/")
+	}
+
+	b, err := blob.OpenBucket(ctx, bucket_u.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open bucket, %w", err)
+	}
+
+	defer b.Close()
+
+	inputs := make([]*Input, 0)
+
+	iter := b.List(&blob.ListOptions{Prefix: prefix})
+
+	for {
+
+		obj, err := iter.Next(ctx)
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list bucket, %w", err)
+		}
+
+		if obj.IsDir {
+			continue
+		}
+
+		// Include and exclude patterns are matched against keys relative to the prefix being walked
+
+		ok, err := matchesInputOptions(strings.TrimPrefix(obj.Key, prefix), opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		obj_u := bucket_u
+		obj_u.Path = strings.TrimSuffix(bucket_u.Path, "/") + "/" + obj.Key
+
+		inputs = append(inputs, &Input{Path: obj_u.String(), Args: &url.Values{}})
+	}
+
+	return inputs, nil
+}
+
+// matchesInputOptions returns a boolean flag indicating whether the file 'key', relative to the directory being walked, matches 'opts'.
+func matchesInputOptions(key string, opts *InputOptions) (bool, error) {
+
+	ext := strings.ToLower(path.Ext(key))
+
+	switch opts.Media {
+	case "", MEDIA_ALL:
+		// pass
+	case MEDIA_PHOTOS:
+
+		if !slices.Contains(PHOTO_EXTENSIONS, ext) {
+			return false, nil
+		}
+
+	case MEDIA_VIDEOS:
+
+		if !slices.Contains(VIDEO_EXTENSIONS, ext) {
+			return false, nil
+		}
+
+	default:
+		return false, fmt.Errorf("Invalid media type '%s'", opts.Media)
+	}
+
+	match := func(patterns []string) (bool, error) {
+
+		for _, pattern := range patterns {
+
+			target := key
+
+			if !strings.Contains(pattern, "/") {
+				target = path.Base(key)
+			}
+
+			ok, err := path.Match(pattern, target)
+
+			if err != nil {
+				return false, fmt.Errorf("Invalid pattern '%s', %w", pattern, err)
+			}
+
+			if ok {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	if len(opts.Include) > 0 {
+
+		ok, err := match(opts.Include)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	ok, err := match(opts.Exclude)
+
+	if err != nil {
+		return false, err
+	}
+
+	return !ok, nil
+}
@@ -0,0 +1,346 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/reader"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+)
+
+func TestExpandPaths(t *testing.T) {
+
+	ctx := context.Background()
+
+	root := t.TempDir()
+
+	files := []string{
+		"a.jpg",
+		"b.PNG",
+		"c.mov",
+		"notes.txt",
+		"sub/d.jpg",
+		"sub/skip/e.jpg",
+	}
+
+	for _, f := range files {
+
+		path := filepath.Join(root, f)
+
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+
+		if err != nil {
+			t.Fatalf("Failed to create directory, %v", err)
+		}
+
+		err = os.WriteFile(path, []byte(f), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", path, err)
+		}
+	}
+
+	tests := []struct {
+		opts     *InputOptions
+		expected []string
+	}{
+		{&InputOptions{}, files},
+		{&InputOptions{Media: MEDIA_PHOTOS}, []string{"a.jpg", "b.PNG", "sub/d.jpg", "sub/skip/e.jpg"}},
+		{&InputOptions{Media: MEDIA_VIDEOS}, []string{"c.mov"}},
+		{&InputOptions{Include: []string{"*.jpg"}, Exclude: []string{"sub/skip/*"}}, []string{"a.jpg", "sub/d.jpg"}},
+	}
+
+	for i, test := range tests {
+
+		inputs, err := ExpandPaths(ctx, []string{root}, test.opts)
+
+		if err != nil {
+			t.Fatalf("Failed to expand paths for test %d, %v", i, err)
+		}
+
+		paths := make([]string, len(inputs))
+
+		for j, in := range inputs {
+
+			rel, err := filepath.Rel(root, in.Path[len("file://"):])
+
+			if err != nil {
+				t.Fatalf("Failed to derive relative path for %s, %v", in.Path, err)
+			}
+
+			paths[j] = filepath.ToSlash(rel)
+		}
+
+		sort.Strings(paths)
+
+		expected := append([]string{}, test.expected...)
+		sort.Strings(expected)
+
+		if fmt.Sprintf("%v", paths) != fmt.Sprintf("%v", expected) {
+			t.Fatalf("Unexpected paths for test %d, %v", i, paths)
+		}
+	}
+
+	inputs, err := ExpandPaths(ctx, []string{filepath.Join(root, "a.jpg")}, &InputOptions{Media: MEDIA_VIDEOS})
+
+	if err != nil {
+		t.Fatalf("Failed to expand file path, %v", err)
+	}
+
+	if len(inputs) != 1 {
+		t.Fatalf("Expected explicit file paths to be included as-is")
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+
+	ctx := context.Background()
+
+	root := t.TempDir()
+
+	csv_body := "path,title,tags,privacy,photoset_id\n" +
+		"a.jpg,Photo A,\"one two\",\"friends,family\",123\n" +
+		"/abs/b.jpg,,,public,\n"
+
+	jsonl_body := `{"path": "c.jpg", "title": "Photo C", "tags": ["one", "two"], "safety_level": 2, "hidden": true}` + "\n"
+
+	manifests := map[string]string{
+		"manifest.csv":   csv_body,
+		"manifest.jsonl": jsonl_body,
+	}
+
+	for fname, body := range manifests {
+
+		err := os.WriteFile(filepath.Join(root, fname), []byte(body), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", fname, err)
+		}
+	}
+
+	inputs, err := ReadManifest(ctx, filepath.Join(root, "manifest.csv"))
+
+	if err != nil {
+		t.Fatalf("Failed to read CSV manifest, %v", err)
+	}
+
+	if len(inputs) != 2 {
+		t.Fatalf("Unexpected number of inputs, %d", len(inputs))
+	}
+
+	a := inputs[0]
+
+	if a.Path != filepath.Join(root, "a.jpg") || a.PhotosetId != "123" {
+		t.Fatalf("Unexpected input, %v", a)
+	}
+
+	if a.Args.Get("title") != "Photo A" || a.Args.Get("tags") != "one two" || a.Args.Get("is_public") != "0" || a.Args.Get("is_friend") != "1" || a.Args.Get("is_family") != "1" {
+		t.Fatalf("Unexpected arguments, %v", a.Args)
+	}
+
+	b := inputs[1]
+
+	if b.Path != "/abs/b.jpg" || b.Args.Has("title") || b.Args.Get("is_public") != "1" {
+		t.Fatalf("Unexpected input, %v %v", b, b.Args)
+	}
+
+	global := a.MergeArgs(nil)
+	global.Set("title", "Global")
+	global.Set("description", "Global")
+
+	merged := a.MergeArgs(global)
+
+	if merged.Get("title") != "Photo A" || merged.Get("description") != "Global" {
+		t.Fatalf("Expected per-file parameters to override global parameters, %v", merged)
+	}
+
+	inputs, err = ReadManifest(ctx, filepath.Join(root, "manifest.jsonl"))
+
+	if err != nil {
+		t.Fatalf("Failed to read JSONL manifest, %v", err)
+	}
+
+	c := inputs[0]
+
+	if c.Args.Get("tags") != "one two" || c.Args.Get("safety_level") != "2" || c.Args.Get("hidden") != "true" {
+		t.Fatalf("Unexpected arguments, %v", c.Args)
+	}
+
+	_, err = ReadManifest(ctx, filepath.Join(root, "manifest.txt"))
+
+	if err == nil {
+		t.Fatalf("Expected unsupported manifest to fail")
+	}
+}
+
+func TestUploadInputs(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	set := svr.Store.AddPhotoset(&flickrtest.Photoset{Owner: svr.UserId, Title: "Test"})
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+
+	body := fmt.Sprintf("path,title,photoset_id\na.jpg,Photo A,%d\n", set.Id)
+
+	err = os.WriteFile(filepath.Join(root, "manifest.csv"), []byte(body), 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to write manifest, %v", err)
+	}
+
+	err = os.WriteFile(filepath.Join(root, "a.jpg"), []byte("photo"), 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to write photo, %v", err)
+	}
+
+	inputs, err := ReadManifest(ctx, filepath.Join(root, "manifest.csv"))
+
+	if err != nil {
+		t.Fatalf("Failed to read manifest, %v", err)
+	}
+
+	up, err := NewUploader(ctx, &UploaderOptions{Client: cl})
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	for rsp := range up.UploadInputs(ctx, inputs) {
+
+		if rsp.Error != nil {
+			t.Fatalf("Failed to upload %s, %v", rsp.Path, rsp.Error)
+		}
+
+		ph, exists := svr.Store.GetPhoto(rsp.PhotoId)
+
+		if !exists {
+			t.Fatalf("Failed to retrieve photo %d", rsp.PhotoId)
+		}
+
+		if ph.Title != "Photo A" {
+			t.Fatalf("Unexpected title, %s", ph.Title)
+		}
+	}
+
+	set_id := set.Id
+
+	set, exists := svr.Store.GetPhotoset(set_id)
+
+	if !exists {
+		t.Fatalf("Failed to retrieve photoset %d", set_id)
+	}
+
+	if len(set.Photos) != 1 {
+		t.Fatalf("Expected photo to be added to photoset, %d", len(set.Photos))
+	}
+}
+
+// sharedBucketOpener implements the blob.BucketURLOpener interface returning the same in-memory bucket for every URI
+// so that tests can walk, and read from, a bucket with a scheme other than file://.
+type sharedBucketOpener struct {
+	bucket *blob.Bucket
+}
+
+func (o *sharedBucketOpener) OpenBucketURL(ctx context.Context, u *url.URL) (*blob.Bucket, error) {
+	// Wrap the shared bucket so that closing the bucket that is returned does not close the shared bucket
+	return blob.PrefixedBucket(o.bucket, ""), nil
+}
+
+var shared_bucket = memblob.OpenBucket(nil)
+var register_shared_bucket sync.Once
+
+func TestExpandPathsBucketPrefix(t *testing.T) {
+
+	ctx := context.Background()
+
+	register_shared_bucket.Do(func() {
+		blob.DefaultURLMux().RegisterBucket("sharedmem", &sharedBucketOpener{bucket: shared_bucket})
+	})
+
+	files := []string{
+		"photos/a.jpg",
+		"photos/notes.txt",
+		"photos/sub/b.jpg",
+		"other/c.jpg",
+	}
+
+	for _, key := range files {
+
+		err := shared_bucket.WriteAll(ctx, key, []byte(key), nil)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", key, err)
+		}
+	}
+
+	tests := []struct {
+		opts     *InputOptions
+		expected []string
+	}{
+		{
+			&InputOptions{Media: MEDIA_PHOTOS},
+			[]string{"sharedmem://bucket/photos/a.jpg", "sharedmem://bucket/photos/sub/b.jpg"},
+		},
+		{
+			&InputOptions{Exclude: []string{"sub/*"}},
+			[]string{"sharedmem://bucket/photos/a.jpg", "sharedmem://bucket/photos/notes.txt"},
+		},
+	}
+
+	for i, test := range tests {
+
+		inputs, err := ExpandPaths(ctx, []string{"sharedmem://bucket/photos/"}, test.opts)
+
+		if err != nil {
+			t.Fatalf("Failed to expand paths for test %d, %v", i, err)
+		}
+
+		paths := make([]string, len(inputs))
+
+		for j, in := range inputs {
+			paths[j] = in.Path
+		}
+
+		sort.Strings(paths)
+
+		if !slices.Equal(paths, test.expected) {
+			t.Fatalf("Unexpected paths for test %d, %v", i, paths)
+		}
+	}
+
+	fh, err := reader.NewReader(ctx, "sharedmem://bucket/photos/sub/b.jpg")
+
+	if err != nil {
+		t.Fatalf("Failed to create reader, %v", err)
+	}
+
+	defer fh.Close()
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil || string(body) != "photos/sub/b.jpg" {
+		t.Fatalf("Unexpected body, %s (%v)", string(body), err)
+	}
+}
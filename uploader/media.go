@@ -0,0 +1,252 @@
+package uploader
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The kind of media for photos.
+const MEDIA_TYPE_PHOTO string = "photo"
+
+// The kind of media for videos.
+const MEDIA_TYPE_VIDEO string = "video"
+
+// The JPEG image format.
+const FORMAT_JPEG string = "jpeg"
+
+// The PNG image format.
+const FORMAT_PNG string = "png"
+
+// The GIF image format.
+const FORMAT_GIF string = "gif"
+
+// The TIFF image format.
+const FORMAT_TIFF string = "tiff"
+
+// The HEIC (HEIF) image format.
+const FORMAT_HEIC string = "heic"
+
+// The MP4 (ISO base media file format) video format.
+const FORMAT_MP4 string = "mp4"
+
+// The QuickTime video format.
+const FORMAT_MOV string = "mov"
+
+// The 3GPP video format.
+const FORMAT_3GP string = "3gp"
+
+// The AVI video format.
+const FORMAT_AVI string = "avi"
+
+// The MPEG (program stream) video format.
+const FORMAT_MPEG string = "mpeg"
+
+// The MPEG transport stream (including AVCHD .mts and .m2ts files) video format.
+const FORMAT_MPEG_TS string = "mpeg-ts"
+
+// The Windows Media (ASF) video format.
+const FORMAT_WMV string = "wmv"
+
+// The Ogg video format.
+const FORMAT_OGV string = "ogv"
+
+// The number of bytes from the start of a file needed to detect its format.
+const SNIFF_LENGTH int = 512
+
+// The maximum size, in bytes, of the "moov" box read to derive the duration of ISO base media files.
+const MAX_MOOV_SIZE int64 = 64 << 20
+
+// SniffFormat returns the format (for example FORMAT_JPEG) and kind of media (MEDIA_TYPE_PHOTO or MEDIA_TYPE_VIDEO)
+// of a file derived from the first SNIFF_LENGTH bytes of its contents in 'head'. If the format is not supported by
+// the Flickr API empty strings are returned.
+func SniffFormat(head []byte) (string, string) {
+
+	switch {
+	case bytes.HasPrefix(head, []byte{0xff, 0xd8, 0xff}):
+		return FORMAT_JPEG, MEDIA_TYPE_PHOTO
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return FORMAT_PNG, MEDIA_TYPE_PHOTO
+	case bytes.HasPrefix(head, []byte("GIF87a")), bytes.HasPrefix(head, []byte("GIF89a")):
+		return FORMAT_GIF, MEDIA_TYPE_PHOTO
+	case bytes.HasPrefix(head, []byte("II*\x00")), bytes.HasPrefix(head, []byte("MM\x00*")):
+		return FORMAT_TIFF, MEDIA_TYPE_PHOTO
+	case len(head) >= 12 && string(head[4:8]) == "ftyp":
+		return sniffISOFormat(head)
+	case len(head) >= 12 && string(head[0:4]) == "RIFF" && string(head[8:12]) == "AVI ":
+		return FORMAT_AVI, MEDIA_TYPE_VIDEO
+	case bytes.HasPrefix(head, []byte{0x00, 0x00, 0x01, 0xba}), bytes.HasPrefix(head, []byte{0x00, 0x00, 0x01, 0xb3}):
+		return FORMAT_MPEG, MEDIA_TYPE_VIDEO
+	case bytes.HasPrefix(head, []byte{0x30, 0x26, 0xb2, 0x75, 0x8e, 0x66, 0xcf, 0x11}):
+		return FORMAT_WMV, MEDIA_TYPE_VIDEO
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return FORMAT_OGV, MEDIA_TYPE_VIDEO
+	case len(head) > 188 && head[0] == 0x47 && head[188] == 0x47:
+		return FORMAT_MPEG_TS, MEDIA_TYPE_VIDEO
+	case len(head) > 196 && head[4] == 0x47 && head[196] == 0x47:
+		return FORMAT_MPEG_TS, MEDIA_TYPE_VIDEO
+	}
+
+	return "", ""
+}
+
+// sniffISOFormat returns the format and kind of media for an ISO base media file derived from the brands in its "ftyp" box.
+func sniffISOFormat(head []byte) (string, string) {
+
+	size := int(binary.BigEndian.Uint32(head[0:4]))
+	size = max(12, min(size, len(head)))
+
+	brands := []string{string(head[8:12])}
+
+	for i := 16; i+4 <= size; i += 4 {
+		brands = append(brands, string(head[i:i+4]))
+	}
+
+	for _, brand := range brands {
+
+		switch brand {
+		case "heic", "heix", "hevc", "hevx", "heim", "heis", "mif1", "msf1":
+			return FORMAT_HEIC, MEDIA_TYPE_PHOTO
+		}
+	}
+
+	major := brands[0]
+
+	switch {
+	case major == "qt  ":
+		return FORMAT_MOV, MEDIA_TYPE_VIDEO
+	case major[0:3] == "3gp" || major[0:3] == "3g2":
+		return FORMAT_3GP, MEDIA_TYPE_VIDEO
+	default:
+		return FORMAT_MP4, MEDIA_TYPE_VIDEO
+	}
+}
+
+// isoDuration returns the duration of the ISO base media file (MP4, QuickTime or 3GPP) in 'r', whose size is 'size',
+// derived from the "mvhd" box inside its "moov" box.
+func isoDuration(r io.ReadSeeker, size int64) (time.Duration, error) {
+
+	var offset int64
+
+	for offset < size {
+
+		box_type, header_size, box_size, err := readBoxHeader(r, offset, size)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if box_type != "moov" {
+			offset += box_size
+			continue
+		}
+
+		body_size := box_size - header_size
+
+		if body_size > MAX_MOOV_SIZE {
+			return 0, fmt.Errorf("moov box is too large")
+		}
+
+		body := make([]byte, body_size)
+
+		_, err = io.ReadFull(r, body)
+
+		if err != nil {
+			return 0, fmt.Errorf("Failed to read moov box, %w", err)
+		}
+
+		return mvhdDuration(body)
+	}
+
+	return 0, fmt.Errorf("Missing moov box")
+}
+
+// readBoxHeader seeks 'r' to the box at 'offset' and reads its header returning the box's type, the size of its
+// header and the size of the entire box. 'size' is the size of the file.
+func readBoxHeader(r io.ReadSeeker, offset int64, size int64) (string, int64, int64, error) {
+
+	_, err := r.Seek(offset, io.SeekStart)
+
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	hdr := make([]byte, 16)
+
+	_, err = io.ReadFull(r, hdr[0:8])
+
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("Failed to read box header, %w", err)
+	}
+
+	box_type := string(hdr[4:8])
+	box_size := int64(binary.BigEndian.Uint32(hdr[0:4]))
+	header_size := int64(8)
+
+	switch box_size {
+	case 0:
+		box_size = size - offset
+	case 1:
+
+		_, err = io.ReadFull(r, hdr[8:16])
+
+		if err != nil {
+			return "", 0, 0, fmt.Errorf("Failed to read box size, %w", err)
+		}
+
+		box_size = int64(binary.BigEndian.Uint64(hdr[8:16]))
+		header_size = 16
+	}
+
+	if box_size < header_size || offset+box_size > size {
+		return "", 0, 0, fmt.Errorf("Invalid size for %s box", box_type)
+	}
+
+	return box_type, header_size, box_size, nil
+}
+
+// mvhdDuration returns the duration in the "mvhd" box contained in the body of a "moov" box.
+func mvhdDuration(moov []byte) (time.Duration, error) {
+
+	for i := 0; i+8 <= len(moov); {
+
+		box_size := int(binary.BigEndian.Uint32(moov[i : i+4]))
+		box_type := string(moov[i+4 : i+8])
+
+		if box_size < 8 || i+box_size > len(moov) {
+			break
+		}
+
+		if box_type != "mvhd" {
+			i += box_size
+			continue
+		}
+
+		body := moov[i+8 : i+box_size]
+
+		var timescale uint64
+		var duration uint64
+
+		switch {
+		case len(body) >= 20 && body[0] == 0:
+			timescale = uint64(binary.BigEndian.Uint32(body[12:16]))
+			duration = uint64(binary.BigEndian.Uint32(body[16:20]))
+		case len(body) >= 32 && body[0] == 1:
+			timescale = uint64(binary.BigEndian.Uint32(body[20:24]))
+			duration = binary.BigEndian.Uint64(body[24:32])
+		default:
+			return 0, fmt.Errorf("Invalid mvhd box")
+		}
+
+		if timescale == 0 {
+			return 0, fmt.Errorf("Invalid mvhd timescale")
+		}
+
+		seconds := float64(duration) / float64(timescale)
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	return 0, fmt.Errorf("Missing mvhd box")
+}
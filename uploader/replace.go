@@ -0,0 +1,177 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/reader"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// ParseReplacePairs returns the list of Inputs for 'pairs' where each pair is a string in the form of "{PATH}={PHOTO_ID}",
+// assigning PHOTO_ID to the "photo_id" API parameter for PATH. Strings that do not end in "={PHOTO_ID}" are treated as
+// paths without a photo ID, in which case the photo ID must be provided by the Uploader's parameters.
+func ParseReplacePairs(pairs []string) ([]*Input, error) {
+
+	inputs := make([]*Input, len(pairs))
+
+	for i, pair := range pairs {
+
+		in := &Input{
+			Path: pair,
+			Args: &url.Values{},
+		}
+
+		idx := strings.LastIndex(pair, "=")
+
+		if idx != -1 {
+
+			photo_id, err := strconv.ParseInt(pair[idx+1:], 10, 64)
+
+			if err == nil {
+
+				if pair[:idx] == "" {
+					return nil, fmt.Errorf("Invalid pair '%s', missing path", pair)
+				}
+
+				in.Path = pair[:idx]
+				in.Args.Set("photo_id", strconv.FormatInt(photo_id, 10))
+			}
+		}
+
+		inputs[i] = in
+	}
+
+	return inputs, nil
+}
+
+// ExpandReplacePairs returns the list of Inputs for 'pairs', parsed using ParseReplacePairs. Pairs with a photo ID are
+// included as-is. Paths without a photo ID are expanded using ExpandPaths, so that directories are walked recursively and
+// filtered according to 'opts', in which case the photo ID must be provided by the Uploader's parameters. 'opts' may be nil.
+func ExpandReplacePairs(ctx context.Context, pairs []string, opts *InputOptions) ([]*Input, error) {
+
+	pair_inputs, err := ParseReplacePairs(pairs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*Input, 0)
+
+	for _, in := range pair_inputs {
+
+		if in.Args.Has("photo_id") {
+			inputs = append(inputs, in)
+			continue
+		}
+
+		path_inputs, err := ExpandPaths(ctx, []string{in.Path}, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		inputs = append(inputs, path_inputs...)
+	}
+
+	return inputs, nil
+}
+
+// ReplaceInputs returns an iterator that replaces the photo identified by the "photo_id" parameter of each of 'inputs'
+// with the file for that Input, using up to the Uploader's number of workers concurrently, and yields the result of each
+// replacement as soon as it completes. If the Uploader was created with the ReplaceAsync option each replacement is
+// performed asynchronously using the client.ReplaceAsyncWithClient method. Replacements are not recorded in the Uploader's
+// ledger and post-upload actions are not applied to replaced photos.
+func (u *Uploader) ReplaceInputs(ctx context.Context, inputs []*Input) iter.Seq[*UploadResult] {
+	return u.process(ctx, inputs, u.replaceInput)
+}
+
+// replaceInput replaces the photo identified by the "photo_id" parameter of 'in' with the file for 'in'.
+func (u *Uploader) replaceInput(ctx context.Context, in *Input) *UploadResult {
+
+	path := in.Path
+
+	rsp := &UploadResult{
+		Path: path,
+	}
+
+	replace_args := in.MergeArgs(u.args)
+
+	photo_id, err := strconv.ParseInt(replace_args.Get("photo_id"), 10, 64)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Invalid or missing photo ID for '%s'", path)}
+		return rsp
+	}
+
+	fh, err := reader.NewReader(ctx, path)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to create reader for '%s', %v", path, err)}
+		return rsp
+	}
+
+	defer fh.Close()
+
+	r, size, err := readSeeker(fh)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to read '%s', %v", path, err)}
+		return rsp
+	}
+
+	body := &client.UploadFile{
+		Reader:   r,
+		FileName: fileName(path),
+		Size:     size,
+	}
+
+	replace_ctx := u.uploadContext(ctx)
+
+	if u.replace_async {
+
+		replaced_id, err := client.ReplaceAsyncWithClient(replace_ctx, u.client, body, replace_args)
+
+		if err != nil {
+			rsp.Error = &UploadError{fmt.Errorf("Failed to replace photo %d with '%s', %v", photo_id, path, err)}
+			return rsp
+		}
+
+		rsp.PhotoId = replaced_id
+		return rsp
+	}
+
+	replace_rsp, err := u.client.Replace(replace_ctx, body, replace_args)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to replace photo %d with '%s', %v", photo_id, path, err)}
+		return rsp
+	}
+
+	defer replace_rsp.Close()
+
+	up, err := response.UnmarshalUploadResponse(replace_rsp)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to unmarshal replace response for '%s', %v", path, err)}
+		return rsp
+	}
+
+	if up.Error != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to replace photo %d with '%s', %v", photo_id, path, up.Error)}
+		return rsp
+	}
+
+	if up.Photo == nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to replace photo %d with '%s', missing photo ID", photo_id, path)}
+		return rsp
+	}
+
+	rsp.PhotoId = up.Photo.Id
+	return rsp
+}
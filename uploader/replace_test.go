@@ -0,0 +1,226 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+)
+
+func TestParseReplacePairs(t *testing.T) {
+
+	inputs, err := ParseReplacePairs([]string{"/tmp/a.jpg=123", "/tmp/b=c.jpg=456", "/tmp/d.jpg"})
+
+	if err != nil {
+		t.Fatalf("Failed to parse pairs, %v", err)
+	}
+
+	tests := []struct {
+		path     string
+		photo_id string
+	}{
+		{"/tmp/a.jpg", "123"},
+		{"/tmp/b=c.jpg", "456"},
+		{"/tmp/d.jpg", ""},
+	}
+
+	for i, test := range tests {
+
+		if inputs[i].Path != test.path || inputs[i].Args.Get("photo_id") != test.photo_id {
+			t.Fatalf("Unexpected input %d, %s (%s)", i, inputs[i].Path, inputs[i].Args.Get("photo_id"))
+		}
+	}
+
+	_, err = ParseReplacePairs([]string{"=123"})
+
+	if err == nil {
+		t.Fatalf("Expected pair without path to fail")
+	}
+}
+
+func TestReplaceInputs(t *testing.T) {
+
+	ctx := context.Background()
+
+	for _, async := range []bool{false, true} {
+
+		svr := flickrtest.NewServer()
+		defer svr.Close()
+
+		cl, err := client.NewClient(ctx, svr.ClientURI())
+
+		if err != nil {
+			t.Fatalf("Failed to create client, %v", err)
+		}
+
+		root := t.TempDir()
+		pairs := make([]string, 0)
+
+		for i := 0; i < 3; i++ {
+
+			ph := svr.Store.AddPhoto(&flickrtest.Photo{
+				Owner: svr.UserId,
+				Body:  []byte("original"),
+			})
+
+			path := filepath.Join(root, fmt.Sprintf("%d.jpg", i))
+
+			err := os.WriteFile(path, []byte(fmt.Sprintf("replacement %d", i)), 0644)
+
+			if err != nil {
+				t.Fatalf("Failed to write %s, %v", path, err)
+			}
+
+			pairs = append(pairs, fmt.Sprintf("%s=%d", path, ph.Id))
+		}
+
+		pairs = append(pairs, filepath.Join(root, "missing.jpg")+"=1")
+		pairs = append(pairs, filepath.Join(root, "0.jpg")+"=999999")
+
+		inputs, err := ParseReplacePairs(pairs)
+
+		if err != nil {
+			t.Fatalf("Failed to parse pairs, %v", err)
+		}
+
+		opts := &UploaderOptions{
+			Client:       cl,
+			Workers:      2,
+			ReplaceAsync: async,
+		}
+
+		up, err := NewUploader(ctx, opts)
+
+		if err != nil {
+			t.Fatalf("Failed to create uploader, %v", err)
+		}
+
+		replaced := 0
+		failed := 0
+
+		for rsp := range up.ReplaceInputs(ctx, inputs) {
+
+			if rsp.Error != nil {
+				failed += 1
+				continue
+			}
+
+			ph, exists := svr.Store.GetPhoto(rsp.PhotoId)
+
+			if !exists {
+				t.Fatalf("Replaced photo %d not found", rsp.PhotoId)
+			}
+
+			if !bytes.HasPrefix(ph.Body, []byte("replacement")) {
+				t.Fatalf("Photo %d was not replaced (async: %t)", rsp.PhotoId, async)
+			}
+
+			replaced += 1
+		}
+
+		if replaced != 3 || failed != 2 {
+			t.Fatalf("Unexpected results (async: %t), %d replaced, %d failed", async, replaced, failed)
+		}
+
+		p := up.Progress()
+
+		if p.Done != 5 || p.Failed != 2 {
+			t.Fatalf("Unexpected progress (async: %t), %s", async, p)
+		}
+	}
+}
+
+func TestExpandReplacePairs(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	ph := svr.Store.AddPhoto(&flickrtest.Photo{
+		Owner: svr.UserId,
+		Body:  []byte("original"),
+	})
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "photos")
+
+	err = os.MkdirAll(filepath.Join(dir, "drafts"), 0755)
+
+	if err != nil {
+		t.Fatalf("Failed to create directory, %v", err)
+	}
+
+	files := map[string]string{
+		"photos/cat.jpg":        "replacement",
+		"photos/notes.txt":      "notes",
+		"photos/drafts/cat.jpg": "draft",
+		"dog.jpg":               "dog",
+	}
+
+	for rel_path, body := range files {
+
+		err := os.WriteFile(filepath.Join(root, rel_path), []byte(body), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", rel_path, err)
+		}
+	}
+
+	opts := &InputOptions{
+		Include: []string{"*.jpg"},
+		Exclude: []string{"drafts/*"},
+	}
+
+	inputs, err := ExpandReplacePairs(ctx, []string{dir, filepath.Join(root, "dog.jpg") + "=123"}, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to expand pairs, %v", err)
+	}
+
+	if len(inputs) != 2 {
+		t.Fatalf("Unexpected number of inputs, %d", len(inputs))
+	}
+
+	if filepath.Base(inputs[0].Path) != "cat.jpg" || inputs[0].Args.Get("photo_id") != "" {
+		t.Fatalf("Unexpected input for directory, %s (%s)", inputs[0].Path, inputs[0].Args.Get("photo_id"))
+	}
+
+	if inputs[1].Path != filepath.Join(root, "dog.jpg") || inputs[1].Args.Get("photo_id") != "123" {
+		t.Fatalf("Unexpected input for pair, %s (%s)", inputs[1].Path, inputs[1].Args.Get("photo_id"))
+	}
+
+	// Files in a directory replace the photo ID in the Uploader's parameters
+
+	up, err := NewUploader(ctx, &UploaderOptions{Client: cl, Args: &url.Values{"photo_id": []string{strconv.FormatInt(ph.Id, 10)}}})
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	for rsp := range up.ReplaceInputs(ctx, inputs[0:1]) {
+
+		if rsp.Error != nil {
+			t.Fatalf("Failed to replace %s, %v", rsp.Path, rsp.Error)
+		}
+	}
+
+	ph, _ = svr.Store.GetPhoto(ph.Id)
+
+	if string(ph.Body) != "replacement" {
+		t.Fatalf("Photo was not replaced")
+	}
+}
@@ -0,0 +1,766 @@
+// package uploader provides a bounded pool of workers for uploading many files to Flickr, optionally recording
+// (and resuming) the status of each upload in a ledger, that streams the result of each upload as it completes.
+package uploader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/ledger"
+	"github.com/aaronland/go-flickr-api/metadata"
+	"github.com/aaronland/go-flickr-api/reader"
+	"github.com/aaronland/go-flickr-api/response"
+)
+
+// The default number of files to upload concurrently.
+const DEFAULT_WORKERS int = 4
+
+// UploadResult is struct containing information about an atomic upload.
+type UploadResult struct {
+	// The URI of file that was uploaded.
+	Path string `json:"path,omitempty"`
+	// The Photo ID of a successfully uploaded file.
+	PhotoId int64 `json:"photoid,omitempty"`
+	// A boolean flag indicating the file was not uploaded because the ledger shows it has already been uploaded.
+	Skipped bool `json:"skipped,omitempty"`
+	// A boolean flag indicating the file was not uploaded because a file with the same contents has already been
+	// uploaded, in which case PhotoId is the ID of the existing photo.
+	Duplicate bool `json:"duplicate,omitempty"`
+	// An UploadError instance if the file was not able to be uploaded.
+	Error *UploadError `json:"error,omitempty"`
+	// Zero or more problems which did not prevent the file from being uploaded, for example failing to extract its metadata.
+	Warnings []string `json:"warnings,omitempty"`
+	// The results of the post-upload actions applied to the photo, including any that failed.
+	Actions []*ActionResult `json:"actions,omitempty"`
+}
+
+// UploadError is a custom error type that can be JSON-serialized.
+type UploadError struct {
+	error
+}
+
+// The error message associated with this instance.
+func (e *UploadError) Error() string {
+	return e.error.Error()
+}
+
+// The error message associated with this instance.
+func (e *UploadError) String() string {
+	return e.Error()
+}
+
+// Return the underlying error so that UploadError instances can be used with errors.Is and errors.As.
+func (e *UploadError) Unwrap() error {
+	return e.error
+}
+
+// This error instance serialized as a string for JSON-marshaling.
+func (e *UploadError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Error())
+}
+
+// Progress is a struct containing a snapshot of the progress of an Uploader instance.
+type Progress struct {
+	// The total number of files to upload.
+	Files int64
+	// The number of files that have been processed, whether they were uploaded, skipped or failed.
+	Done int64
+	// The number of files that failed to upload.
+	Failed int64
+	// The number of files that were skipped because they had already been uploaded.
+	Skipped int64
+	// The number of files that were not uploaded because they were duplicates of photos that had already been uploaded.
+	Duplicates int64
+	// The number of bytes sent to the Flickr API.
+	BytesSent int64
+	// The amount of time since the uploads started.
+	Elapsed time.Duration
+}
+
+// ETA returns the estimated amount of time until all the files have been processed, based on the average time
+// taken to process each file so far. If no files have been processed yet it returns -1.
+func (p *Progress) ETA() time.Duration {
+
+	if p.Done == 0 {
+		return -1
+	}
+
+	per_file := p.Elapsed / time.Duration(p.Done)
+	return per_file * time.Duration(p.Files-p.Done)
+}
+
+// Return the progress as a human-readable string.
+func (p *Progress) String() string {
+
+	eta := "unknown"
+
+	if p.ETA() >= 0 {
+		eta = p.ETA().Round(time.Second).String()
+	}
+
+	return fmt.Sprintf("%d/%d files processed (%d skipped, %d duplicates, %d failed), %.2f MB sent, ETA %s", p.Done, p.Files, p.Skipped, p.Duplicates, p.Failed, float64(p.BytesSent)/1024/1024, eta)
+}
+
+// Bar returns the progress as a single-line progress bar, 'width' characters wide, followed by the number of files
+// processed, the average upload speed and the estimated time remaining.
+func (p *Progress) Bar(width int) string {
+
+	filled := 0
+
+	if p.Files > 0 {
+		filled = int(int64(width) * p.Done / p.Files)
+	}
+
+	filled = max(0, min(width, filled))
+
+	rate := 0.0
+
+	if p.Elapsed > 0 {
+		rate = float64(p.BytesSent) / 1024 / 1024 / p.Elapsed.Seconds()
+	}
+
+	eta := "unknown"
+
+	if p.ETA() >= 0 {
+		eta = p.ETA().Round(time.Second).String()
+	}
+
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", width-filled)
+	return fmt.Sprintf("[%s] %d/%d files (%d failed), %.2f MB sent, %.2f MB/s, ETA %s", bar, p.Done, p.Files, p.Failed, float64(p.BytesSent)/1024/1024, rate, eta)
+}
+
+// UploaderOptions is a struct containing configuration details for a new Uploader instance.
+type UploaderOptions struct {
+	// The Client used to upload files.
+	Client client.Client
+	// An optional ledger.Ledger used to record the status of each upload. If present files that have already been
+	// uploaded are skipped and outstanding asynchronous upload tickets are resumed rather than being uploaded again.
+	Ledger ledger.Ledger
+	// The number of files to upload concurrently. If 0 DEFAULT_WORKERS is used.
+	Workers int
+	// Zero or more Flickr API parameters to include with each upload.
+	Args *url.Values
+	// If true each file is tagged with a machine tag containing its SHA-256 hash (see HashMachineTag) and files whose
+	// hash matches a completed upload in the ledger, or an existing photo with the same machine tag, are reported as
+	// duplicates rather than being uploaded again.
+	Dedup bool
+	// An optional metadata.Mapping used to derive upload parameters, and API methods to call once each file has been
+	// uploaded, from the EXIF, XMP and IPTC metadata embedded in each file. Parameters specific to each file and Args
+	// take precedence over parameters derived from embedded metadata.
+	Metadata *metadata.Mapping
+	// An optional client.TicketPoller used to check the status of asynchronous upload tickets, for example to share a poller
+	// between Uploader instances. If nil a new TicketPoller is created for each call to the Upload or UploadInputs methods.
+	TicketPoller *client.TicketPoller
+	// Zero or more post-upload actions to apply to each photo once it has been uploaded. Actions are not applied to files
+	// that are skipped or that are duplicates. If a ledger is present uploads whose actions fail are recorded as failed, with
+	// their photo ID, and only their actions are retried the next time.
+	Actions []Action
+	// The maximum number of bytes per second to send, shared by all the workers. If 0 bandwidth is not limited.
+	MaxBandwidth int64
+	// Optional Limits used to validate each file, and its upload parameters, before it is uploaded. Files that fail
+	// validation are not uploaded. See also ValidateInput.
+	Limits *Limits
+	// If true photos are replaced asynchronously, waiting for each replacement's upload ticket to complete, by the
+	// ReplaceInputs method.
+	ReplaceAsync bool
+}
+
+// Uploader uploads files to Flickr using a bounded pool of workers.
+type Uploader struct {
+	client        client.Client
+	ledger        ledger.Ledger
+	workers       int
+	args          *url.Values
+	dedup         bool
+	metadata      *metadata.Mapping
+	actions       []Action
+	poller        *client.TicketPoller
+	replace_async bool
+	limiter       *client.RateLimiter
+	limits        *Limits
+	started       time.Time
+	files         atomic.Int64
+	done          atomic.Int64
+	failed        atomic.Int64
+	skipped       atomic.Int64
+	duplicates    atomic.Int64
+	bytes_sent    atomic.Int64
+}
+
+// NewUploader returns a new Uploader instance configured by 'opts'.
+func NewUploader(ctx context.Context, opts *UploaderOptions) (*Uploader, error) {
+
+	if opts.Client == nil {
+		return nil, fmt.Errorf("Missing client")
+	}
+
+	workers := opts.Workers
+
+	if workers == 0 {
+		workers = DEFAULT_WORKERS
+	}
+
+	if workers < 0 {
+		return nil, fmt.Errorf("Invalid number of workers")
+	}
+
+	args := opts.Args
+
+	if args == nil {
+		args = &url.Values{}
+	}
+
+	var limiter *client.RateLimiter
+
+	if opts.MaxBandwidth < 0 {
+		return nil, fmt.Errorf("Invalid maximum bandwidth")
+	}
+
+	if opts.MaxBandwidth > 0 {
+
+		l, err := client.NewBandwidthLimiter(opts.MaxBandwidth)
+
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create bandwidth limiter, %w", err)
+		}
+
+		limiter = l
+	}
+
+	u := &Uploader{
+		client:        opts.Client,
+		ledger:        opts.Ledger,
+		workers:       workers,
+		args:          args,
+		dedup:         opts.Dedup,
+		metadata:      opts.Metadata,
+		actions:       opts.Actions,
+		poller:        opts.TicketPoller,
+		replace_async: opts.ReplaceAsync,
+		limiter:       limiter,
+		limits:        opts.Limits,
+		started:       time.Now(),
+	}
+
+	return u, nil
+}
+
+// Upload returns an iterator that uploads each of 'paths', using up to the Uploader's number of workers concurrently,
+// and yields the result of each upload as soon as it completes (so results are not necessarily yielded in the same
+// order as 'paths'). Breaking out of the loop cancels any uploads still in progress.
+func (u *Uploader) Upload(ctx context.Context, paths []string) iter.Seq[*UploadResult] {
+
+	inputs := make([]*Input, len(paths))
+
+	for i, p := range paths {
+		inputs[i] = &Input{Path: p}
+	}
+
+	return u.UploadInputs(ctx, inputs)
+}
+
+// UploadInputs returns an iterator that uploads each of 'inputs', using the parameters specific to each Input in
+// addition to (or overriding) the Uploader's parameters, and yields the result of each upload as soon as it completes.
+// See also the Upload method.
+func (u *Uploader) UploadInputs(ctx context.Context, inputs []*Input) iter.Seq[*UploadResult] {
+
+	return func(yield func(*UploadResult) bool) {
+
+		// All the workers share a single poller so that outstanding upload tickets are checked in batches.
+
+		poller := u.poller
+
+		if poller == nil {
+
+			p, err := client.NewTicketPoller(ctx, u.client, nil)
+
+			if err != nil {
+				yield(&UploadResult{Error: &UploadError{fmt.Errorf("Failed to create ticket poller, %v", err)}})
+				return
+			}
+
+			defer p.Close()
+			poller = p
+		}
+
+		upload := func(ctx context.Context, in *Input) *UploadResult {
+			return u.uploadInput(ctx, poller, in)
+		}
+
+		for rsp := range u.process(ctx, inputs, upload) {
+
+			if !yield(rsp) {
+				return
+			}
+		}
+	}
+}
+
+// process returns an iterator that invokes 'fn' for each of 'inputs', using up to the Uploader's number of workers
+// concurrently, updating the Uploader's progress and yielding each result as soon as it completes. Breaking out of
+// the loop cancels the context passed to 'fn'.
+func (u *Uploader) process(ctx context.Context, inputs []*Input, fn func(context.Context, *Input) *UploadResult) iter.Seq[*UploadResult] {
+
+	return func(yield func(*UploadResult) bool) {
+
+		process_ctx, cancel := context.WithCancel(ctx)
+
+		u.files.Add(int64(len(inputs)))
+
+		inputs_ch := make(chan *Input)
+		results_ch := make(chan *UploadResult)
+
+		wg := new(sync.WaitGroup)
+
+		for i := 0; i < min(u.workers, len(inputs)); i++ {
+
+			wg.Add(1)
+
+			go func() {
+
+				defer wg.Done()
+
+				for in := range inputs_ch {
+
+					rsp := fn(process_ctx, in)
+
+					u.done.Add(1)
+
+					switch {
+					case rsp.Error != nil:
+						u.failed.Add(1)
+					case rsp.Skipped:
+						u.skipped.Add(1)
+					case rsp.Duplicate:
+						u.duplicates.Add(1)
+					}
+
+					results_ch <- rsp
+				}
+			}()
+		}
+
+		go func() {
+
+			defer close(inputs_ch)
+
+			for _, in := range inputs {
+
+				select {
+				case inputs_ch <- in:
+					// pass
+				case <-process_ctx.Done():
+					return
+				}
+			}
+		}()
+
+		go func() {
+			wg.Wait()
+			close(results_ch)
+		}()
+
+		// Ensure that all the workers have stopped before returning.
+
+		defer func() {
+
+			cancel()
+
+			for range results_ch {
+				// pass
+			}
+		}()
+
+		for rsp := range results_ch {
+
+			if !yield(rsp) {
+				return
+			}
+		}
+	}
+}
+
+// Progress returns a snapshot of the progress of the Uploader.
+func (u *Uploader) Progress() *Progress {
+
+	p := &Progress{
+		Files:      u.files.Load(),
+		Done:       u.done.Load(),
+		Failed:     u.failed.Load(),
+		Skipped:    u.skipped.Load(),
+		Duplicates: u.duplicates.Load(),
+		BytesSent:  u.bytes_sent.Load(),
+		Elapsed:    time.Since(u.started),
+	}
+
+	return p
+}
+
+// uploadInput uploads the file for 'in', recording its status in the Uploader's ledger if it is not nil. Files that fail
+// validation against the Uploader's Limits, if present, are not uploaded. If the ledger shows that the file has already
+// been uploaded it is skipped and if it shows an outstanding asynchronous upload ticket for the file that ticket is
+// resumed rather than uploading the file again. If deduplication is enabled files that are duplicates of existing photos
+// are not uploaded. Once uploaded the photo is added to the photoset for 'in', if present, and the Uploader's post-upload
+// actions and any API methods derived from its embedded metadata are applied to it.
+func (u *Uploader) uploadInput(ctx context.Context, poller *client.TicketPoller, in *Input) *UploadResult {
+
+	path := in.Path
+
+	rsp := &UploadResult{
+		Path: path,
+	}
+
+	fh, err := reader.NewReader(ctx, path)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to create reader for '%s', %v", path, err)}
+		return rsp
+	}
+
+	defer fh.Close()
+
+	// The file is read more than once (to validate it, hash it, extract its metadata and upload it) so rather than
+	// reading it in to memory it is rewound before each read.
+
+	r, size, err := readSeeker(fh)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to read '%s', %v", path, err)}
+		return rsp
+	}
+
+	rewind := func() error {
+
+		_, err := r.Seek(0, io.SeekStart)
+
+		if err != nil {
+			return fmt.Errorf("Failed to rewind '%s', %v", path, err)
+		}
+
+		return nil
+	}
+
+	if u.limits != nil {
+
+		v := &ValidationResult{
+			Path:     path,
+			Problems: make([]string, 0),
+		}
+
+		validateMedia(v, r, size, u.limits)
+		v.Problems = append(v.Problems, ValidateArgs(in.MergeArgs(u.args))...)
+
+		if !v.Valid() {
+			rsp.Error = &UploadError{fmt.Errorf("Invalid file '%s', %s", path, strings.Join(v.Problems, "; "))}
+			return rsp
+		}
+
+		err := rewind()
+
+		if err != nil {
+			rsp.Error = &UploadError{err}
+			return rsp
+		}
+	}
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, r)
+
+	if err != nil {
+		rsp.Error = &UploadError{fmt.Errorf("Failed to read '%s', %v", path, err)}
+		return rsp
+	}
+
+	err = rewind()
+
+	if err != nil {
+		rsp.Error = &UploadError{err}
+		return rsp
+	}
+
+	e := &ledger.Entry{
+		Path: path,
+		Hash: hex.EncodeToString(h.Sum(nil)),
+	}
+
+	actions := make([]Action, 0)
+
+	if in.PhotosetId != "" {
+		actions = append(actions, NewAddToPhotosetAction(in.PhotosetId))
+	}
+
+	actions = append(actions, u.actions...)
+
+	var md *metadata.Metadata
+
+	if u.metadata != nil {
+
+		md, err = metadata.ExtractReader(r, size)
+
+		// Files with malformed metadata are still uploaded, using only the upload parameters and manifest values.
+
+		if err != nil {
+			slog.Warn("Failed to extract metadata", "path", path, "error", err)
+			rsp.Warnings = append(rsp.Warnings, fmt.Sprintf("Failed to extract metadata from '%s', %v", path, err))
+			md = nil
+		}
+
+		err = rewind()
+
+		if err != nil {
+			rsp.Error = &UploadError{err}
+			return rsp
+		}
+
+		if md != nil {
+
+			method_args, err := u.metadata.MethodArgs(md)
+
+			if err != nil {
+				rsp.Error = &UploadError{fmt.Errorf("Failed to derive API methods from metadata for '%s', %v", path, err)}
+				return rsp
+			}
+
+			for _, args := range method_args {
+				actions = append(actions, &MethodAction{Args: args})
+			}
+		}
+	}
+
+	// record updates the ledger, if present, and returns the final result for the upload.
+
+	record := func(status string, err error) *UploadResult {
+
+		e.Status = status
+		e.LastModified = 0
+
+		if err != nil {
+			e.Error = err.Error()
+			rsp.Error = &UploadError{err}
+		}
+
+		if u.ledger != nil {
+
+			// Record the status even if the upload was interrupted by cancelling the context.
+
+			put_err := u.ledger.Put(context.WithoutCancel(ctx), e)
+
+			if put_err != nil && rsp.Error == nil {
+				rsp.Error = &UploadError{fmt.Errorf("Failed to record upload for '%s', %v", path, put_err)}
+			}
+		}
+
+		rsp.PhotoId = e.PhotoId
+		return rsp
+	}
+
+	if u.ledger != nil {
+
+		prev, err := u.ledger.Get(ctx, path)
+
+		switch {
+		case err == ledger.ErrNotFound:
+			// pass
+		case err != nil:
+			rsp.Error = &UploadError{fmt.Errorf("Failed to retrieve ledger entry for '%s', %v", path, err)}
+			return rsp
+		case prev.Hash != e.Hash:
+			// The file has changed since it was last uploaded
+		case prev.Status == ledger.STATUS_COMPLETE:
+			rsp.PhotoId = prev.PhotoId
+			rsp.Skipped = true
+			return rsp
+		case prev.PhotoId != 0:
+			// The file was uploaded but one or more of its actions failed so only the actions are retried
+			e.PhotoId = prev.PhotoId
+		case prev.Status == ledger.STATUS_PENDING && prev.TicketId != "":
+			e.TicketId = prev.TicketId
+		}
+	}
+
+	if e.PhotoId == 0 && e.TicketId == "" && u.dedup {
+
+		photo_id, err := u.findDuplicate(ctx, e.Hash)
+
+		if err != nil {
+			rsp.Error = &UploadError{fmt.Errorf("Failed to check for duplicates of '%s', %v", path, err)}
+			return rsp
+		}
+
+		if photo_id != 0 {
+			e.PhotoId = photo_id
+			rsp = record(ledger.STATUS_COMPLETE, nil)
+			rsp.Duplicate = true
+			return rsp
+		}
+	}
+
+	if e.PhotoId == 0 && e.TicketId == "" {
+
+		upload_args := in.MergeArgs(u.args)
+
+		if md != nil {
+
+			md_args, err := u.metadata.UploadArgs(md)
+
+			if err != nil {
+				return record(ledger.STATUS_FAILED, fmt.Errorf("Failed to derive upload parameters from metadata for '%s', %v", path, err))
+			}
+
+			for k, v := range *md_args {
+
+				if !upload_args.Has(k) {
+					(*upload_args)[k] = v
+				}
+			}
+		}
+
+		upload_args.Set("async", "1")
+
+		if u.dedup {
+			appendTag(upload_args, HashMachineTag(e.Hash))
+		}
+
+		upload_fh := &client.UploadFile{
+			Reader:   r,
+			FileName: fileName(path),
+			Size:     size,
+		}
+
+		upload_rsp, err := u.client.Upload(u.uploadContext(ctx), upload_fh, upload_args)
+
+		if err != nil {
+			return record(ledger.STATUS_FAILED, fmt.Errorf("Failed to upload image '%s', %v", path, err))
+		}
+
+		ticket, err := response.UnmarshalUploadTicketResponse(upload_rsp)
+
+		upload_rsp.Close()
+
+		if err != nil {
+			return record(ledger.STATUS_FAILED, fmt.Errorf("Failed to unmarshal upload response for '%s', %v", path, err))
+		}
+
+		if ticket.Error != nil {
+			return record(ledger.STATUS_FAILED, fmt.Errorf("Failed to upload image '%s', %v", path, ticket.Error))
+		}
+
+		if ticket.TicketId == "" {
+			return record(ledger.STATUS_FAILED, fmt.Errorf("Failed to upload image '%s', missing ticket ID", path))
+		}
+
+		e.TicketId = ticket.TicketId
+
+		pending_rsp := record(ledger.STATUS_PENDING, nil)
+
+		if pending_rsp.Error != nil {
+			return pending_rsp
+		}
+	}
+
+	if e.PhotoId == 0 {
+
+		ticket_rsp, err := poller.Wait(ctx, e.TicketId)
+
+		// If the wait was cancelled or timed out the ticket is left pending so that it can be resumed.
+
+		if ticket_rsp.Status == client.TICKET_STATUS_PENDING {
+			rsp.Error = &UploadError{fmt.Errorf("Upload of '%s' still pending waiting for ticket %s, %v", path, e.TicketId, err)}
+			return rsp
+		}
+
+		if err != nil {
+			return record(ledger.STATUS_FAILED, fmt.Errorf("Failed to check upload ticket for '%s', %v", path, err))
+		}
+
+		e.PhotoId = ticket_rsp.PhotoId
+	}
+
+	if len(actions) == 0 {
+		return record(ledger.STATUS_COMPLETE, nil)
+	}
+
+	// The upload is only recorded as complete once all of its actions have succeeded. Otherwise it is recorded (and
+	// reported) as failed, along with its photo ID, so that its actions (but not the upload itself) are retried the next time.
+
+	action_results := applyActions(ctx, u.client, actions, e.PhotoId)
+	status := ledger.STATUS_COMPLETE
+
+	var action_err error
+
+	for _, r := range action_results {
+
+		if r.Error != nil {
+			status = ledger.STATUS_FAILED
+			action_err = fmt.Errorf("Failed to apply action '%s' to '%s', %w", r.Action, path, r.Error)
+			break
+		}
+	}
+
+	rsp = record(status, action_err)
+	rsp.Actions = action_results
+
+	return rsp
+}
+
+// fileName returns the file name for 'uri', which may be a local path or a URI, used to derive the default title for uploads.
+func fileName(uri string) string {
+
+	u, err := url.Parse(uri)
+
+	if err == nil && u.Scheme != "" {
+		uri = u.Path
+	}
+
+	return path.Base(filepath.ToSlash(uri))
+}
+
+// uploadContext returns a copy of 'ctx' with client.UploadOptions that add the number of bytes sent to the Uploader's
+// progress and apply the Uploader's bandwidth limit. Any client.UploadOptions already assigned to 'ctx' are preserved.
+func (u *Uploader) uploadContext(ctx context.Context) context.Context {
+
+	var last int64
+
+	opts := &client.UploadOptions{
+		Limiter: u.limiter,
+	}
+
+	parent_opts, has_parent := client.UploadOptionsFromContext(ctx)
+
+	if has_parent && opts.Limiter == nil {
+		opts.Limiter = parent_opts.Limiter
+	}
+
+	opts.Progress = func(sent int64, total int64) {
+
+		// The count starts again from zero if the upload is retried
+
+		if sent < last {
+			last = 0
+		}
+
+		u.bytes_sent.Add(sent - last)
+		last = sent
+
+		if has_parent && parent_opts.Progress != nil {
+			parent_opts.Progress(sent, total)
+		}
+	}
+
+	return client.WithUploadOptions(ctx, opts)
+}
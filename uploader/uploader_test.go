@@ -0,0 +1,378 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+	"github.com/aaronland/go-flickr-api/ledger"
+	"github.com/aaronland/go-flickr-api/metadata"
+	_ "gocloud.dev/blob/fileblob"
+)
+
+func TestUploader(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+	paths := make([]string, 0)
+
+	for i := 0; i < 4; i++ {
+
+		path := filepath.Join(root, fmt.Sprintf("%d.jpg", i))
+
+		err := os.WriteFile(path, []byte(fmt.Sprintf("photo %d", i)), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", path, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	paths = append(paths, filepath.Join(root, "missing.jpg"))
+
+	led, err := ledger.NewLedger(ctx, "jsonl://"+filepath.Join(root, "ledger.jsonl"))
+
+	if err != nil {
+		t.Fatalf("Failed to create ledger, %v", err)
+	}
+
+	defer led.Close()
+
+	opts := &UploaderOptions{
+		Client:  cl,
+		Ledger:  led,
+		Workers: 4,
+	}
+
+	up, err := NewUploader(ctx, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	uploaded := 0
+	failed := 0
+
+	for rsp := range up.Upload(ctx, paths) {
+
+		if rsp.Error != nil {
+			failed += 1
+			continue
+		}
+
+		if rsp.PhotoId == 0 || rsp.Skipped {
+			t.Fatalf("Unexpected result for %s, %v", rsp.Path, rsp)
+		}
+
+		uploaded += 1
+	}
+
+	if uploaded != 4 || failed != 1 {
+		t.Fatalf("Unexpected results, %d uploaded and %d failed", uploaded, failed)
+	}
+
+	p := up.Progress()
+
+	if p.Files != 5 || p.Done != 5 || p.Failed != 1 || p.BytesSent == 0 || p.ETA() != 0 {
+		t.Fatalf("Unexpected progress, %s", p)
+	}
+
+	if len(svr.Store.Photos()) != 4 {
+		t.Fatalf("Unexpected number of photos, %d", len(svr.Store.Photos()))
+	}
+
+	// The default title for each photo is derived from its file name.
+
+	for _, ph := range svr.Store.Photos() {
+
+		if len(ph.Title) != 1 || ph.Title[0] < '0' || ph.Title[0] > '3' {
+			t.Fatalf("Unexpected default title, %s", ph.Title)
+		}
+	}
+
+	// Uploading the same files again should skip them.
+
+	up, err = NewUploader(ctx, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	skipped := 0
+
+	for rsp := range up.Upload(ctx, paths[0:4]) {
+
+		if rsp.Error != nil {
+			t.Fatalf("Failed to upload %s, %v", rsp.Path, rsp.Error)
+		}
+
+		if rsp.Skipped {
+			skipped += 1
+		}
+	}
+
+	if skipped != 4 {
+		t.Fatalf("Expected files to be skipped, %d", skipped)
+	}
+
+	if len(svr.Store.Photos()) != 4 {
+		t.Fatalf("Unexpected number of photos after second run, %d", len(svr.Store.Photos()))
+	}
+
+	count := 0
+
+	for range up.Upload(ctx, paths[0:4]) {
+		count += 1
+		break
+	}
+
+	if count != 1 {
+		t.Fatalf("Expected break to stop iteration")
+	}
+}
+
+func TestUploaderBandwidth(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+	paths := make([]string, 0)
+
+	for i := 0; i < 2; i++ {
+
+		path := filepath.Join(root, fmt.Sprintf("%d.jpg", i))
+
+		err := os.WriteFile(path, bytes.Repeat([]byte{byte(i)}, 48*1024), 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", path, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	opts := &UploaderOptions{
+		Client:       cl,
+		Workers:      2,
+		MaxBandwidth: 128 * 1024,
+	}
+
+	up, err := NewUploader(ctx, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	// The limiter starts with a full bucket of 32KB so sending the remaining 64KB at 128KB/s should take at least 500ms.
+
+	started := time.Now()
+
+	for rsp := range up.Upload(ctx, paths) {
+
+		if rsp.Error != nil {
+			t.Fatalf("Failed to upload %s, %v", rsp.Path, rsp.Error)
+		}
+	}
+
+	elapsed := time.Since(started)
+
+	if elapsed < 500*time.Millisecond {
+		t.Fatalf("Uploads were not limited, took %v", elapsed)
+	}
+
+	p := up.Progress()
+
+	if p.BytesSent != 96*1024 {
+		t.Fatalf("Unexpected number of bytes sent, %d", p.BytesSent)
+	}
+
+	bar := p.Bar(10)
+
+	if !strings.HasPrefix(bar, "[==========] 2/2 files") {
+		t.Fatalf("Unexpected progress bar, %s", bar)
+	}
+
+	_, err = NewUploader(ctx, &UploaderOptions{Client: cl, MaxBandwidth: -1})
+
+	if err == nil {
+		t.Fatalf("Expected negative bandwidth to fail")
+	}
+}
+
+func TestUploaderMetadata(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	xmp := `<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` +
+		`<rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:exif="http://ns.adobe.com/exif/1.0/" exif:DateTimeOriginal="2021-06-01T12:34:56" exif:GPSLatitude="37,46.5N" exif:GPSLongitude="122,30W">` +
+		`<dc:title><rdf:Alt><rdf:li xml:lang="x-default">Embedded title</rdf:li></rdf:Alt></dc:title>` +
+		`<dc:subject><rdf:Bag><rdf:li>one</rdf:li><rdf:li>two words</rdf:li></rdf:Bag></dc:subject>` +
+		`</rdf:Description></rdf:RDF></x:xmpmeta>`
+
+	header := "http://ns.adobe.com/xap/1.0/\x00"
+	length := 2 + len(header) + len(xmp)
+
+	body := []byte{0xFF, 0xD8, 0xFF, 0xE1, byte(length >> 8), byte(length & 0xFF)}
+	body = append(body, header...)
+	body = append(body, xmp...)
+	body = append(body, 0xFF, 0xD9)
+
+	path := filepath.Join(t.TempDir(), "photo.jpg")
+
+	err = os.WriteFile(path, body, 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to write %s, %v", path, err)
+	}
+
+	opts := &UploaderOptions{
+		Client:   cl,
+		Metadata: metadata.DefaultMapping(),
+		Args:     &url.Values{"description": []string{"Global description"}},
+	}
+
+	up, err := NewUploader(ctx, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	for rsp := range up.Upload(ctx, []string{path}) {
+
+		if rsp.Error != nil {
+			t.Fatalf("Failed to upload %s, %v", rsp.Path, rsp.Error)
+		}
+
+		ph, exists := svr.Store.GetPhoto(rsp.PhotoId)
+
+		if !exists {
+			t.Fatalf("Failed to retrieve photo %d", rsp.PhotoId)
+		}
+
+		if ph.Title != "Embedded title" || ph.Description != "Global description" {
+			t.Fatalf("Unexpected title or description, %s %s", ph.Title, ph.Description)
+		}
+
+		if !ph.HasTag("one") || !ph.HasTag("two words") {
+			t.Fatalf("Unexpected tags, %v", ph.Tags)
+		}
+
+		if ph.Accuracy == 0 || math.Abs(ph.Latitude-37.775) > 0.000001 || math.Abs(ph.Longitude+122.5) > 0.000001 {
+			t.Fatalf("Unexpected location, %f %f", ph.Latitude, ph.Longitude)
+		}
+
+		if ph.DateTaken.Format(metadata.FLICKR_DATE_LAYOUT) != "2021-06-01 12:34:56" {
+			t.Fatalf("Unexpected date taken, %v", ph.DateTaken)
+		}
+	}
+}
+
+func TestUploaderMalformedMetadata(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	// A JPEG file with an APP1 segment that claims to contain EXIF data but does not
+
+	header := "Exif\x00\x00"
+	exif := "not a TIFF header"
+	length := 2 + len(header) + len(exif)
+
+	body := []byte{0xFF, 0xD8, 0xFF, 0xE1, byte(length >> 8), byte(length & 0xFF)}
+	body = append(body, header...)
+	body = append(body, exif...)
+	body = append(body, 0xFF, 0xD9)
+
+	_, err = metadata.ExtractReader(bytes.NewReader(body), int64(len(body)))
+
+	if err == nil {
+		t.Fatalf("Expected malformed EXIF data to fail to extract")
+	}
+
+	path := filepath.Join(t.TempDir(), "photo.jpg")
+
+	err = os.WriteFile(path, body, 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to write %s, %v", path, err)
+	}
+
+	opts := &UploaderOptions{
+		Client:   cl,
+		Metadata: metadata.DefaultMapping(),
+		Args:     &url.Values{"description": []string{"Global description"}},
+	}
+
+	up, err := NewUploader(ctx, opts)
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	for rsp := range up.Upload(ctx, []string{path}) {
+
+		if rsp.Error != nil {
+			t.Fatalf("Failed to upload %s, %v", rsp.Path, rsp.Error)
+		}
+
+		if len(rsp.Warnings) != 1 || !strings.Contains(rsp.Warnings[0], "Failed to extract metadata") {
+			t.Fatalf("Expected metadata warning, %v", rsp.Warnings)
+		}
+
+		ph, exists := svr.Store.GetPhoto(rsp.PhotoId)
+
+		if !exists {
+			t.Fatalf("Failed to retrieve photo %d", rsp.PhotoId)
+		}
+
+		if ph.Title != "photo" || ph.Description != "Global description" || string(ph.Body) != string(body) {
+			t.Fatalf("Unexpected photo, %s %s", ph.Title, ph.Description)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/reader"
+)
+
+// The account type for free Flickr accounts.
+const ACCOUNT_FREE string = "free"
+
+// The account type for Flickr Pro accounts.
+const ACCOUNT_PRO string = "pro"
+
+// The maximum size, in bytes, of photos accepted by the Flickr API.
+const MAX_PHOTO_SIZE int64 = 200 << 20
+
+// The maximum size, in bytes, of videos accepted by the Flickr API.
+const MAX_VIDEO_SIZE int64 = 1 << 30
+
+// The maximum duration of videos uploaded by free accounts.
+const MAX_VIDEO_DURATION_FREE time.Duration = 3 * time.Minute
+
+// The maximum duration of videos uploaded by Pro accounts.
+const MAX_VIDEO_DURATION_PRO time.Duration = 10 * time.Minute
+
+// The valid values for enumerated Flickr API upload parameters.
+var ARG_ENUMERATIONS = map[string][]string{
+	"safety_level": {"1", "2", "3"},
+	"content_type": {"1", "2", "3"},
+	"hidden":       {"1", "2"},
+	"is_public":    {"0", "1"},
+	"is_friend":    {"0", "1"},
+	"is_family":    {"0", "1"},
+	"async":        {"0", "1"},
+}
+
+// Limits is a struct containing the limits the Flickr API imposes on uploads.
+type Limits struct {
+	// The maximum size, in bytes, of photos.
+	MaxPhotoSize int64
+	// The maximum size, in bytes, of videos.
+	MaxVideoSize int64
+	// The maximum duration of videos. If 0 the duration of videos is not checked.
+	MaxVideoDuration time.Duration
+}
+
+// ValidationResult is a struct containing the results of validating a file before it is uploaded.
+type ValidationResult struct {
+	// The URI of the file that was validated.
+	Path string `json:"path"`
+	// The format of the file, for example FORMAT_JPEG, or empty if the format is not supported.
+	Format string `json:"format,omitempty"`
+	// The kind of media of the file, MEDIA_TYPE_PHOTO or MEDIA_TYPE_VIDEO.
+	MediaType string `json:"media_type,omitempty"`
+	// The size, in bytes, of the file.
+	Size int64 `json:"size"`
+	// The duration, in seconds, of videos whose duration could be determined.
+	Duration float64 `json:"duration,omitempty"`
+	// Zero or more problems that would prevent the file from being uploaded.
+	Problems []string `json:"problems,omitempty"`
+}
+
+// Valid returns a boolean flag indicating whether there are no problems with the file.
+func (r *ValidationResult) Valid() bool {
+	return len(r.Problems) == 0
+}
+
+// AccountLimits returns the Limits for 'account' which is one of ACCOUNT_FREE or ACCOUNT_PRO.
+func AccountLimits(account string) (*Limits, error) {
+
+	l := &Limits{
+		MaxPhotoSize: MAX_PHOTO_SIZE,
+		MaxVideoSize: MAX_VIDEO_SIZE,
+	}
+
+	switch account {
+	case ACCOUNT_FREE:
+		l.MaxVideoDuration = MAX_VIDEO_DURATION_FREE
+	case ACCOUNT_PRO:
+		l.MaxVideoDuration = MAX_VIDEO_DURATION_PRO
+	default:
+		return nil, fmt.Errorf("Invalid account type '%s'", account)
+	}
+
+	return l, nil
+}
+
+// ValidateArgs returns zero or more problems with the Flickr API upload parameters in 'args' whose values are not one
+// of the values defined in ARG_ENUMERATIONS.
+func ValidateArgs(args *url.Values) []string {
+
+	problems := make([]string, 0)
+
+	if args == nil {
+		return problems
+	}
+
+	keys := make([]string, 0)
+
+	for k := range ARG_ENUMERATIONS {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+
+		if !args.Has(k) {
+			continue
+		}
+
+		v := args.Get(k)
+		valid := ARG_ENUMERATIONS[k]
+
+		if !slices.Contains(valid, v) {
+			problems = append(problems, fmt.Sprintf("Invalid value '%s' for %s parameter, expected one of %s", v, k, strings.Join(valid, ", ")))
+		}
+	}
+
+	return problems
+}
+
+// ValidateInput returns a ValidationResult for the file for 'in', checking that its format is supported by the Flickr API
+// and that it is within 'limits', and for the upload parameters derived from 'args' overridden by the parameters in 'in'.
+func ValidateInput(ctx context.Context, in *Input, args *url.Values, limits *Limits) *ValidationResult {
+
+	rsp := &ValidationResult{
+		Path:     in.Path,
+		Problems: make([]string, 0),
+	}
+
+	fh, err := reader.NewReader(ctx, in.Path)
+
+	if err != nil {
+		rsp.Problems = append(rsp.Problems, fmt.Sprintf("Failed to create reader, %v", err))
+		return rsp
+	}
+
+	defer fh.Close()
+
+	r, size, err := readSeeker(fh)
+
+	if err != nil {
+		rsp.Problems = append(rsp.Problems, fmt.Sprintf("Failed to read file, %v", err))
+		return rsp
+	}
+
+	validateMedia(rsp, r, size, limits)
+
+	rsp.Problems = append(rsp.Problems, ValidateArgs(in.MergeArgs(args))...)
+	return rsp
+}
+
+// readSeeker returns 'fh' as an io.ReadSeeker, and its size, so that it can be read more than once without reading it in to
+// memory. Readers that do not support seeking or do not report their size are read in to memory.
+func readSeeker(fh io.Reader) (io.ReadSeeker, int64, error) {
+
+	rs, is_seeker := fh.(io.ReadSeeker)
+	sz, has_size := fh.(interface{ Size() int64 })
+
+	if is_seeker && has_size {
+		return rs, sz.Size(), nil
+	}
+
+	body, err := io.ReadAll(fh)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bytes.NewReader(body), int64(len(body)), nil
+}
+
+// ValidateInputs returns an iterator that yields the ValidationResult for each of 'inputs'. See also ValidateInput.
+func ValidateInputs(ctx context.Context, inputs []*Input, args *url.Values, limits *Limits) iter.Seq[*ValidationResult] {
+
+	return func(yield func(*ValidationResult) bool) {
+
+		for _, in := range inputs {
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if !yield(ValidateInput(ctx, in, args, limits)) {
+				return
+			}
+		}
+	}
+}
+
+// validateMedia checks the format, size and (video) duration of the file in 'r', whose size is 'size', against 'limits'
+// recording the details and any problems in 'rsp'.
+func validateMedia(rsp *ValidationResult, r io.ReadSeeker, size int64, limits *Limits) {
+
+	rsp.Size = size
+
+	if size == 0 {
+		rsp.Problems = append(rsp.Problems, "File is empty")
+		return
+	}
+
+	head := make([]byte, SNIFF_LENGTH)
+
+	n, err := io.ReadFull(r, head)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		rsp.Problems = append(rsp.Problems, fmt.Sprintf("Failed to read file, %v", err))
+		return
+	}
+
+	format, media_type := SniffFormat(head[:n])
+
+	if format == "" {
+		rsp.Problems = append(rsp.Problems, "Unsupported file format")
+		return
+	}
+
+	rsp.Format = format
+	rsp.MediaType = media_type
+
+	if limits == nil {
+		return
+	}
+
+	max_size := limits.MaxPhotoSize
+
+	if media_type == MEDIA_TYPE_VIDEO {
+		max_size = limits.MaxVideoSize
+	}
+
+	if max_size > 0 && size > max_size {
+		rsp.Problems = append(rsp.Problems, fmt.Sprintf("File size (%.2f MB) exceeds the maximum size for a %s (%.2f MB)", float64(size)/1024/1024, media_type, float64(max_size)/1024/1024))
+	}
+
+	switch format {
+	case FORMAT_MP4, FORMAT_MOV, FORMAT_3GP:
+
+		// Videos whose duration can't be determined are still assumed to be valid.
+
+		d, err := isoDuration(r, size)
+
+		if err != nil {
+			return
+		}
+
+		rsp.Duration = d.Seconds()
+
+		if limits.MaxVideoDuration > 0 && d > limits.MaxVideoDuration {
+			rsp.Problems = append(rsp.Problems, fmt.Sprintf("Video duration (%v) exceeds the maximum duration (%v)", d.Round(time.Second), limits.MaxVideoDuration))
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronland/go-flickr-api/client"
+	"github.com/aaronland/go-flickr-api/flickrtest"
+)
+
+func TestSniffFormat(t *testing.T) {
+
+	tests := map[string][]byte{
+		FORMAT_JPEG: {0xff, 0xd8, 0xff, 0xe0},
+		FORMAT_PNG:  []byte("\x89PNG\r\n\x1a\n"),
+		FORMAT_GIF:  []byte("GIF89a"),
+		FORMAT_TIFF: []byte("II*\x00"),
+		FORMAT_HEIC: []byte("\x00\x00\x00\x18ftypmif1\x00\x00\x00\x00mif1heic"),
+		FORMAT_MOV:  []byte("\x00\x00\x00\x14ftypqt  \x00\x00\x00\x00qt  "),
+		FORMAT_MP4:  testMP4(time.Second)[0:32],
+		FORMAT_AVI:  []byte("RIFF\x00\x00\x00\x00AVI LIST"),
+		"":          []byte("hello world"),
+	}
+
+	for expected, head := range tests {
+
+		format, _ := SniffFormat(head)
+
+		if format != expected {
+			t.Fatalf("Expected '%s' but got '%s'", expected, format)
+		}
+	}
+}
+
+func TestValidateInputs(t *testing.T) {
+
+	ctx := context.Background()
+
+	root := t.TempDir()
+
+	files := map[string][]byte{
+		"photo.jpg": append([]byte{0xff, 0xd8, 0xff, 0xe0}, bytes.Repeat([]byte("x"), 2048)...),
+		"short.mp4": testMP4(time.Minute),
+		"long.mp4":  testMP4(5 * time.Minute),
+		"notes.txt": []byte("hello world"),
+		"empty.jpg": []byte{},
+	}
+
+	inputs := make([]*Input, 0)
+
+	for fname, body := range files {
+
+		path := filepath.Join(root, fname)
+
+		err := os.WriteFile(path, body, 0644)
+
+		if err != nil {
+			t.Fatalf("Failed to write %s, %v", path, err)
+		}
+
+		inputs = append(inputs, &Input{Path: path, Args: &url.Values{}})
+	}
+
+	free, err := AccountLimits(ACCOUNT_FREE)
+
+	if err != nil {
+		t.Fatalf("Failed to derive limits, %v", err)
+	}
+
+	pro, err := AccountLimits(ACCOUNT_PRO)
+
+	if err != nil {
+		t.Fatalf("Failed to derive limits, %v", err)
+	}
+
+	small := &Limits{
+		MaxPhotoSize: 1024,
+		MaxVideoSize: MAX_VIDEO_SIZE,
+	}
+
+	tests := []struct {
+		limits  *Limits
+		invalid []string
+	}{
+		{free, []string{"long.mp4", "notes.txt", "empty.jpg"}},
+		{pro, []string{"notes.txt", "empty.jpg"}},
+		{small, []string{"photo.jpg", "notes.txt", "empty.jpg"}},
+	}
+
+	for i, test := range tests {
+
+		invalid := make([]string, 0)
+
+		for rsp := range ValidateInputs(ctx, inputs, nil, test.limits) {
+
+			if !rsp.Valid() {
+				invalid = append(invalid, filepath.Base(rsp.Path))
+			}
+
+			if filepath.Base(rsp.Path) == "long.mp4" && rsp.Duration != 300 {
+				t.Fatalf("Unexpected duration, %f", rsp.Duration)
+			}
+		}
+
+		if len(invalid) != len(test.invalid) {
+			t.Fatalf("Unexpected invalid files for test %d, %v", i, invalid)
+		}
+
+		for _, fname := range test.invalid {
+
+			found := false
+
+			for _, other := range invalid {
+				if fname == other {
+					found = true
+				}
+			}
+
+			if !found {
+				t.Fatalf("Expected %s to be invalid for test %d, %v", fname, i, invalid)
+			}
+		}
+	}
+
+	args := &url.Values{}
+	args.Set("safety_level", "4")
+	args.Set("is_public", "1")
+
+	photo := &Input{Path: filepath.Join(root, "photo.jpg"), Args: &url.Values{}}
+
+	rsp := ValidateInput(ctx, photo, args, pro)
+
+	if len(rsp.Problems) != 1 || !strings.Contains(rsp.Problems[0], "safety_level") {
+		t.Fatalf("Unexpected problems, %v", rsp.Problems)
+	}
+
+	_, err = AccountLimits("enterprise")
+
+	if err == nil {
+		t.Fatalf("Expected invalid account type to fail")
+	}
+}
+
+func TestUploaderLimits(t *testing.T) {
+
+	ctx := context.Background()
+
+	svr := flickrtest.NewServer()
+	defer svr.Close()
+
+	cl, err := client.NewClient(ctx, svr.ClientURI())
+
+	if err != nil {
+		t.Fatalf("Failed to create client, %v", err)
+	}
+
+	root := t.TempDir()
+
+	path := filepath.Join(root, "notes.txt")
+
+	err = os.WriteFile(path, []byte("hello world"), 0644)
+
+	if err != nil {
+		t.Fatalf("Failed to write %s, %v", path, err)
+	}
+
+	limits, _ := AccountLimits(ACCOUNT_FREE)
+
+	up, err := NewUploader(ctx, &UploaderOptions{Client: cl, Limits: limits})
+
+	if err != nil {
+		t.Fatalf("Failed to create uploader, %v", err)
+	}
+
+	for rsp := range up.Upload(ctx, []string{path}) {
+
+		if rsp.Error == nil || !strings.Contains(rsp.Error.Error(), "Unsupported file format") {
+			t.Fatalf("Expected upload to fail validation, %v", rsp.Error)
+		}
+	}
+
+	if len(svr.Store.Photos()) != 0 {
+		t.Fatalf("Invalid file was uploaded")
+	}
+}
+
+// testMP4 returns a minimal MP4 file whose "mvhd" box has a duration of 'd'.
+func testMP4(d time.Duration) []byte {
+
+	buf := new(bytes.Buffer)
+
+	box := func(box_type string, body []byte) {
+		binary.Write(buf, binary.BigEndian, uint32(8+len(body)))
+		buf.WriteString(box_type)
+		buf.Write(body)
+	}
+
+	box("ftyp", []byte("isom\x00\x00\x02\x00isomiso2mp41"))
+	box("mdat", bytes.Repeat([]byte{0x00}, 64))
+
+	mvhd := make([]byte, 20)
+	binary.BigEndian.PutUint32(mvhd[12:16], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:20], uint32(d.Milliseconds()))
+
+	mvhd_box := new(bytes.Buffer)
+	binary.Write(mvhd_box, binary.BigEndian, uint32(8+len(mvhd)))
+	mvhd_box.WriteString("mvhd")
+	mvhd_box.Write(mvhd)
+
+	box("moov", mvhd_box.Bytes())
+
+	return buf.Bytes()
+}